@@ -0,0 +1,21 @@
+package client
+
+import (
+	"net/http"
+
+	webapi_gossip "github.com/iotaledger/goshimmer/plugins/webapi/gossip"
+)
+
+const (
+	routeGossipNeighbors = "gossip/neighbors"
+)
+
+// GetGossipNeighbors gets the connected gossip neighbors together with the statistics of their traffic.
+func (api *GoShimmerAPI) GetGossipNeighbors() (*webapi_gossip.NeighborsResponse, error) {
+	res := &webapi_gossip.NeighborsResponse{}
+	if err := api.do(http.MethodGet, routeGossipNeighbors, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
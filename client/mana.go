@@ -0,0 +1,94 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	webapi_mana "github.com/iotaledger/goshimmer/plugins/webapi/mana"
+)
+
+const (
+	routeGetMana              = "mana"
+	routeGetAllMana           = "mana/all"
+	routeGetNHighestAccess    = "mana/access/nhighest"
+	routeGetNHighestConsensus = "mana/consensus/nhighest"
+	routeGetManaPercentile    = "mana/percentile"
+	routeGetManaPledgeLog     = "mana/pledges"
+)
+
+// GetOwnMana returns the access and consensus mana of the node this api client is communicating with.
+func (api *GoShimmerAPI) GetOwnMana() (*webapi_mana.GetManaResponse, error) {
+	return api.GetManaFullNodeID("")
+}
+
+// GetManaFullNodeID returns the access and consensus mana of the node with the given base58 encoded full nodeID.
+func (api *GoShimmerAPI) GetManaFullNodeID(base58EncodedNodeID string) (*webapi_mana.GetManaResponse, error) {
+	res := &webapi_mana.GetManaResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?nodeID=%s", routeGetMana, base58EncodedNodeID)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetAllMana returns the access and consensus mana of all nodes, sorted in descending order.
+func (api *GoShimmerAPI) GetAllMana() (*webapi_mana.GetAllManaResponse, error) {
+	res := &webapi_mana.GetAllManaResponse{}
+	if err := api.do(http.MethodGet, routeGetAllMana, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetNHighestAccessMana returns the N nodes with the highest access mana.
+func (api *GoShimmerAPI) GetNHighestAccessMana(n uint) (*webapi_mana.GetNHighestResponse, error) {
+	res := &webapi_mana.GetNHighestResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?number=%d", routeGetNHighestAccess, n)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetNHighestConsensusMana returns the N nodes with the highest consensus mana.
+func (api *GoShimmerAPI) GetNHighestConsensusMana(n uint) (*webapi_mana.GetNHighestResponse, error) {
+	res := &webapi_mana.GetNHighestResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?number=%d", routeGetNHighestConsensus, n)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetManaPercentile returns the access and consensus mana percentile of the node with the given base58 encoded full
+// nodeID.
+func (api *GoShimmerAPI) GetManaPercentile(base58EncodedNodeID string) (*webapi_mana.GetPercentileResponse, error) {
+	res := &webapi_mana.GetPercentileResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?nodeID=%s", routeGetManaPercentile, base58EncodedNodeID)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetPledgeLog returns the mana pledge and revocation events of the transaction with the given base58 encoded ID. If
+// the ID is empty, the events of the most recent transactions are returned.
+func (api *GoShimmerAPI) GetPledgeLog(base58EncodedTxID string) (*webapi_mana.GetPledgeLogResponse, error) {
+	res := &webapi_mana.GetPledgeLogResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?txID=%s", routeGetManaPledgeLog, base58EncodedTxID)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	webapi_manualpeering "github.com/iotaledger/goshimmer/plugins/webapi/manualpeering"
+)
+
+const (
+	routeManualPeers = "manualpeering/peers"
+)
+
+// GetManualPeers gets the manual peers of the node and whether they are currently connected.
+func (api *GoShimmerAPI) GetManualPeers() (*webapi_manualpeering.PeersResponse, error) {
+	res := &webapi_manualpeering.PeersResponse{}
+	if err := api.do(http.MethodGet, routeManualPeers, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddManualPeers adds the given peers (of the form publicKey@host:gossipPort) to the manual peers of the node.
+func (api *GoShimmerAPI) AddManualPeers(peers []string) (*webapi_manualpeering.PeersResponse, error) {
+	res := &webapi_manualpeering.PeersResponse{}
+	if err := api.do(http.MethodPost, routeManualPeers,
+		&webapi_manualpeering.PeersRequest{Peers: peers}, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RemoveManualPeer removes the manual peer with the given base58 encoded public key.
+func (api *GoShimmerAPI) RemoveManualPeer(publicKey string) (*webapi_manualpeering.PeersResponse, error) {
+	res := &webapi_manualpeering.PeersResponse{}
+	if err := api.do(http.MethodDelete, fmt.Sprintf("%s?publicKey=%s", routeManualPeers, publicKey), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -1,14 +1,18 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
 
 	webapi_message "github.com/iotaledger/goshimmer/plugins/webapi/message"
 )
 
 const (
-	routeFindByID    = "message/findById"
-	routeSendPayload = "message/sendPayload"
+	routeChildren         = "message/children"
+	routeFindByID         = "message/findById"
+	routeMetadata         = "message/metadata"
+	routeSendPayload      = "message/sendPayload"
+	routeSolidEntryPoints = "message/solidEntryPoints"
 )
 
 // FindMessageByID finds messages by the given base58 encoded IDs. The messages are returned in the same order as
@@ -38,3 +42,38 @@ func (api *GoShimmerAPI) SendPayload(payload []byte) (string, error) {
 
 	return res.ID, nil
 }
+
+// GetSolidEntryPoints returns the base58 encoded IDs of the solid entry points of the node.
+func (api *GoShimmerAPI) GetSolidEntryPoints() ([]string, error) {
+	res := &webapi_message.SolidEntryPointsResponse{}
+	if err := api.do(http.MethodGet, routeSolidEntryPoints, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res.SolidEntryPoints, nil
+}
+
+// GetMessageMetadata returns the metadata of the message with the given base58 encoded ID.
+func (api *GoShimmerAPI) GetMessageMetadata(base58EncodedID string) (*webapi_message.MetadataResponse, error) {
+	res := &webapi_message.MetadataResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?id=%s", routeMetadata, base58EncodedID)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetMessageChildren returns the base58 encoded IDs of the messages that reference the message with the given base58
+// encoded ID as a strong or weak parent.
+func (api *GoShimmerAPI) GetMessageChildren(base58EncodedID string) (*webapi_message.ChildrenResponse, error) {
+	res := &webapi_message.ChildrenResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?id=%s", routeChildren, base58EncodedID)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+	webapi_snapshot "github.com/iotaledger/goshimmer/plugins/webapi/snapshot"
+)
+
+const (
+	routeGetSnapshot = "snapshot"
+)
+
+// GetSnapshot exports the confirmed unspent outputs of the ledger state (and optionally the mana state) of the node.
+func (api *GoShimmerAPI) GetSnapshot(withMana bool) (*snapshot.Snapshot, error) {
+	res := &webapi_snapshot.GetSnapshotResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?mana=%t", routeGetSnapshot, withMana)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	exportedSnapshot, _, err := snapshot.FromBytes(res.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportedSnapshot, nil
+}
@@ -3,18 +3,46 @@ package client
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	webapi_value "github.com/iotaledger/goshimmer/plugins/webapi/value"
 )
 
 const (
+	routeAddressHistory = "value/addressHistory"
 	routeAttachments    = "value/attachments"
+	routeColorSupply    = "value/colorSupply"
 	routeGetTxnByID     = "value/transactionByID"
 	routeSendTxn        = "value/sendTransaction"
 	routeSendTxnByJSON  = "value/sendTransactionByJson"
 	routeUnspentOutputs = "value/unspentOutputs"
 )
 
+// GetAddressHistory gets a page of the outputs that were ever created on an address (spent and unspent). The cursor
+// is the NextCursor of the previous page (empty for the first page), a limit of 0 uses the default page size of the
+// node and the optional colors restrict the result to outputs holding tokens of at least one of them.
+func (api *GoShimmerAPI) GetAddressHistory(base58EncodedAddress string, cursor string, limit int, base58EncodedColors ...string) (*webapi_value.AddressHistoryResponse, error) {
+	query := url.Values{}
+	query.Set("address", base58EncodedAddress)
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	if limit != 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	for _, base58EncodedColor := range base58EncodedColors {
+		query.Add("color", base58EncodedColor)
+	}
+
+	res := &webapi_value.AddressHistoryResponse{}
+	if err := api.do(http.MethodGet, routeAddressHistory+"?"+query.Encode(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // GetAttachments gets the attachments of a transaction ID
 func (api *GoShimmerAPI) GetAttachments(base58EncodedTxnID string) (*webapi_value.AttachmentsResponse, error) {
 	res := &webapi_value.AttachmentsResponse{}
@@ -27,6 +55,18 @@ func (api *GoShimmerAPI) GetAttachments(base58EncodedTxnID string) (*webapi_valu
 	return res, nil
 }
 
+// GetColorSupply gets the amount of minted and destroyed tokens of a color.
+func (api *GoShimmerAPI) GetColorSupply(base58EncodedColor string) (*webapi_value.ColorSupplyResponse, error) {
+	res := &webapi_value.ColorSupplyResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return fmt.Sprintf("%s?color=%s", routeColorSupply, base58EncodedColor)
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // GetTransactionByID gets the transaction of a transaction ID
 func (api *GoShimmerAPI) GetTransactionByID(base58EncodedTxnID string) (*webapi_value.GetTransactionByIDResponse, error) {
 	res := &webapi_value.GetTransactionByIDResponse{}
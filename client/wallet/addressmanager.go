@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/bitmask"
 )
 
@@ -12,6 +13,7 @@ import (
 type AddressManager struct {
 	// state of the wallet
 	seed             *seed.Seed
+	watchedAddresses []address.Address
 	lastAddressIndex uint64
 	spentAddresses   []bitmask.BitMask
 
@@ -35,11 +37,53 @@ func NewAddressManager(seed *seed.Seed, lastAddressIndex uint64, spentAddresses
 	return
 }
 
-// Address returns the address that belongs to the given index.
+// NewWatchOnlyAddressManager creates an AddressManager for a fixed set of addresses without knowing the seed that they
+// belong to. It can be used to track the balances and to prepare transactions of cold-storage wallets, but it can not
+// derive new addresses.
+func NewWatchOnlyAddressManager(addresses []ledgerstate.Address, spentAddresses []bitmask.BitMask) (addressManager *AddressManager) {
+	if len(addresses) == 0 {
+		panic("a watch-only AddressManager needs at least one address")
+	}
+
+	watchedAddresses := make([]address.Address, len(addresses))
+	for i, addr := range addresses {
+		watchedAddresses[i] = address.Address{
+			AddressBytes: addr.Array(),
+			Index:        uint64(i),
+		}
+	}
+
+	addressManager = &AddressManager{
+		watchedAddresses:        watchedAddresses,
+		lastAddressIndex:        uint64(len(watchedAddresses) - 1),
+		spentAddresses:          spentAddresses,
+		lastUnspentAddressIndex: uint64(len(watchedAddresses) - 1),
+	}
+	addressManager.updateFirstUnspentAddressIndex()
+	addressManager.updateLastUnspentAddressIndex()
+
+	return
+}
+
+// WatchOnly returns true if the AddressManager manages a fixed set of addresses without knowing their seed.
+func (addressManager *AddressManager) WatchOnly() bool {
+	return addressManager.seed == nil
+}
+
+// Address returns the address that belongs to the given index. A watch-only AddressManager returns its last address
+// for indexes that exceed its set of addresses.
 func (addressManager *AddressManager) Address(addressIndex uint64) address.Address {
+	if addressManager.WatchOnly() && addressIndex > addressManager.lastAddressIndex {
+		addressIndex = addressManager.lastAddressIndex
+	}
+
 	// update lastUnspentAddressIndex if necessary
 	addressManager.spentAddressIndexes(addressIndex)
 
+	if addressManager.WatchOnly() {
+		return addressManager.watchedAddresses[addressIndex]
+	}
+
 	return addressManager.seed.Address(addressIndex)
 }
 
@@ -130,13 +174,13 @@ func (addressManager *AddressManager) spentAddressIndexes(addressIndex uint64) (
 		addressManager.spentAddresses = append(addressManager.spentAddresses, make([]bitmask.BitMask, sliceIndex-spentAddressesCapacity+1)...)
 	}
 
-	// update lastAddressIndex if the index is bigger
-	if addressIndex > addressManager.lastAddressIndex {
+	// update lastAddressIndex if the index is bigger (the set of addresses of a watch-only AddressManager is fixed)
+	if addressIndex > addressManager.lastAddressIndex && !addressManager.WatchOnly() {
 		addressManager.lastAddressIndex = addressIndex
 	}
 
 	// update lastUnspentAddressIndex if necessary
-	if addressIndex > addressManager.lastUnspentAddressIndex && !addressManager.spentAddresses[sliceIndex].HasBit(uint(bitIndex)) {
+	if addressIndex > addressManager.lastUnspentAddressIndex && addressIndex <= addressManager.lastAddressIndex && !addressManager.spentAddresses[sliceIndex].HasBit(uint(bitIndex)) {
 		addressManager.lastUnspentAddressIndex = addressIndex
 	}
 
@@ -146,7 +190,8 @@ func (addressManager *AddressManager) spentAddressIndexes(addressIndex uint64) (
 // updateFirstUnspentAddressIndex searches for the first unspent address and updates the firstUnspentAddressIndex.
 func (addressManager *AddressManager) updateFirstUnspentAddressIndex() {
 	for i := addressManager.firstUnspentAddressIndex; true; i++ {
-		if !addressManager.IsAddressSpent(i) {
+		// a watch-only AddressManager falls back to its last address if all of its addresses are spent
+		if !addressManager.IsAddressSpent(i) || (addressManager.WatchOnly() && i >= addressManager.lastAddressIndex) {
 			addressManager.firstUnspentAddressIndex = i
 
 			return
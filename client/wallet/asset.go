@@ -24,3 +24,15 @@ type Asset struct {
 	// the amount of tokens that we want to create
 	Amount uint64
 }
+
+// AssetSupply contains the amount of tokens of an asset that were created and destroyed in the network.
+type AssetSupply struct {
+	// Minted contains the amount of tokens that were created.
+	Minted uint64
+
+	// Destroyed contains the amount of tokens that were converted back to IOTA.
+	Destroyed uint64
+
+	// Supply contains the amount of tokens that are currently in circulation.
+	Supply uint64
+}
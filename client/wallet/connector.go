@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"errors"
+
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 )
@@ -11,4 +13,37 @@ type Connector interface {
 	UnspentOutputs(addresses ...address.Address) (unspentOutputs map[address.Address]map[ledgerstate.OutputID]*Output, err error)
 	SendTransaction(transaction *ledgerstate.Transaction) (err error)
 	RequestFaucetFunds(address address.Address) (err error)
+	TransactionInclusionState(transactionID ledgerstate.TransactionID) (inclusionState InclusionState, err error)
+}
+
+var (
+	// ErrWalletOffline is returned if an offline wallet tries to access the network.
+	ErrWalletOffline = errors.New("the wallet is offline")
+
+	// ErrTransactionNotFound is returned if the network does not know the requested transaction.
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
+
+// offlineConnector is the Connector of offline wallets which do not know any unspent outputs and which can not issue
+// transactions.
+type offlineConnector struct{}
+
+// UnspentOutputs returns an empty result since offline wallets do not know about the unspent outputs.
+func (offlineConnector) UnspentOutputs(...address.Address) (unspentOutputs map[address.Address]map[ledgerstate.OutputID]*Output, err error) {
+	return make(map[address.Address]map[ledgerstate.OutputID]*Output), nil
+}
+
+// SendTransaction returns an ErrWalletOffline.
+func (offlineConnector) SendTransaction(*ledgerstate.Transaction) (err error) {
+	return ErrWalletOffline
+}
+
+// RequestFaucetFunds returns an ErrWalletOffline.
+func (offlineConnector) RequestFaucetFunds(address.Address) (err error) {
+	return ErrWalletOffline
+}
+
+// TransactionInclusionState returns an ErrWalletOffline.
+func (offlineConnector) TransactionInclusionState(ledgerstate.TransactionID) (inclusionState InclusionState, err error) {
+	return InclusionState{}, ErrWalletOffline
 }
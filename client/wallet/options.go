@@ -3,6 +3,7 @@ package wallet
 import (
 	"github.com/iotaledger/goshimmer/client"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/bitmask"
 )
 
@@ -24,6 +25,14 @@ func Import(seed *seed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.B
 	}
 }
 
+// ImportTransactionHistory restores the history of the transactions that were previously sent or received by the
+// wallet.
+func ImportTransactionHistory(transactionHistory *TransactionHistory) Option {
+	return func(wallet *Wallet) {
+		wallet.transactionHistory = transactionHistory
+	}
+}
+
 // ReusableAddress configures the wallet to run in "single address" mode where all the funds are always managed on a
 // single reusable address.
 func ReusableAddress(enabled bool) Option {
@@ -39,3 +48,19 @@ func GenericConnector(connector Connector) Option {
 		wallet.connector = connector
 	}
 }
+
+// Offline configures the wallet to run without a connection to a node. An offline wallet can only be used to sign
+// transactions that were prepared by other wallets (i.e. for seeds that must never touch a networked machine).
+func Offline() Option {
+	return func(wallet *Wallet) {
+		wallet.connector = offlineConnector{}
+	}
+}
+
+// WatchOnly configures the wallet to manage the given addresses without knowing their seed. A watch-only wallet can
+// track the balances of a cold-storage seed and prepare transactions that are then signed by an offline wallet.
+func WatchOnly(addresses ...ledgerstate.Address) Option {
+	return func(wallet *Wallet) {
+		wallet.addressManager = NewWatchOnlyAddressManager(addresses, []bitmask.BitMask{})
+	}
+}
@@ -0,0 +1,296 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+	"github.com/mr-tron/base58"
+)
+
+// region PartiallySignedTransaction ///////////////////////////////////////////////////////////////////////////////////
+
+// PartiallySignedTransaction is an unsigned TransactionEssence together with the Outputs that it consumes and the
+// UnlockBlocks that were collected so far. It can be exported and handed to other (offline) wallets which add the
+// UnlockBlocks for the Inputs that they control, before the different copies are merged and submitted to the network.
+type PartiallySignedTransaction struct {
+	essence         *ledgerstate.TransactionEssence
+	consumedOutputs ledgerstate.Outputs
+	unlockBlocks    ledgerstate.UnlockBlocks
+}
+
+// NewPartiallySignedTransaction creates a PartiallySignedTransaction without UnlockBlocks. The consumed Outputs need to
+// be provided in the order of the Inputs of the TransactionEssence.
+func NewPartiallySignedTransaction(essence *ledgerstate.TransactionEssence, consumedOutputs ledgerstate.Outputs) (partiallySignedTransaction *PartiallySignedTransaction, err error) {
+	if len(consumedOutputs) != len(essence.Inputs()) {
+		err = fmt.Errorf("amount of consumed Outputs (%d) does not match amount of Inputs (%d)", len(consumedOutputs), len(essence.Inputs()))
+		return
+	}
+	for i, input := range essence.Inputs() {
+		if consumedOutputs[i].ID() != input.(*ledgerstate.UTXOInput).ReferencedOutputID() {
+			err = fmt.Errorf("consumed Output with %s does not match Input %d", consumedOutputs[i].ID(), i)
+			return
+		}
+	}
+
+	return &PartiallySignedTransaction{
+		essence:         essence,
+		consumedOutputs: consumedOutputs,
+		unlockBlocks:    make(ledgerstate.UnlockBlocks, len(consumedOutputs)),
+	}, nil
+}
+
+// PartiallySignedTransactionFromBytes unmarshals a PartiallySignedTransaction from a sequence of bytes.
+func PartiallySignedTransactionFromBytes(bytes []byte) (partiallySignedTransaction *PartiallySignedTransaction, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if partiallySignedTransaction, err = PartiallySignedTransactionFromMarshalUtil(marshalUtil); err != nil {
+		err = fmt.Errorf("failed to parse PartiallySignedTransaction from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// PartiallySignedTransactionFromBase58EncodedString creates a PartiallySignedTransaction from a base58 encoded string.
+func PartiallySignedTransactionFromBase58EncodedString(base58String string) (partiallySignedTransaction *PartiallySignedTransaction, err error) {
+	decodedBytes, err := base58.Decode(base58String)
+	if err != nil {
+		err = fmt.Errorf("error while decoding base58 encoded PartiallySignedTransaction: %w", err)
+		return
+	}
+
+	if partiallySignedTransaction, _, err = PartiallySignedTransactionFromBytes(decodedBytes); err != nil {
+		err = fmt.Errorf("failed to parse PartiallySignedTransaction from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// PartiallySignedTransactionFromMarshalUtil unmarshals a PartiallySignedTransaction using a MarshalUtil (for easier
+// unmarshaling).
+func PartiallySignedTransactionFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (partiallySignedTransaction *PartiallySignedTransaction, err error) {
+	essence, err := ledgerstate.TransactionEssenceFromMarshalUtil(marshalUtil)
+	if err != nil {
+		err = fmt.Errorf("failed to parse TransactionEssence: %w", err)
+		return
+	}
+
+	consumedOutputs := make(ledgerstate.Outputs, len(essence.Inputs()))
+	for i, input := range essence.Inputs() {
+		if consumedOutputs[i], err = ledgerstate.OutputFromMarshalUtil(marshalUtil); err != nil {
+			err = fmt.Errorf("failed to parse consumed Output: %w", err)
+			return
+		}
+		consumedOutputs[i].SetID(input.(*ledgerstate.UTXOInput).ReferencedOutputID())
+	}
+
+	if partiallySignedTransaction, err = NewPartiallySignedTransaction(essence, consumedOutputs); err != nil {
+		err = fmt.Errorf("failed to create PartiallySignedTransaction: %w", err)
+		return
+	}
+
+	for i := range partiallySignedTransaction.unlockBlocks {
+		unlockBlockExists, unlockBlockExistsErr := marshalUtil.ReadBool()
+		if unlockBlockExistsErr != nil {
+			err = fmt.Errorf("failed to parse UnlockBlock flag: %w", unlockBlockExistsErr)
+			return
+		}
+		if !unlockBlockExists {
+			continue
+		}
+
+		if partiallySignedTransaction.unlockBlocks[i], err = ledgerstate.UnlockBlockFromMarshalUtil(marshalUtil); err != nil {
+			err = fmt.Errorf("failed to parse UnlockBlock: %w", err)
+			return
+		}
+	}
+
+	return
+}
+
+// Essence returns the TransactionEssence that needs to be signed.
+func (p *PartiallySignedTransaction) Essence() *ledgerstate.TransactionEssence {
+	return p.essence
+}
+
+// ConsumedOutputs returns the Outputs that are consumed by the Inputs of the TransactionEssence (in the same order).
+func (p *PartiallySignedTransaction) ConsumedOutputs() ledgerstate.Outputs {
+	return p.consumedOutputs
+}
+
+// UnlockBlocks returns the UnlockBlocks that were collected so far (missing UnlockBlocks are nil).
+func (p *PartiallySignedTransaction) UnlockBlocks() ledgerstate.UnlockBlocks {
+	return p.unlockBlocks
+}
+
+// SetUnlockBlock sets the UnlockBlock of the Input with the given index.
+func (p *PartiallySignedTransaction) SetUnlockBlock(index int, unlockBlock ledgerstate.UnlockBlock) (err error) {
+	if index < 0 || index >= len(p.unlockBlocks) {
+		return fmt.Errorf("index of UnlockBlock (%d) is out of bounds", index)
+	}
+
+	p.unlockBlocks[index] = unlockBlock
+
+	return
+}
+
+// MissingUnlockBlocks returns the indexes of the Inputs that do not have an UnlockBlock, yet.
+func (p *PartiallySignedTransaction) MissingUnlockBlocks() (missingUnlockBlocks []int) {
+	missingUnlockBlocks = make([]int, 0)
+	for i, unlockBlock := range p.unlockBlocks {
+		if unlockBlock == nil {
+			missingUnlockBlocks = append(missingUnlockBlocks, i)
+		}
+	}
+
+	return
+}
+
+// Merge adds the UnlockBlocks of another copy of the same PartiallySignedTransaction. The signatures of
+// ThresholdUnlockBlocks that exist in both copies are combined.
+func (p *PartiallySignedTransaction) Merge(other *PartiallySignedTransaction) (err error) {
+	if !bytes.Equal(p.essence.Bytes(), other.essence.Bytes()) {
+		return fmt.Errorf("PartiallySignedTransactions have different TransactionEssences")
+	}
+
+	for i, otherUnlockBlock := range other.unlockBlocks {
+		if otherUnlockBlock == nil {
+			continue
+		}
+
+		if p.unlockBlocks[i] == nil {
+			p.unlockBlocks[i] = otherUnlockBlock
+			continue
+		}
+
+		thresholdUnlockBlock, isThresholdUnlockBlock := p.unlockBlocks[i].(*ledgerstate.ThresholdUnlockBlock)
+		otherThresholdUnlockBlock, otherIsThresholdUnlockBlock := otherUnlockBlock.(*ledgerstate.ThresholdUnlockBlock)
+		if isThresholdUnlockBlock && otherIsThresholdUnlockBlock {
+			if err = thresholdUnlockBlock.Merge(otherThresholdUnlockBlock); err != nil {
+				return fmt.Errorf("failed to merge ThresholdUnlockBlocks of Input %d: %w", i, err)
+			}
+		}
+	}
+
+	return
+}
+
+// Transaction returns the fully signed Transaction. It returns an error if UnlockBlocks are missing or invalid.
+func (p *PartiallySignedTransaction) Transaction() (transaction *ledgerstate.Transaction, err error) {
+	if missingUnlockBlocks := p.MissingUnlockBlocks(); len(missingUnlockBlocks) != 0 {
+		err = fmt.Errorf("the Inputs %v are not signed, yet", missingUnlockBlocks)
+		return
+	}
+
+	transaction = ledgerstate.NewTransaction(p.essence, p.unlockBlocks)
+	if !ledgerstate.UnlockBlocksValid(p.consumedOutputs, transaction) {
+		transaction = nil
+		err = fmt.Errorf("the UnlockBlocks do not authorize the spending of the consumed Outputs")
+		return
+	}
+
+	return
+}
+
+// Bytes returns a marshaled version of the PartiallySignedTransaction.
+func (p *PartiallySignedTransaction) Bytes() []byte {
+	marshalUtil := marshalutil.New().WriteBytes(p.essence.Bytes())
+	for _, consumedOutput := range p.consumedOutputs {
+		marshalUtil.WriteBytes(consumedOutput.Bytes())
+	}
+	for _, unlockBlock := range p.unlockBlocks {
+		marshalUtil.WriteBool(unlockBlock != nil)
+		if unlockBlock != nil {
+			marshalUtil.WriteBytes(unlockBlock.Bytes())
+		}
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// Base58 returns a base58 encoded version of the PartiallySignedTransaction.
+func (p *PartiallySignedTransaction) Base58() string {
+	return base58.Encode(p.Bytes())
+}
+
+// MarshalJSON returns a JSON representation of the PartiallySignedTransaction with base58 encoded fields.
+func (p *PartiallySignedTransaction) MarshalJSON() ([]byte, error) {
+	partiallySignedTransactionJSON := partiallySignedTransactionJSON{
+		Essence:         base58.Encode(p.essence.Bytes()),
+		ConsumedOutputs: make([]string, len(p.consumedOutputs)),
+		UnlockBlocks:    make([]string, len(p.unlockBlocks)),
+	}
+	for i, consumedOutput := range p.consumedOutputs {
+		partiallySignedTransactionJSON.ConsumedOutputs[i] = base58.Encode(consumedOutput.Bytes())
+	}
+	for i, unlockBlock := range p.unlockBlocks {
+		if unlockBlock != nil {
+			partiallySignedTransactionJSON.UnlockBlocks[i] = base58.Encode(unlockBlock.Bytes())
+		}
+	}
+
+	return json.Marshal(partiallySignedTransactionJSON)
+}
+
+// UnmarshalJSON restores a PartiallySignedTransaction from its JSON representation.
+func (p *PartiallySignedTransaction) UnmarshalJSON(data []byte) (err error) {
+	partiallySignedTransactionJSON := &partiallySignedTransactionJSON{}
+	if err = json.Unmarshal(data, partiallySignedTransactionJSON); err != nil {
+		return fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	if len(partiallySignedTransactionJSON.UnlockBlocks) != len(partiallySignedTransactionJSON.ConsumedOutputs) {
+		return fmt.Errorf("amount of UnlockBlocks (%d) does not match amount of consumed Outputs (%d)", len(partiallySignedTransactionJSON.UnlockBlocks), len(partiallySignedTransactionJSON.ConsumedOutputs))
+	}
+
+	marshalUtil := marshalutil.New()
+	for _, encodedBytes := range append([]string{partiallySignedTransactionJSON.Essence}, partiallySignedTransactionJSON.ConsumedOutputs...) {
+		decodedBytes, decodeErr := base58.Decode(encodedBytes)
+		if decodeErr != nil {
+			return fmt.Errorf("failed to decode base58 encoded field: %w", decodeErr)
+		}
+		marshalUtil.WriteBytes(decodedBytes)
+	}
+	for _, encodedUnlockBlock := range partiallySignedTransactionJSON.UnlockBlocks {
+		marshalUtil.WriteBool(encodedUnlockBlock != "")
+		if encodedUnlockBlock == "" {
+			continue
+		}
+
+		decodedBytes, decodeErr := base58.Decode(encodedUnlockBlock)
+		if decodeErr != nil {
+			return fmt.Errorf("failed to decode base58 encoded UnlockBlock: %w", decodeErr)
+		}
+		marshalUtil.WriteBytes(decodedBytes)
+	}
+
+	partiallySignedTransaction, _, err := PartiallySignedTransactionFromBytes(marshalUtil.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to parse PartiallySignedTransaction: %w", err)
+	}
+	*p = *partiallySignedTransaction
+
+	return
+}
+
+// String returns a human readable version of the PartiallySignedTransaction.
+func (p *PartiallySignedTransaction) String() string {
+	return stringify.Struct("PartiallySignedTransaction",
+		stringify.StructField("essence", p.essence),
+		stringify.StructField("consumedOutputs", p.consumedOutputs),
+		stringify.StructField("missingUnlockBlocks", p.MissingUnlockBlocks()),
+	)
+}
+
+// partiallySignedTransactionJSON is the JSON representation of a PartiallySignedTransaction.
+type partiallySignedTransactionJSON struct {
+	Essence         string   `json:"essence"`
+	ConsumedOutputs []string `json:"consumedOutputs"`
+	UnlockBlocks    []string `json:"unlockBlocks"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
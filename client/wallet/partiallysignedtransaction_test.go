@@ -0,0 +1,130 @@
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/bitmask"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartiallySignedTransaction_ColdStorage(t *testing.T) {
+	coldSeed := walletseed.NewSeed()
+	receiverSeed := walletseed.NewSeed()
+
+	mockedConnector := newMockConnector(&Output{
+		Address:  coldSeed.Address(0),
+		OutputID: ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0),
+		Balances: ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 1337}),
+		InclusionState: InclusionState{
+			Liked:     true,
+			Confirmed: true,
+		},
+	})
+	onlineWallet := New(Import(coldSeed, 1, []bitmask.BitMask{}, NewAssetRegistry()), GenericConnector(mockedConnector))
+	offlineWallet := New(Import(coldSeed, 1, []bitmask.BitMask{}, NewAssetRegistry()), Offline())
+
+	// the online wallet prepares the transaction without signing it
+	partiallySignedTransaction, err := onlineWallet.PrepareTransaction(Destination(receiverSeed.Address(0), 1000))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0}, partiallySignedTransaction.MissingUnlockBlocks())
+	_, err = onlineWallet.SubmitTransaction(partiallySignedTransaction)
+	assert.Error(t, err)
+
+	// the offline wallet signs the exported transaction
+	exportedTransaction, err := json.Marshal(partiallySignedTransaction)
+	require.NoError(t, err)
+	importedTransaction := &PartiallySignedTransaction{}
+	require.NoError(t, json.Unmarshal(exportedTransaction, importedTransaction))
+	assert.Equal(t, 1, offlineWallet.SignTransaction(importedTransaction))
+	_, err = offlineWallet.SubmitTransaction(importedTransaction)
+	assert.True(t, errors.Is(err, ErrWalletOffline))
+
+	// the online wallet submits the signed transaction
+	signedTransaction, err := PartiallySignedTransactionFromBase58EncodedString(importedTransaction.Base58())
+	require.NoError(t, err)
+	tx, err := onlineWallet.SubmitTransaction(signedTransaction)
+	require.NoError(t, err)
+	assert.Equal(t, partiallySignedTransaction.Essence().Bytes(), tx.Essence().Bytes())
+	assert.True(t, mockedConnector.outputs[coldSeed.Address(0)][ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0)].InclusionState.Spent)
+}
+
+func TestPartiallySignedTransaction_WatchOnly(t *testing.T) {
+	coldSeed := walletseed.NewSeed()
+	receiverSeed := walletseed.NewSeed()
+
+	mockedConnector := newMockConnector(&Output{
+		Address:  coldSeed.Address(0),
+		OutputID: ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0),
+		Balances: ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 1337}),
+		InclusionState: InclusionState{
+			Liked:     true,
+			Confirmed: true,
+		},
+	})
+	watchOnlyWallet := New(WatchOnly(coldSeed.Address(0).Address(), coldSeed.Address(1).Address()), GenericConnector(mockedConnector))
+	offlineWallet := New(Import(coldSeed, 1, []bitmask.BitMask{}, NewAssetRegistry()), Offline())
+	assert.True(t, watchOnlyWallet.WatchOnly())
+	assert.False(t, offlineWallet.WatchOnly())
+
+	// the watch-only wallet prepares the transaction and sends the remainder to one of its addresses
+	partiallySignedTransaction, err := watchOnlyWallet.PrepareTransaction(Destination(receiverSeed.Address(0), 1000))
+	require.NoError(t, err)
+	require.Len(t, partiallySignedTransaction.Essence().Outputs(), 2)
+	remainderOutputs := 0
+	for _, output := range partiallySignedTransaction.Essence().Outputs() {
+		if output.Address().Array() == coldSeed.Address(1).AddressBytes {
+			remainderOutputs++
+			balance, _ := output.Balances().Get(ledgerstate.ColorIOTA)
+			assert.Equal(t, uint64(337), balance)
+		}
+	}
+	assert.Equal(t, 1, remainderOutputs)
+
+	// only the wallet that knows the seed can sign the transaction
+	assert.Zero(t, watchOnlyWallet.SignTransaction(partiallySignedTransaction))
+	assert.Equal(t, 1, offlineWallet.SignTransaction(partiallySignedTransaction))
+
+	_, err = watchOnlyWallet.SubmitTransaction(partiallySignedTransaction)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockedConnector.sentTransactions)
+	assert.Equal(t, coldSeed.Address(1), watchOnlyWallet.RemainderAddress())
+}
+
+func TestPartiallySignedTransaction_Merge(t *testing.T) {
+	seeds := []*walletseed.Seed{walletseed.NewSeed(), walletseed.NewSeed()}
+
+	consumedOutputs := make(ledgerstate.Outputs, len(seeds))
+	for i, seed := range seeds {
+		consumedOutputs[i] = ledgerstate.NewSigLockedSingleOutput(100, seed.Address(0).Address()).SetID(ledgerstate.NewOutputID(ledgerstate.TransactionID{byte(i + 1)}, 0))
+	}
+	inputs := ledgerstate.NewInputs(consumedOutputs[0].Input(), consumedOutputs[1].Input())
+	if inputs[0].(*ledgerstate.UTXOInput).ReferencedOutputID() != consumedOutputs[0].ID() {
+		consumedOutputs[0], consumedOutputs[1] = consumedOutputs[1], consumedOutputs[0]
+	}
+	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, inputs, ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(200, seeds[0].Address(1).Address())))
+
+	// every party signs its own copy of the transaction
+	copies := make([]*PartiallySignedTransaction, len(seeds))
+	for i, seed := range seeds {
+		var err error
+		copies[i], err = NewPartiallySignedTransaction(txEssence, consumedOutputs)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, New(Import(seed, 0, []bitmask.BitMask{}, NewAssetRegistry()), Offline()).SignTransaction(copies[i]))
+		assert.Len(t, copies[i].MissingUnlockBlocks(), 1)
+	}
+
+	require.NoError(t, copies[0].Merge(copies[1]))
+	assert.Empty(t, copies[0].MissingUnlockBlocks())
+
+	tx, err := copies[0].Transaction()
+	require.NoError(t, err)
+	assert.True(t, ledgerstate.UnlockBlocksValid(consumedOutputs, tx))
+}
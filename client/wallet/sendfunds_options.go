@@ -52,6 +52,28 @@ func Destination(addr address.Address, amount uint64, optionalColor ...ledgersta
 	}
 }
 
+// Destroy is an option for the SendFunds call that converts the given amount of colored tokens back to IOTA. The
+// resulting IOTA tokens are sent to the remainder address.
+func Destroy(color ledgerstate.Color, amount uint64) SendFundsOption {
+	if color == ledgerstate.ColorIOTA || color == ledgerstate.ColorMint {
+		return optionError(errors.New("only colored tokens can be destroyed"))
+	}
+
+	if amount == 0 {
+		return optionError(errors.New("the amount of destroyed tokens needs to be larger than 0"))
+	}
+
+	return func(options *sendFundsOptions) error {
+		if options.DestroyedFunds == nil {
+			options.DestroyedFunds = make(map[ledgerstate.Color]uint64)
+		}
+
+		options.DestroyedFunds[color] += amount
+
+		return nil
+	}
+}
+
 // Remainder is an option for the SendsFunds call that allows us to specify the remainder address that is
 // supposed to be used in the corresponding transaction.
 func Remainder(addr address.Address) SendFundsOption {
@@ -65,6 +87,7 @@ func Remainder(addr address.Address) SendFundsOption {
 // sendFundsOptions is a struct that is used to aggregate the optional parameters provided in the SendFunds call.
 type sendFundsOptions struct {
 	Destinations     map[address.Address]map[ledgerstate.Color]uint64
+	DestroyedFunds   map[ledgerstate.Color]uint64
 	RemainderAddress address.Address
 }
 
@@ -81,7 +104,7 @@ func buildSendFundsOptions(options ...SendFundsOption) (result *sendFundsOptions
 	}
 
 	// sanitize parameters
-	if len(result.Destinations) == 0 {
+	if len(result.Destinations) == 0 && len(result.DestroyedFunds) == 0 {
 		err = errors.New("you need to provide at least one Destination for a valid transfer to be issued")
 
 		return
@@ -0,0 +1,330 @@
+package wallet
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+)
+
+// region TransactionHistory ///////////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionHistory keeps track of the transactions that were sent or received by a wallet together with their
+// inclusion states. Outgoing transactions are stored completely, so that they can be reattached if they get orphaned.
+type TransactionHistory struct {
+	entries map[ledgerstate.TransactionID]*HistoryEntry
+}
+
+// NewTransactionHistory is the constructor for the TransactionHistory.
+func NewTransactionHistory() *TransactionHistory {
+	return &TransactionHistory{
+		entries: make(map[ledgerstate.TransactionID]*HistoryEntry),
+	}
+}
+
+// TransactionHistoryFromBytes unmarshals a TransactionHistory from a sequence of bytes.
+func TransactionHistoryFromBytes(bytes []byte) (transactionHistory *TransactionHistory, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if transactionHistory, err = TransactionHistoryFromMarshalUtil(marshalUtil); err != nil {
+		err = fmt.Errorf("failed to parse TransactionHistory from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// TransactionHistoryFromMarshalUtil unmarshals a TransactionHistory using a MarshalUtil (for easier unmarshaling).
+func TransactionHistoryFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (transactionHistory *TransactionHistory, err error) {
+	entryCount, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = fmt.Errorf("failed to parse entry count: %w", err)
+		return
+	}
+
+	transactionHistory = NewTransactionHistory()
+	for i := uint64(0); i < entryCount; i++ {
+		entry, entryErr := HistoryEntryFromMarshalUtil(marshalUtil)
+		if entryErr != nil {
+			err = fmt.Errorf("failed to parse HistoryEntry at index %d: %w", i, entryErr)
+			return
+		}
+		transactionHistory.entries[entry.TransactionID] = entry
+	}
+
+	return
+}
+
+// Entry returns the HistoryEntry of the given Transaction.
+func (t *TransactionHistory) Entry(transactionID ledgerstate.TransactionID) (entry *HistoryEntry, exists bool) {
+	entry, exists = t.entries[transactionID]
+
+	return
+}
+
+// Entries returns all HistoryEntries ordered by the time they were added to the history.
+func (t *TransactionHistory) Entries() (entries []*HistoryEntry) {
+	entries = make([]*HistoryEntry, 0, len(t.entries))
+	for _, entry := range t.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	return
+}
+
+// PendingEntries returns the HistoryEntries of the Transactions whose inclusion state is not final, yet.
+func (t *TransactionHistory) PendingEntries() (entries []*HistoryEntry) {
+	entries = make([]*HistoryEntry, 0)
+	for _, entry := range t.Entries() {
+		if entry.Status == TransactionPending {
+			entries = append(entries, entry)
+		}
+	}
+
+	return
+}
+
+// Bytes returns a marshaled version of the TransactionHistory.
+func (t *TransactionHistory) Bytes() []byte {
+	marshalUtil := marshalutil.New()
+	marshalUtil.WriteUint64(uint64(len(t.entries)))
+	for _, entry := range t.Entries() {
+		marshalUtil.WriteBytes(entry.Bytes())
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// String returns a human readable version of the TransactionHistory.
+func (t *TransactionHistory) String() string {
+	structBuilder := stringify.StructBuilder("TransactionHistory")
+	for _, entry := range t.Entries() {
+		structBuilder.AddField(stringify.StructField(entry.TransactionID.Base58(), entry))
+	}
+
+	return structBuilder.String()
+}
+
+// add adds a new HistoryEntry to the TransactionHistory and returns false if the Transaction was known already.
+func (t *TransactionHistory) add(entry *HistoryEntry) (added bool) {
+	if _, exists := t.entries[entry.TransactionID]; exists {
+		return false
+	}
+	t.entries[entry.TransactionID] = entry
+
+	return true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region HistoryEntry /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// HistoryEntry represents a Transaction in the TransactionHistory of a wallet.
+type HistoryEntry struct {
+	// TransactionID contains the identifier of the Transaction.
+	TransactionID ledgerstate.TransactionID
+
+	// Direction indicates if the wallet sent or received the funds.
+	Direction TransactionDirection
+
+	// Status contains the last known inclusion state of the Transaction.
+	Status TransactionStatus
+
+	// Timestamp contains the time when the wallet learned about the Transaction.
+	Timestamp time.Time
+
+	// Balances contains the funds that were sent to other wallets (outgoing) or to this wallet (incoming).
+	Balances map[ledgerstate.Color]uint64
+
+	// Reattachments contains the number of times the Transaction was sent to the network again.
+	Reattachments uint8
+
+	// Transaction contains the issued Transaction for outgoing entries (nil for incoming ones).
+	Transaction *ledgerstate.Transaction
+}
+
+// HistoryEntryFromMarshalUtil unmarshals a HistoryEntry using a MarshalUtil (for easier unmarshaling).
+func HistoryEntryFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (entry *HistoryEntry, err error) {
+	entry = &HistoryEntry{}
+	if entry.TransactionID, err = ledgerstate.TransactionIDFromMarshalUtil(marshalUtil); err != nil {
+		err = fmt.Errorf("failed to parse TransactionID: %w", err)
+		return
+	}
+	direction, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = fmt.Errorf("failed to parse direction: %w", err)
+		return
+	}
+	entry.Direction = TransactionDirection(direction)
+	status, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = fmt.Errorf("failed to parse status: %w", err)
+		return
+	}
+	entry.Status = TransactionStatus(status)
+	if entry.Timestamp, err = marshalUtil.ReadTime(); err != nil {
+		err = fmt.Errorf("failed to parse timestamp: %w", err)
+		return
+	}
+	if entry.Reattachments, err = marshalUtil.ReadUint8(); err != nil {
+		err = fmt.Errorf("failed to parse reattachment count: %w", err)
+		return
+	}
+
+	balanceCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		err = fmt.Errorf("failed to parse balance count: %w", err)
+		return
+	}
+	entry.Balances = make(map[ledgerstate.Color]uint64, balanceCount)
+	for i := uint32(0); i < balanceCount; i++ {
+		color, colorErr := ledgerstate.ColorFromMarshalUtil(marshalUtil)
+		if colorErr != nil {
+			err = fmt.Errorf("failed to parse Color: %w", colorErr)
+			return
+		}
+		if entry.Balances[color], err = marshalUtil.ReadUint64(); err != nil {
+			err = fmt.Errorf("failed to parse balance: %w", err)
+			return
+		}
+	}
+
+	transactionExists, err := marshalUtil.ReadBool()
+	if err != nil {
+		err = fmt.Errorf("failed to parse Transaction flag: %w", err)
+		return
+	}
+	if transactionExists {
+		if entry.Transaction, err = ledgerstate.TransactionFromMarshalUtil(marshalUtil); err != nil {
+			err = fmt.Errorf("failed to parse Transaction: %w", err)
+			return
+		}
+	}
+
+	return
+}
+
+// Bytes returns a marshaled version of the HistoryEntry.
+func (h *HistoryEntry) Bytes() []byte {
+	colors := make([]ledgerstate.Color, 0, len(h.Balances))
+	for color := range h.Balances {
+		colors = append(colors, color)
+	}
+	sort.Slice(colors, func(i, j int) bool {
+		return colors[i].String() < colors[j].String()
+	})
+
+	marshalUtil := marshalutil.New().
+		Write(h.TransactionID).
+		WriteUint8(uint8(h.Direction)).
+		WriteUint8(uint8(h.Status)).
+		WriteTime(h.Timestamp).
+		WriteUint8(h.Reattachments).
+		WriteUint32(uint32(len(colors)))
+	for _, color := range colors {
+		marshalUtil.Write(color).WriteUint64(h.Balances[color])
+	}
+	marshalUtil.WriteBool(h.Transaction != nil)
+	if h.Transaction != nil {
+		marshalUtil.Write(h.Transaction)
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// String returns a human readable version of the HistoryEntry.
+func (h *HistoryEntry) String() string {
+	return stringify.Struct("HistoryEntry",
+		stringify.StructField("transactionID", h.TransactionID),
+		stringify.StructField("direction", h.Direction),
+		stringify.StructField("status", h.Status),
+		stringify.StructField("timestamp", h.Timestamp),
+		stringify.StructField("balances", h.Balances),
+		stringify.StructField("reattachments", h.Reattachments),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionDirection /////////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionDirection indicates if a Transaction in the TransactionHistory was sent or received by the wallet.
+type TransactionDirection uint8
+
+const (
+	// IncomingTransaction represents a Transaction that sent funds to the wallet.
+	IncomingTransaction TransactionDirection = iota
+
+	// OutgoingTransaction represents a Transaction that was issued by the wallet.
+	OutgoingTransaction
+)
+
+// String returns a human readable version of the TransactionDirection.
+func (t TransactionDirection) String() string {
+	switch t {
+	case IncomingTransaction:
+		return "IN"
+	case OutgoingTransaction:
+		return "OUT"
+	default:
+		return fmt.Sprintf("TransactionDirection(%d)", uint8(t))
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionStatus ////////////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionStatus represents the last known inclusion state of a Transaction in the TransactionHistory.
+type TransactionStatus uint8
+
+const (
+	// TransactionPending represents a Transaction that was neither confirmed nor rejected, yet.
+	TransactionPending TransactionStatus = iota
+
+	// TransactionConfirmed represents a confirmed Transaction.
+	TransactionConfirmed
+
+	// TransactionRejected represents a Transaction that was rejected (i.e. because of a double spend).
+	TransactionRejected
+
+	// TransactionOrphaned represents an outgoing Transaction that the network does not know and that could not be
+	// reattached anymore.
+	TransactionOrphaned
+)
+
+// String returns a human readable version of the TransactionStatus.
+func (t TransactionStatus) String() string {
+	switch t {
+	case TransactionPending:
+		return "PEND"
+	case TransactionConfirmed:
+		return "OK"
+	case TransactionRejected:
+		return "REJECTED"
+	case TransactionOrphaned:
+		return "ORPHANED"
+	default:
+		return fmt.Sprintf("TransactionStatus(%d)", uint8(t))
+	}
+}
+
+// transactionStatus derives the TransactionStatus from the InclusionState reported by the network.
+func transactionStatus(inclusionState InclusionState) TransactionStatus {
+	switch {
+	case inclusionState.Confirmed:
+		return TransactionConfirmed
+	case inclusionState.Rejected:
+		return TransactionRejected
+	default:
+		return TransactionPending
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,123 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/bitmask"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionHistory(t *testing.T) {
+	senderSeed := walletseed.NewSeed()
+	receiverSeed := walletseed.NewSeed()
+
+	mockedConnector := newMockConnector(&Output{
+		Address:  senderSeed.Address(0),
+		OutputID: ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0),
+		Balances: ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 1337}),
+		InclusionState: InclusionState{
+			Liked: true,
+		},
+	})
+	wallet := New(Import(senderSeed, 1, []bitmask.BitMask{}, NewAssetRegistry()), GenericConnector(mockedConnector))
+
+	// the funds on the wallet are recorded as a pending incoming transaction
+	incomingEntry, exists := wallet.TransactionHistory().Entry(ledgerstate.TransactionID{1})
+	require.True(t, exists)
+	assert.Equal(t, IncomingTransaction, incomingEntry.Direction)
+	assert.Equal(t, TransactionPending, incomingEntry.Status)
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 1337}, incomingEntry.Balances)
+
+	tx, err := wallet.SendFunds(Destination(receiverSeed.Address(0), 1000))
+	require.NoError(t, err)
+	outgoingEntry, exists := wallet.TransactionHistory().Entry(tx.ID())
+	require.True(t, exists)
+	assert.Equal(t, OutgoingTransaction, outgoingEntry.Direction)
+	assert.Equal(t, TransactionPending, outgoingEntry.Status)
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 1000}, outgoingEntry.Balances)
+	assert.Len(t, wallet.TransactionHistory().PendingEntries(), 2)
+
+	// nothing changes as long as the network did not decide about the transactions
+	updatedEntries, err := wallet.RefreshTransactionHistory()
+	require.NoError(t, err)
+	assert.Empty(t, updatedEntries)
+
+	mockedConnector.transactions[ledgerstate.TransactionID{1}] = InclusionState{Liked: true, Confirmed: true}
+	mockedConnector.transactions[tx.ID()] = InclusionState{Liked: true, Confirmed: true}
+	updatedEntries, err = wallet.RefreshTransactionHistory()
+	require.NoError(t, err)
+	assert.Len(t, updatedEntries, 2)
+	assert.Equal(t, TransactionConfirmed, incomingEntry.Status)
+	assert.Equal(t, TransactionConfirmed, outgoingEntry.Status)
+	assert.Empty(t, wallet.TransactionHistory().PendingEntries())
+
+	// the history survives a restart of the wallet
+	restoredHistory, _, err := TransactionHistoryFromBytes(wallet.TransactionHistory().Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, wallet.TransactionHistory().Bytes(), restoredHistory.Bytes())
+	restoredWallet := New(Import(senderSeed, 1, []bitmask.BitMask{}, NewAssetRegistry()), ImportTransactionHistory(restoredHistory), GenericConnector(mockedConnector))
+	restoredEntry, exists := restoredWallet.TransactionHistory().Entry(tx.ID())
+	require.True(t, exists)
+	assert.Equal(t, TransactionConfirmed, restoredEntry.Status)
+	assert.Equal(t, tx.Bytes(), restoredEntry.Transaction.Bytes())
+}
+
+func TestWallet_RefreshTransactionHistory(t *testing.T) {
+	senderSeed := walletseed.NewSeed()
+	receiverSeed := walletseed.NewSeed()
+
+	mockedConnector := newMockConnector(
+		&Output{
+			Address:        senderSeed.Address(0),
+			OutputID:       ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0),
+			Balances:       ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 1337}),
+			InclusionState: InclusionState{Liked: true, Confirmed: true},
+		},
+		&Output{
+			Address:        senderSeed.Address(1),
+			OutputID:       ledgerstate.NewOutputID(ledgerstate.TransactionID{2}, 0),
+			Balances:       ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 1337}),
+			InclusionState: InclusionState{Liked: true, Confirmed: true},
+		},
+	)
+	wallet := New(Import(senderSeed, 2, []bitmask.BitMask{}, NewAssetRegistry()), GenericConnector(mockedConnector))
+
+	orphanedTx, err := wallet.SendFunds(Destination(receiverSeed.Address(0), 1337))
+	require.NoError(t, err)
+	rejectedTx, err := wallet.SendFunds(Destination(receiverSeed.Address(1), 1337))
+	require.NoError(t, err)
+	orphanedEntry, _ := wallet.TransactionHistory().Entry(orphanedTx.ID())
+	rejectedEntry, _ := wallet.TransactionHistory().Entry(rejectedTx.ID())
+
+	// a transaction that is unknown to the network is only reattached after the ReattachmentDelay
+	delete(mockedConnector.transactions, orphanedTx.ID())
+	updatedEntries, err := wallet.RefreshTransactionHistory()
+	require.NoError(t, err)
+	assert.Empty(t, updatedEntries)
+	assert.Equal(t, 2, mockedConnector.sentTransactions)
+
+	orphanedEntry.Timestamp = time.Now().Add(-ReattachmentDelay * (MaxReattachments + 1))
+	for i := 1; i <= MaxReattachments; i++ {
+		delete(mockedConnector.transactions, orphanedTx.ID())
+		updatedEntries, err = wallet.RefreshTransactionHistory()
+		require.NoError(t, err)
+		assert.Equal(t, []*HistoryEntry{orphanedEntry}, updatedEntries)
+		assert.Equal(t, uint8(i), orphanedEntry.Reattachments)
+		assert.Equal(t, TransactionPending, orphanedEntry.Status)
+		assert.Equal(t, 2+i, mockedConnector.sentTransactions)
+	}
+
+	// the transaction is reported as orphaned after too many reattachments and rejected transactions are reported
+	delete(mockedConnector.transactions, orphanedTx.ID())
+	mockedConnector.transactions[rejectedTx.ID()] = InclusionState{Rejected: true}
+	updatedEntries, err = wallet.RefreshTransactionHistory()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*HistoryEntry{orphanedEntry, rejectedEntry}, updatedEntries)
+	assert.Equal(t, TransactionOrphaned, orphanedEntry.Status)
+	assert.Equal(t, TransactionRejected, rejectedEntry.Status)
+	assert.Equal(t, 2+MaxReattachments, mockedConnector.sentTransactions)
+}
@@ -10,17 +10,28 @@ import (
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/bitmask"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/marshalutil"
 	"golang.org/x/crypto/blake2b"
 )
 
+const (
+	// ReattachmentDelay defines how long the wallet waits for an issued transaction to become known to the network before
+	// it reattaches the transaction.
+	ReattachmentDelay = time.Minute
+
+	// MaxReattachments defines how often the wallet reattaches an orphaned transaction before it gives up.
+	MaxReattachments = 3
+)
+
 // Wallet represents a simple cryptocurrency wallet for the IOTA tangle. It contains the logic to manage the movement of
 // funds.
 type Wallet struct {
 	addressManager       *AddressManager
 	assetRegistry        *AssetRegistry
 	unspentOutputManager *UnspentOutputManager
+	transactionHistory   *TransactionHistory
 	connector            Connector
 
 	// if this option is enabled the wallet will use a single reusable address instead of changing addresses.
@@ -50,6 +61,11 @@ func New(options ...Option) (wallet *Wallet) {
 		wallet.assetRegistry = NewAssetRegistry()
 	}
 
+	// initialize transaction history if none was provided in the options.
+	if wallet.transactionHistory == nil {
+		wallet.transactionHistory = NewTransactionHistory()
+	}
+
 	// initialize wallet with default connector (server) if none was provided
 	if wallet.connector == nil {
 		panic("you need to provide a connector for your wallet")
@@ -61,6 +77,7 @@ func New(options ...Option) (wallet *Wallet) {
 	if err != nil {
 		panic(err)
 	}
+	wallet.recordIncomingTransactions()
 
 	return
 }
@@ -72,6 +89,18 @@ func (wallet *Wallet) ServerStatus() (status ServerStatus, err error) {
 
 // SendFunds issues a payment of the given amount to the given address.
 func (wallet *Wallet) SendFunds(options ...SendFundsOption) (tx *ledgerstate.Transaction, err error) {
+	partiallySignedTransaction, err := wallet.PrepareTransaction(options...)
+	if err != nil {
+		return
+	}
+	wallet.SignTransaction(partiallySignedTransaction)
+
+	return wallet.SubmitTransaction(partiallySignedTransaction)
+}
+
+// PrepareTransaction builds the unsigned Transaction for a payment of the given amount to the given address. The
+// returned PartiallySignedTransaction can be exported and signed by other (offline) wallets before it is submitted.
+func (wallet *Wallet) PrepareTransaction(options ...SendFundsOption) (partiallySignedTransaction *PartiallySignedTransaction, err error) {
 	// build options from the parameters
 	sendFundsOptions, err := buildSendFundsOptions(options...)
 	if err != nil {
@@ -84,45 +113,106 @@ func (wallet *Wallet) SendFunds(options ...SendFundsOption) (tx *ledgerstate.Tra
 		return
 	}
 
-	// build transaction
+	// build transaction essence
 	inputs, consumedFunds := wallet.buildInputs(consumedOutputs)
 	outputs := wallet.buildOutputs(sendFundsOptions, consumedFunds)
 	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, inputs, outputs)
 	outputsByID := consumedOutputs.OutputsByID()
 
-	unlockBlocks := make([]ledgerstate.UnlockBlock, len(inputs))
-	existingUnlockBlocks := make(map[address.Address]uint16)
-	for outputIndex, input := range inputs {
+	consumedLedgerStateOutputs := make(ledgerstate.Outputs, len(inputs))
+	for i, input := range inputs {
 		output := outputsByID[input.(*ledgerstate.UTXOInput).ReferencedOutputID()]
-		if unlockBlockIndex, unlockBlockExists := existingUnlockBlocks[output.Address]; unlockBlockExists {
-			unlockBlocks[outputIndex] = ledgerstate.NewReferenceUnlockBlock(unlockBlockIndex)
+		consumedLedgerStateOutputs[i] = ledgerstate.NewSigLockedColoredOutput(output.Balances, output.Address.Address()).SetID(output.OutputID)
+	}
+
+	return NewPartiallySignedTransaction(txEssence, consumedLedgerStateOutputs)
+}
+
+// SignTransaction adds the UnlockBlocks for all Inputs of the PartiallySignedTransaction that are controlled by the
+// addresses of the wallet. It does not require a connection to the network and returns the amount of signed Inputs.
+// A watch-only wallet does not sign any Inputs.
+func (wallet *Wallet) SignTransaction(partiallySignedTransaction *PartiallySignedTransaction) (signedInputs int) {
+	if wallet.WatchOnly() {
+		return
+	}
+
+	walletAddresses := make(map[[ledgerstate.AddressLength]byte]address.Address)
+	for _, addr := range wallet.addressManager.Addresses() {
+		walletAddresses[addr.AddressBytes] = addr
+	}
+
+	txEssence := partiallySignedTransaction.Essence()
+	existingUnlockBlocks := make(map[address.Address]uint16)
+	for inputIndex, consumedOutput := range partiallySignedTransaction.ConsumedOutputs() {
+		if thresholdUnlockBlock, isThresholdUnlockBlock := partiallySignedTransaction.UnlockBlocks()[inputIndex].(*ledgerstate.ThresholdUnlockBlock); isThresholdUnlockBlock {
+			if wallet.SignThresholdUnlockBlock(txEssence, thresholdUnlockBlock) != 0 {
+				signedInputs++
+			}
 			continue
 		}
 
-		keyPair := wallet.Seed().KeyPair(output.Address.Index)
-		unlockBlock := ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(txEssence.Bytes())))
-		unlockBlocks[outputIndex] = unlockBlock
-		existingUnlockBlocks[output.Address] = uint16(len(existingUnlockBlocks))
-	}
+		addr, addressOfWallet := walletAddresses[consumedOutput.Address().Array()]
+		if !addressOfWallet || partiallySignedTransaction.UnlockBlocks()[inputIndex] != nil {
+			continue
+		}
 
-	tx = ledgerstate.NewTransaction(txEssence, unlockBlocks)
+		var unlockBlock ledgerstate.UnlockBlock
+		if unlockBlockIndex, unlockBlockExists := existingUnlockBlocks[addr]; unlockBlockExists {
+			unlockBlock = ledgerstate.NewReferenceUnlockBlock(unlockBlockIndex)
+		} else {
+			keyPair := wallet.Seed().KeyPair(addr.Index)
+			unlockBlock = ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(txEssence.Bytes())))
+			existingUnlockBlocks[addr] = uint16(inputIndex)
+		}
 
-	// mark outputs as spent
-	for addr, outputs := range consumedOutputs {
-		for transactionID := range outputs {
-			wallet.unspentOutputManager.MarkOutputSpent(addr, transactionID)
+		if err := partiallySignedTransaction.SetUnlockBlock(inputIndex, unlockBlock); err == nil {
+			signedInputs++
 		}
 	}
 
-	// mark addresses as spent
-	if !wallet.reusableAddress {
-		for addr := range consumedOutputs {
+	return
+}
+
+// SubmitTransaction checks if the PartiallySignedTransaction is fully signed and sends it to the network. The consumed
+// outputs of the wallet are marked as spent.
+func (wallet *Wallet) SubmitTransaction(partiallySignedTransaction *PartiallySignedTransaction) (tx *ledgerstate.Transaction, err error) {
+	if tx, err = partiallySignedTransaction.Transaction(); err != nil {
+		return
+	}
+
+	walletAddresses := make(map[[ledgerstate.AddressLength]byte]address.Address)
+	for _, addr := range wallet.addressManager.Addresses() {
+		walletAddresses[addr.AddressBytes] = addr
+	}
+
+	for _, consumedOutput := range partiallySignedTransaction.ConsumedOutputs() {
+		addr, addressOfWallet := walletAddresses[consumedOutput.Address().Array()]
+		if !addressOfWallet {
+			continue
+		}
+
+		// mark outputs as spent
+		wallet.unspentOutputManager.MarkOutputSpent(addr, consumedOutput.ID())
+
+		// mark addresses as spent
+		if !wallet.reusableAddress {
 			wallet.addressManager.MarkAddressSpent(addr.Index)
 		}
 	}
 
 	// send transaction
-	err = wallet.connector.SendTransaction(tx)
+	if err = wallet.connector.SendTransaction(tx); err != nil {
+		return
+	}
+
+	wallet.transactionHistory.add(&HistoryEntry{
+		TransactionID: tx.ID(),
+		Direction:     OutgoingTransaction,
+		Status:        TransactionPending,
+		Timestamp:     time.Now(),
+		Balances:      wallet.outgoingBalances(tx, walletAddresses),
+		Transaction:   tx,
+	})
 
 	return
 }
@@ -171,6 +261,24 @@ func (wallet *Wallet) CreateAsset(asset Asset) (assetColor ledgerstate.Color, er
 	return
 }
 
+// DestroyAsset converts the given amount of tokens of an asset back to IOTA which are sent to the remainder address of
+// the wallet.
+func (wallet *Wallet) DestroyAsset(assetColor ledgerstate.Color, amount uint64) (tx *ledgerstate.Transaction, err error) {
+	return wallet.SendFunds(Destroy(assetColor, amount))
+}
+
+// AssetSupply retrieves the amount of tokens of an asset that were created and destroyed in the network.
+func (wallet *Wallet) AssetSupply(assetColor ledgerstate.Color) (supply AssetSupply, err error) {
+	webConnector, webConnectorUsed := wallet.connector.(*WebConnector)
+	if !webConnectorUsed {
+		err = errors.New("the connector of the wallet does not support querying the supply of assets")
+
+		return
+	}
+
+	return webConnector.AssetSupply(assetColor)
+}
+
 // AssetRegistry return the internal AssetRegistry instance of the wallet.
 func (wallet *Wallet) AssetRegistry() *AssetRegistry {
 	return wallet.assetRegistry
@@ -239,14 +347,57 @@ func (wallet *Wallet) RequestFaucetFunds(waitForConfirmation ...bool) (err error
 // Refresh scans the addresses for incoming transactions. If the optional rescanSpentAddresses parameter is set to true
 // we also scan the spent addresses again (this can take longer).
 func (wallet *Wallet) Refresh(rescanSpentAddresses ...bool) (err error) {
-	err = wallet.unspentOutputManager.Refresh(rescanSpentAddresses...)
+	if err = wallet.unspentOutputManager.Refresh(rescanSpentAddresses...); err != nil {
+		return
+	}
+	wallet.recordIncomingTransactions()
+
+	return
+}
+
+// TransactionHistory returns the history of the transactions that were sent or received by this wallet.
+func (wallet *Wallet) TransactionHistory() *TransactionHistory {
+	return wallet.transactionHistory
+}
+
+// RefreshTransactionHistory scans for incoming transactions and updates the inclusion states of the pending
+// transactions. Outgoing transactions that are unknown to the network are reattached and marked as orphaned if they
+// can not be reattached anymore. It returns the entries whose status changed or that were reattached.
+func (wallet *Wallet) RefreshTransactionHistory() (updatedEntries []*HistoryEntry, err error) {
+	if err = wallet.Refresh(); err != nil {
+		return
+	}
+
+	updatedEntries = make([]*HistoryEntry, 0)
+	for _, entry := range wallet.transactionHistory.PendingEntries() {
+		inclusionState, stateErr := wallet.connector.TransactionInclusionState(entry.TransactionID)
+		switch {
+		case stateErr == nil:
+			status := transactionStatus(inclusionState)
+			if status == entry.Status {
+				continue
+			}
+			entry.Status = status
+		case errors.Is(stateErr, ErrTransactionNotFound):
+			if entry.Direction != OutgoingTransaction || time.Since(entry.Timestamp) < ReattachmentDelay*time.Duration(entry.Reattachments+1) {
+				continue
+			}
+			wallet.reattach(entry)
+		default:
+			err = stateErr
+
+			return
+		}
+
+		updatedEntries = append(updatedEntries, entry)
+	}
 
 	return
 }
 
 // Balance returns the confirmed and pending balance of the funds managed by this wallet.
 func (wallet *Wallet) Balance() (confirmedBalance map[ledgerstate.Color]uint64, pendingBalance map[ledgerstate.Color]uint64, err error) {
-	err = wallet.unspentOutputManager.Refresh()
+	err = wallet.Refresh()
 	if err != nil {
 		return
 	}
@@ -281,17 +432,58 @@ func (wallet *Wallet) Balance() (confirmedBalance map[ledgerstate.Color]uint64,
 	return
 }
 
-// Seed returns the seed of this wallet that is used to generate all of the wallets addresses and private keys.
+// PublicKey returns the public key that belongs to the given address of the wallet. It can be shared with other parties
+// to create a ThresholdAddress that is controlled by multiple wallets.
+func (wallet *Wallet) PublicKey(addr address.Address) ed25519.PublicKey {
+	return wallet.Seed().KeyPair(addr.Index).PublicKey
+}
+
+// SignThresholdUnlockBlock adds the signatures of all addresses of the wallet that are part of the key set of the given
+// ThresholdUnlockBlock. The partially signed UnlockBlocks of the different wallets can be combined with the Merge method
+// of the UnlockBlock until the threshold is reached. It returns the amount of signatures that were added.
+func (wallet *Wallet) SignThresholdUnlockBlock(txEssence *ledgerstate.TransactionEssence, unlockBlock *ledgerstate.ThresholdUnlockBlock) (addedSignatures int) {
+	if wallet.WatchOnly() {
+		return
+	}
+
+	publicKeys := make(map[ed25519.PublicKey]bool)
+	for _, publicKey := range unlockBlock.PublicKeys() {
+		publicKeys[publicKey] = true
+	}
+
+	for _, addr := range wallet.addressManager.Addresses() {
+		keyPair := wallet.Seed().KeyPair(addr.Index)
+		if !publicKeys[keyPair.PublicKey] {
+			continue
+		}
+
+		if err := unlockBlock.AddSignature(keyPair.PublicKey, keyPair.PrivateKey.Sign(txEssence.Bytes())); err == nil {
+			addedSignatures++
+		}
+	}
+
+	return
+}
+
+// Seed returns the seed of this wallet that is used to generate all of the wallets addresses and private keys. It
+// returns nil for watch-only wallets.
 func (wallet *Wallet) Seed() *seed.Seed {
 	return wallet.addressManager.seed
 }
 
+// WatchOnly returns true if the wallet manages a fixed set of addresses without knowing their seed.
+func (wallet *Wallet) WatchOnly() bool {
+	return wallet.addressManager.WatchOnly()
+}
+
 // AddressManager returns the manager for the addresses of this wallet.
 func (wallet *Wallet) AddressManager() *AddressManager {
 	return wallet.addressManager
 }
 
 // ExportState exports the current state of the wallet to a marshaled version.
+//
+// The TransactionHistory is not part of the exported state and needs to be persisted separately.
 func (wallet *Wallet) ExportState() []byte {
 	marshalUtil := marshalutil.New()
 	marshalUtil.WriteBytes(wallet.Seed().Bytes())
@@ -302,6 +494,83 @@ func (wallet *Wallet) ExportState() []byte {
 	return marshalUtil.Bytes()
 }
 
+// recordIncomingTransactions adds the unknown transactions that created outputs of the wallet to the
+// TransactionHistory and updates the status of the pending incoming transactions.
+func (wallet *Wallet) recordIncomingTransactions() {
+	newEntries := make(map[ledgerstate.TransactionID]*HistoryEntry)
+	for _, outputs := range wallet.unspentOutputManager.unspentOutputs {
+		for outputID, output := range outputs {
+			if entry, exists := wallet.transactionHistory.Entry(outputID.TransactionID()); exists {
+				if entry.Direction == IncomingTransaction && entry.Status == TransactionPending {
+					entry.Status = transactionStatus(output.InclusionState)
+				}
+
+				continue
+			}
+
+			entry, exists := newEntries[outputID.TransactionID()]
+			if !exists {
+				entry = &HistoryEntry{
+					TransactionID: outputID.TransactionID(),
+					Direction:     IncomingTransaction,
+					Status:        transactionStatus(output.InclusionState),
+					Timestamp:     time.Now(),
+					Balances:      make(map[ledgerstate.Color]uint64),
+				}
+				newEntries[outputID.TransactionID()] = entry
+			}
+			output.Balances.ForEach(func(color ledgerstate.Color, balance uint64) bool {
+				entry.Balances[color] += balance
+				return true
+			})
+		}
+	}
+
+	for _, entry := range newEntries {
+		wallet.transactionHistory.add(entry)
+	}
+}
+
+// outgoingBalances returns the funds that the given transaction sends to addresses outside of the wallet (or all funds
+// if the transaction only moves funds between the addresses of the wallet).
+func (wallet *Wallet) outgoingBalances(tx *ledgerstate.Transaction, walletAddresses map[[ledgerstate.AddressLength]byte]address.Address) (balances map[ledgerstate.Color]uint64) {
+	sentBalances := make(map[ledgerstate.Color]uint64)
+	totalBalances := make(map[ledgerstate.Color]uint64)
+	for _, output := range tx.Essence().Outputs() {
+		_, internalTransfer := walletAddresses[output.Address().Array()]
+		output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			totalBalances[color] += balance
+			if !internalTransfer {
+				sentBalances[color] += balance
+			}
+			return true
+		})
+	}
+
+	if len(sentBalances) == 0 {
+		return totalBalances
+	}
+
+	return sentBalances
+}
+
+// reattach sends the Transaction of an orphaned outgoing entry to the network again. The entry is marked as orphaned if
+// it was reattached too often or if the network refuses the Transaction.
+func (wallet *Wallet) reattach(entry *HistoryEntry) {
+	if entry.Transaction == nil || entry.Reattachments >= MaxReattachments {
+		entry.Status = TransactionOrphaned
+
+		return
+	}
+
+	if err := wallet.connector.SendTransaction(entry.Transaction); err != nil {
+		entry.Status = TransactionOrphaned
+
+		return
+	}
+	entry.Reattachments++
+}
+
 func (wallet *Wallet) determineOutputsToConsume(sendFundsOptions *sendFundsOptions) (outputsToConsume OutputsByAddressAndOutputID, err error) {
 	// initialize return values
 	outputsToConsume = make(OutputsByAddressAndOutputID)
@@ -318,6 +587,9 @@ func (wallet *Wallet) determineOutputsToConsume(sendFundsOptions *sendFundsOptio
 			requiredFunds[color] += amount
 		}
 	}
+	for color, amount := range sendFundsOptions.DestroyedFunds {
+		requiredFunds[color] += amount
+	}
 
 	// refresh balances so we get the latest changes
 	if err = wallet.unspentOutputManager.Refresh(); err != nil {
@@ -434,6 +706,15 @@ func (wallet *Wallet) buildOutputs(sendFundsOptions *sendFundsOptions, consumedF
 		}
 	}
 
+	// convert destroyed tokens back to IOTA (they are sent to the remainder address)
+	for color, amount := range sendFundsOptions.DestroyedFunds {
+		consumedFunds[color] -= amount
+		if consumedFunds[color] == 0 {
+			delete(consumedFunds, color)
+		}
+		consumedFunds[ledgerstate.ColorIOTA] += amount
+	}
+
 	// build outputs for remainder
 	if len(consumedFunds) != 0 {
 		if _, addressExists := outputsByColor[sendFundsOptions.RemainderAddress]; !addressExists {
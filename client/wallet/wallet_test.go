@@ -3,13 +3,17 @@ package wallet
 import (
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
 	walletaddr "github.com/iotaledger/goshimmer/client/wallet/packages/address"
 	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/bitmask"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWallet_SendFunds(t *testing.T) {
@@ -120,8 +124,63 @@ func TestWallet_SendFunds(t *testing.T) {
 	}
 }
 
+func TestWallet_SignThresholdUnlockBlock(t *testing.T) {
+	wallets := make([]*Wallet, 3)
+	publicKeys := make([]ed25519.PublicKey, len(wallets))
+	for i := range wallets {
+		wallets[i] = New(GenericConnector(newMockConnector()))
+		publicKeys[i] = wallets[i].PublicKey(wallets[i].ReceiveAddress())
+	}
+
+	thresholdAddress, err := ledgerstate.NewThresholdAddress(2, publicKeys...)
+	require.NoError(t, err)
+	input := ledgerstate.NewSigLockedSingleOutput(100, thresholdAddress).SetID(ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0))
+	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, ledgerstate.NewInputs(input.Input()), ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(100, wallets[0].ReceiveAddress().Address())))
+
+	// every wallet signs its own copy of the unlock block
+	partialUnlockBlocks := make([]*ledgerstate.ThresholdUnlockBlock, len(wallets))
+	for i, wallet := range wallets {
+		partialUnlockBlocks[i], err = ledgerstate.NewThresholdUnlockBlock(2, publicKeys...)
+		require.NoError(t, err)
+		assert.Equal(t, 1, wallet.SignThresholdUnlockBlock(txEssence, partialUnlockBlocks[i]))
+	}
+
+	unlockBlock := partialUnlockBlocks[0]
+	assert.False(t, ledgerstate.UnlockBlocksValid(ledgerstate.Outputs{input}, ledgerstate.NewTransaction(txEssence, ledgerstate.UnlockBlocks{unlockBlock})))
+
+	require.NoError(t, unlockBlock.Merge(partialUnlockBlocks[1]))
+	assert.True(t, ledgerstate.UnlockBlocksValid(ledgerstate.Outputs{input}, ledgerstate.NewTransaction(txEssence, ledgerstate.UnlockBlocks{unlockBlock})))
+}
+
+func TestWallet_DestroyAsset(t *testing.T) {
+	seed := walletseed.NewSeed()
+	assetColor := ledgerstate.Color{1}
+	consumedOutput := &Output{
+		Address:        seed.Address(0),
+		OutputID:       ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0),
+		Balances:       ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 50, assetColor: 100}),
+		InclusionState: InclusionState{Liked: true, Confirmed: true},
+	}
+	wallet := New(Import(seed, 1, []bitmask.BitMask{}, NewAssetRegistry()), GenericConnector(newMockConnector(consumedOutput)))
+
+	_, err := wallet.DestroyAsset(ledgerstate.ColorIOTA, 10)
+	assert.Error(t, err)
+	_, err = wallet.DestroyAsset(assetColor, 101)
+	assert.Error(t, err)
+
+	tx, err := wallet.DestroyAsset(assetColor, 60)
+	require.NoError(t, err)
+	require.Len(t, tx.Essence().Outputs(), 1)
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 110, assetColor: 40}, tx.Essence().Outputs()[0].Balances().Map())
+	assert.True(t, ledgerstate.TransactionBalancesValid(ledgerstate.Outputs{
+		ledgerstate.NewSigLockedColoredOutput(consumedOutput.Balances, consumedOutput.Address.Address()).SetID(consumedOutput.OutputID),
+	}, tx.Essence().Outputs()))
+}
+
 type mockConnector struct {
-	outputs map[address.Address]map[ledgerstate.OutputID]*Output
+	outputs          map[address.Address]map[ledgerstate.OutputID]*Output
+	transactions     map[ledgerstate.TransactionID]InclusionState
+	sentTransactions int
 }
 
 func (connector *mockConnector) RequestFaucetFunds(addr walletaddr.Address) (err error) {
@@ -160,6 +219,9 @@ func (connector *mockConnector) RequestFaucetFunds(addr walletaddr.Address) (err
 }
 
 func (connector *mockConnector) SendTransaction(tx *ledgerstate.Transaction) (err error) {
+	connector.transactions[tx.ID()] = InclusionState{}
+	connector.sentTransactions++
+
 	// mark outputs as spent
 	//for _, input := range tx.Essence().Inputs() {
 	//if input.Type() == ledgerstate.UTXOInputType {
@@ -204,7 +266,8 @@ func (connector *mockConnector) SendTransaction(tx *ledgerstate.Transaction) (er
 
 func newMockConnector(outputs ...*Output) (connector *mockConnector) {
 	connector = &mockConnector{
-		outputs: make(map[address.Address]map[ledgerstate.OutputID]*Output),
+		outputs:      make(map[address.Address]map[ledgerstate.OutputID]*Output),
+		transactions: make(map[ledgerstate.TransactionID]InclusionState),
 	}
 
 	for _, output := range outputs {
@@ -234,3 +297,12 @@ func (connector *mockConnector) UnspentOutputs(addresses ...walletaddr.Address)
 
 	return
 }
+
+func (connector *mockConnector) TransactionInclusionState(transactionID ledgerstate.TransactionID) (inclusionState InclusionState, err error) {
+	inclusionState, exists := connector.transactions[transactionID]
+	if !exists {
+		err = ErrTransactionNotFound
+	}
+
+	return
+}
@@ -1,6 +1,9 @@
 package wallet
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/iotaledger/goshimmer/client"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
@@ -33,6 +36,20 @@ func (webConnector *WebConnector) ServerStatus() (status ServerStatus, err error
 	return
 }
 
+// AssetSupply retrieves the amount of minted and destroyed tokens of the given color.
+func (webConnector *WebConnector) AssetSupply(color ledgerstate.Color) (supply AssetSupply, err error) {
+	response, err := webConnector.client.GetColorSupply(color.Base58())
+	if err != nil {
+		return
+	}
+
+	supply.Minted = response.Minted
+	supply.Destroyed = response.Destroyed
+	supply.Supply = response.Supply
+
+	return
+}
+
 // RequestFaucetFunds request some funds from the faucet for test purposes.
 func (webConnector *WebConnector) RequestFaucetFunds(addr address.Address) (err error) {
 	_, err = webConnector.client.SendFaucetRequest(addr.Address().Base58())
@@ -115,6 +132,28 @@ func (webConnector WebConnector) SendTransaction(tx *ledgerstate.Transaction) (e
 	return
 }
 
+// TransactionInclusionState returns the inclusion state of the given transaction. It returns an ErrTransactionNotFound if
+// the node does not know the transaction.
+func (webConnector WebConnector) TransactionInclusionState(transactionID ledgerstate.TransactionID) (inclusionState InclusionState, err error) {
+	response, err := webConnector.client.GetTransactionByID(transactionID.Base58())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			err = fmt.Errorf("%w: %s", ErrTransactionNotFound, transactionID.Base58())
+		}
+
+		return
+	}
+
+	inclusionState = InclusionState{
+		Liked:       response.InclusionState.Liked,
+		Confirmed:   response.InclusionState.Confirmed,
+		Rejected:    response.InclusionState.Rejected,
+		Conflicting: response.InclusionState.Conflicting,
+	}
+
+	return
+}
+
 // colorFromString is an internal utility method that parses the given string into a Color.
 func colorFromString(colorStr string) (color ledgerstate.Color) {
 	if colorStr == "IOTA" {
@@ -18,4 +18,7 @@ const (
 
 	// PrefixLedgerState defines the storage prefix for the ledgerstate package.
 	PrefixLedgerState
+
+	// PrefixMana defines the storage prefix for the mana package.
+	PrefixMana
 )
@@ -13,6 +13,10 @@ var (
 	ErrDuplicateNeighbor = errors.New("already connected")
 	// ErrInvalidPacket is returned when the gossip manager receives an invalid packet.
 	ErrInvalidPacket = errors.New("invalid packet")
+	// ErrManualPeerExists is returned when a peer is added more than once as a manual peer.
+	ErrManualPeerExists = errors.New("manual peer already exists")
+	// ErrUnknownManualPeer is returned when the specified peer was not added as a manual peer.
+	ErrUnknownManualPeer = errors.New("unknown manual peer")
 	// ErrNeighborQueueFull is returned when the send queue is already full.
 	ErrNeighborQueueFull = errors.New("send queue is full")
 )
@@ -1,6 +1,7 @@
 package gossip
 
 import (
+	pb "github.com/iotaledger/goshimmer/packages/gossip/proto"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/events"
 )
@@ -15,6 +16,8 @@ type Events struct {
 	NeighborRemoved *events.Event
 	// Fired when a new message was received via the gossip protocol.
 	MessageReceived *events.Event
+	// Fired when a neighbor sent a heartbeat.
+	HeartbeatReceived *events.Event
 }
 
 // MessageReceivedEvent holds data about a message received event.
@@ -25,6 +28,14 @@ type MessageReceivedEvent struct {
 	Peer *peer.Peer
 }
 
+// HeartbeatReceivedEvent holds data about a heartbeat received event.
+type HeartbeatReceivedEvent struct {
+	// The received heartbeat.
+	Heartbeat *pb.Heartbeat
+	// The sender of the heartbeat.
+	Peer *peer.Peer
+}
+
 func peerAndErrorCaller(handler interface{}, params ...interface{}) {
 	handler.(func(*peer.Peer, error))(params[0].(*peer.Peer), params[1].(error))
 }
@@ -36,3 +47,7 @@ func neighborCaller(handler interface{}, params ...interface{}) {
 func messageReceived(handler interface{}, params ...interface{}) {
 	handler.(func(*MessageReceivedEvent))(params[0].(*MessageReceivedEvent))
 }
+
+func heartbeatReceived(handler interface{}, params ...interface{}) {
+	handler.(func(*HeartbeatReceivedEvent))(params[0].(*HeartbeatReceivedEvent))
+}
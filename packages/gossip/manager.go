@@ -20,6 +20,8 @@ import (
 const (
 	// maxPacketSize defines the maximum packet size allowed for gossip and bufferedconn.
 	maxPacketSize = 65 * 1024
+	// MaxBatchRequestSize defines the maximum number of message IDs that are requested in a single packet.
+	MaxBatchRequestSize = 100
 )
 
 var (
@@ -59,10 +61,11 @@ func NewManager(local *peer.Local, f LoadMessageFunc, log *logger.Logger) *Manag
 		loadMessageFunc: f,
 		log:             log,
 		events: Events{
-			ConnectionFailed: events.NewEvent(peerAndErrorCaller),
-			NeighborAdded:    events.NewEvent(neighborCaller),
-			NeighborRemoved:  events.NewEvent(neighborCaller),
-			MessageReceived:  events.NewEvent(messageReceived),
+			ConnectionFailed:  events.NewEvent(peerAndErrorCaller),
+			NeighborAdded:     events.NewEvent(neighborCaller),
+			NeighborRemoved:   events.NewEvent(neighborCaller),
+			MessageReceived:   events.NewEvent(messageReceived),
+			HeartbeatReceived: events.NewEvent(heartbeatReceived),
 		},
 		srv:       nil,
 		neighbors: make(map[identity.ID]*Neighbor),
@@ -77,7 +80,12 @@ func NewManager(local *peer.Local, f LoadMessageFunc, log *logger.Logger) *Manag
 
 	m.messageRequestWorkerPool = workerpool.New(func(task workerpool.Task) {
 
-		m.processMessageRequest(task.Param(0).([]byte), task.Param(1).(*Neighbor))
+		data, nbr := task.Param(0).([]byte), task.Param(1).(*Neighbor)
+		if pb.PacketType(data[0]) == pb.PacketMessageBatchRequest {
+			m.processMessageBatchRequest(data, nbr)
+		} else {
+			m.processMessageRequest(data, nbr)
+		}
 
 		task.Return(nil)
 	}, workerpool.WorkerCount(messageRequestWorkerCount), workerpool.QueueSize(messageRequestWorkerQueueSize))
@@ -124,30 +132,26 @@ func (m *Manager) stop() {
 
 // AddOutbound tries to add a neighbor by connecting to that peer.
 func (m *Manager) AddOutbound(p *peer.Peer) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	if p.ID() == m.local.ID() {
 		return ErrLoopbackNeighbor
 	}
-	if m.srv == nil {
+	srv := m.server()
+	if srv == nil {
 		return ErrNotRunning
 	}
-	return m.addNeighbor(p, m.srv.DialPeer)
+	return m.addNeighbor(p, srv.DialPeer)
 }
 
 // AddInbound tries to add a neighbor by accepting an incoming connection from that peer.
 func (m *Manager) AddInbound(p *peer.Peer) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	if p.ID() == m.local.ID() {
 		return ErrLoopbackNeighbor
 	}
-	if m.srv == nil {
+	srv := m.server()
+	if srv == nil {
 		return ErrNotRunning
 	}
-	return m.addNeighbor(p, m.srv.AcceptPeer)
+	return m.addNeighbor(p, srv.AcceptPeer)
 }
 
 // DropNeighbor disconnects the neighbor with the given ID.
@@ -168,7 +172,32 @@ func (m *Manager) DropNeighbor(id identity.ID) error {
 // If no peer is provided, all neighbors are queried.
 func (m *Manager) RequestMessage(messageID []byte, to ...identity.ID) {
 	msgReq := &pb.MessageRequest{Id: messageID}
-	m.send(marshal(msgReq), to...)
+	for _, nbr := range m.send(marshal(msgReq), to...) {
+		nbr.stats.countRequests(messageID)
+	}
+}
+
+// RequestMessages requests the messages with the given ids from the neighbors using as few packets as possible.
+// If no peer is provided, all neighbors are queried.
+func (m *Manager) RequestMessages(messageIDs [][]byte, to ...identity.ID) {
+	for len(messageIDs) > 0 {
+		batchSize := len(messageIDs)
+		if batchSize > MaxBatchRequestSize {
+			batchSize = MaxBatchRequestSize
+		}
+
+		msgReq := &pb.MessageBatchRequest{Ids: messageIDs[:batchSize]}
+		for _, nbr := range m.send(marshal(msgReq), to...) {
+			nbr.stats.countRequests(messageIDs[:batchSize]...)
+		}
+		messageIDs = messageIDs[batchSize:]
+	}
+}
+
+// SendHeartbeat adds the given heartbeat to the send queue of the neighbors.
+// If no peer is provided, it is send to all neighbors.
+func (m *Manager) SendHeartbeat(heartbeat *pb.Heartbeat, to ...identity.ID) {
+	m.send(marshal(heartbeat), to...)
 }
 
 // SendMessage adds the given message the send queue of the neighbors.
@@ -190,6 +219,34 @@ func (m *Manager) AllNeighbors() []*Neighbor {
 	return result
 }
 
+// Neighbor returns the connected neighbor with the given ID.
+func (m *Manager) Neighbor(id identity.ID) (*Neighbor, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	nbr, ok := m.neighbors[id]
+	if !ok {
+		return nil, ErrUnknownNeighbor
+	}
+	return nbr, nil
+}
+
+// IsNeighbor returns whether the peer with the given ID is currently connected.
+func (m *Manager) IsNeighbor(id identity.ID) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, ok := m.neighbors[id]
+	return ok
+}
+
+func (m *Manager) server() *server.TCP {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.srv
+}
+
 func (m *Manager) getNeighbors(ids ...identity.ID) []*Neighbor {
 	if len(ids) > 0 {
 		return m.getNeighborsByID(ids)
@@ -211,16 +268,20 @@ func (m *Manager) getNeighborsByID(ids []identity.ID) []*Neighbor {
 	return result
 }
 
-func (m *Manager) send(b []byte, to ...identity.ID) {
-	neighbors := m.getNeighbors(to...)
+// send writes the data to the given neighbors (or all neighbors if none are given) and returns the neighbors.
+func (m *Manager) send(b []byte, to ...identity.ID) (neighbors []*Neighbor) {
+	neighbors = m.getNeighbors(to...)
 
 	for _, nbr := range neighbors {
 		if _, err := nbr.Write(b); err != nil {
 			m.log.Warnw("send error", "peer-id", nbr.ID(), "err", err)
 		}
 	}
+
+	return
 }
 
+// addNeighbor establishes the connection without holding the lock, as accepting a connection can take several seconds.
 func (m *Manager) addNeighbor(peer *peer.Peer, connectorFunc func(*peer.Peer) (net.Conn, error)) error {
 	conn, err := connectorFunc(peer)
 	if err != nil {
@@ -228,6 +289,15 @@ func (m *Manager) addNeighbor(peer *peer.Peer, connectorFunc func(*peer.Peer) (n
 		return err
 	}
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// the manager might have been stopped while the connection was established
+	if m.srv == nil {
+		_ = conn.Close()
+		m.events.ConnectionFailed.Trigger(peer, ErrNotRunning)
+		return ErrNotRunning
+	}
 	if _, ok := m.neighbors[peer.ID()]; ok {
 		_ = conn.Close()
 		m.events.ConnectionFailed.Trigger(peer, ErrDuplicateNeighbor)
@@ -268,10 +338,17 @@ func (m *Manager) handlePacket(data []byte, nbr *Neighbor) error {
 		if _, added := m.messageWorkerPool.TrySubmit(data, nbr); !added {
 			return fmt.Errorf("messageWorkerPool full: packet message discarded")
 		}
-	case pb.PacketMessageRequest:
+	case pb.PacketMessageRequest, pb.PacketMessageBatchRequest:
 		if _, added := m.messageRequestWorkerPool.TrySubmit(data, nbr); !added {
 			return fmt.Errorf("messageRequestWorkerPool full: message request discarded")
 		}
+	case pb.PacketHeartbeat:
+		packet := new(pb.Heartbeat)
+		if err := proto.Unmarshal(data[1:], packet); err != nil {
+			return fmt.Errorf("invalid heartbeat: %w", err)
+		}
+		nbr.setHeartbeat(packet)
+		m.events.HeartbeatReceived.Trigger(&HeartbeatReceivedEvent{Heartbeat: packet, Peer: nbr.Peer})
 
 	default:
 		return ErrInvalidPacket
@@ -312,21 +389,53 @@ func (m *Manager) processPacketMessage(data []byte, nbr *Neighbor) {
 }
 
 func (m *Manager) processMessageRequest(data []byte, nbr *Neighbor) {
+	nbr.stats.requestsReceived.Inc()
+
 	packet := new(pb.MessageRequest)
 	if err := proto.Unmarshal(data[1:], packet); err != nil {
 		m.log.Debugw("invalid packet", "err", err)
+		return
+	}
+
+	m.answerMessageRequest(packet.GetId(), nbr)
+}
+
+func (m *Manager) processMessageBatchRequest(data []byte, nbr *Neighbor) {
+	packet := new(pb.MessageBatchRequest)
+	if err := proto.Unmarshal(data[1:], packet); err != nil {
+		nbr.stats.requestsReceived.Inc()
+		m.log.Debugw("invalid packet", "err", err)
+		return
+	}
+
+	// ignore the ids that exceed the maximum batch size
+	ids := packet.GetIds()
+	if len(ids) > MaxBatchRequestSize {
+		m.log.Debugw("message batch request too large", "size", len(ids))
+		ids = ids[:MaxBatchRequestSize]
 	}
 
-	msgID, _, err := tangle.MessageIDFromBytes(packet.GetId())
+	nbr.stats.requestsReceived.Add(uint64(len(ids)))
+	for _, id := range ids {
+		m.answerMessageRequest(id, nbr)
+	}
+}
+
+// answerMessageRequest sends the requested message to the neighbor, if it exists.
+func (m *Manager) answerMessageRequest(id []byte, nbr *Neighbor) {
+	msgID, _, err := tangle.MessageIDFromBytes(id)
 	if err != nil {
 		m.log.Debugw("invalid message id:", "err", err)
+		return
 	}
 
 	msgBytes, err := m.loadMessageFunc(msgID)
 	if err != nil {
 		m.log.Debugw("error loading message", "msg-id", msgID, "err", err)
+		return
 	}
 
 	// send the loaded message directly to the neighbor
 	_, _ = nbr.Write(marshal(&pb.Message{Data: msgBytes}))
+	nbr.stats.requestsAnswered.Inc()
 }
@@ -346,6 +346,130 @@ func TestMessageRequest(t *testing.T) {
 	mgrA.RequestMessage(b)
 	time.Sleep(graceTime)
 
+	neighborB, err := mgrA.Neighbor(peerB.ID())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, neighborB.Stats().RequestsSent())
+	neighborA, err := mgrB.Neighbor(peerA.ID())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, neighborA.Stats().RequestsReceived())
+	assert.EqualValues(t, 1, neighborA.Stats().RequestsAnswered())
+
+	mgrA.On("neighborRemoved", mock.Anything).Once()
+	mgrB.On("neighborRemoved", mock.Anything).Once()
+
+	closeA()
+	closeB()
+	time.Sleep(graceTime)
+
+	mgrA.AssertExpectations(t)
+	mgrB.AssertExpectations(t)
+}
+
+func TestMessageBatchRequest(t *testing.T) {
+	mgrA, closeA, peerA := newMockedManager(t, "A")
+	mgrB, closeB, peerB := newMockedManager(t, "B")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// connect in the following way
+	// B -> A
+	mgrA.On("neighborAdded", mock.Anything).Once()
+	mgrB.On("neighborAdded", mock.Anything).Once()
+
+	go func() {
+		defer wg.Done()
+		err := mgrA.AddInbound(peerB)
+		assert.NoError(t, err)
+	}()
+	time.Sleep(graceTime)
+	go func() {
+		defer wg.Done()
+		err := mgrB.AddOutbound(peerA)
+		assert.NoError(t, err)
+	}()
+
+	// wait for the connections to establish
+	wg.Wait()
+
+	// the ids are split into two batches
+	ids := make([][]byte, MaxBatchRequestSize+1)
+	for i := range ids {
+		id := tangle.MessageID{byte(i)}
+		ids[i] = id[:]
+	}
+
+	// mgrA should eventually receive every requested message
+	mgrA.On("messageReceived", &MessageReceivedEvent{Data: testMessageData, Peer: peerB}).Times(len(ids))
+
+	mgrA.RequestMessages(ids)
+	time.Sleep(graceTime)
+
+	neighborB, err := mgrA.Neighbor(peerB.ID())
+	require.NoError(t, err)
+	assert.EqualValues(t, len(ids), neighborB.Stats().RequestsSent())
+	assert.EqualValues(t, 2, neighborB.Stats().PacketsSent())
+	neighborA, err := mgrB.Neighbor(peerA.ID())
+	require.NoError(t, err)
+	assert.EqualValues(t, len(ids), neighborA.Stats().RequestsReceived())
+	assert.EqualValues(t, len(ids), neighborA.Stats().RequestsAnswered())
+
+	mgrA.On("neighborRemoved", mock.Anything).Once()
+	mgrB.On("neighborRemoved", mock.Anything).Once()
+
+	closeA()
+	closeB()
+	time.Sleep(graceTime)
+
+	mgrA.AssertExpectations(t)
+	mgrB.AssertExpectations(t)
+}
+
+func TestHeartbeat(t *testing.T) {
+	mgrA, closeA, peerA := newMockedManager(t, "A")
+	mgrB, closeB, peerB := newMockedManager(t, "B")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// connect in the following way
+	// B -> A
+	mgrA.On("neighborAdded", mock.Anything).Once()
+	mgrB.On("neighborAdded", mock.Anything).Once()
+
+	go func() {
+		defer wg.Done()
+		err := mgrA.AddInbound(peerB)
+		assert.NoError(t, err)
+	}()
+	time.Sleep(graceTime)
+	go func() {
+		defer wg.Done()
+		err := mgrB.AddOutbound(peerA)
+		assert.NoError(t, err)
+	}()
+
+	// wait for the connections to establish
+	wg.Wait()
+
+	heartbeat := &pb.Heartbeat{
+		SolidMarkers: []*pb.Marker{{SequenceId: 1, Index: 42, MessageId: testMessageData}},
+		TipsDigest:   []byte("digest"),
+		Tips:         [][]byte{testMessageData},
+		Synced:       true,
+	}
+
+	// mgrB should eventually receive the heartbeat
+	mgrB.On("heartbeatReceived", mock.Anything).Once()
+
+	mgrA.SendHeartbeat(heartbeat)
+	time.Sleep(graceTime)
+
+	neighborA, err := mgrB.Neighbor(peerA.ID())
+	require.NoError(t, err)
+	received, _ := neighborA.LastHeartbeat()
+	assert.True(t, proto.Equal(heartbeat, received))
+
 	mgrA.On("neighborRemoved", mock.Anything).Once()
 	mgrB.On("neighborRemoved", mock.Anything).Once()
 
@@ -465,6 +589,7 @@ func mockManager(t mock.TestingT, mgr *Manager) *mockedManager {
 	e.Events().NeighborAdded.Attach(events.NewClosure(e.neighborAdded))
 	e.Events().NeighborRemoved.Attach(events.NewClosure(e.neighborRemoved))
 	e.Events().MessageReceived.Attach(events.NewClosure(e.messageReceived))
+	e.Events().HeartbeatReceived.Attach(events.NewClosure(e.heartbeatReceived))
 
 	return e
 }
@@ -474,7 +599,8 @@ type mockedManager struct {
 	*Manager
 }
 
-func (e *mockedManager) connectionFailed(p *peer.Peer, err error) { e.Called(p, err) }
-func (e *mockedManager) neighborAdded(n *Neighbor)                { e.Called(n) }
-func (e *mockedManager) neighborRemoved(n *Neighbor)              { e.Called(n) }
-func (e *mockedManager) messageReceived(ev *MessageReceivedEvent) { e.Called(ev) }
+func (e *mockedManager) connectionFailed(p *peer.Peer, err error)     { e.Called(p, err) }
+func (e *mockedManager) neighborAdded(n *Neighbor)                    { e.Called(n) }
+func (e *mockedManager) neighborRemoved(n *Neighbor)                  { e.Called(n) }
+func (e *mockedManager) messageReceived(ev *MessageReceivedEvent)     { e.Called(ev) }
+func (e *mockedManager) heartbeatReceived(ev *HeartbeatReceivedEvent) { e.Called(ev) }
@@ -0,0 +1,222 @@
+package gossip
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/gossip/server"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/types"
+)
+
+const (
+	// minReconnectDelay defines the delay before the first attempt to re-establish a failed connection to a manual peer.
+	minReconnectDelay = 1 * time.Second
+	// maxReconnectDelay defines the maximum delay between two attempts to connect to a manual peer.
+	maxReconnectDelay = 30 * time.Second
+)
+
+// region ManualPeering ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ManualPeering keeps the Manager connected to a static set of peers that are configured independently of the
+// autopeering. Since the gossip server only accepts connections from expected peers, both sides need to add each other:
+// the peer with the smaller public key dials, while the other one waits for the incoming connection. Lost or failed
+// connections are re-established with an exponential backoff.
+type ManualPeering struct {
+	manager *Manager
+	log     *logger.Logger
+
+	peers   map[identity.ID]*manualPeer
+	closed  bool
+	mutex   sync.RWMutex
+	wg      sync.WaitGroup
+	closure *events.Closure
+}
+
+// NewManualPeering is the constructor of the ManualPeering.
+func NewManualPeering(manager *Manager, log *logger.Logger) (manualPeering *ManualPeering) {
+	manualPeering = &ManualPeering{
+		manager: manager,
+		log:     log,
+		peers:   make(map[identity.ID]*manualPeer),
+	}
+	manualPeering.closure = events.NewClosure(manualPeering.onNeighborRemoved)
+	manager.Events().NeighborRemoved.Attach(manualPeering.closure)
+
+	return
+}
+
+// AddPeer adds the given peer to the manual peers and starts to connect to it in the background.
+func (m *ManualPeering) AddPeer(p *peer.Peer) error {
+	if !IsSupported(p) {
+		return server.ErrNoGossip
+	}
+	if p.ID() == m.manager.local.ID() {
+		return ErrLoopbackNeighbor
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.closed {
+		return ErrNotRunning
+	}
+	if _, exists := m.peers[p.ID()]; exists {
+		return ErrManualPeerExists
+	}
+
+	manualPeer := newManualPeer(p)
+	m.peers[p.ID()] = manualPeer
+
+	m.wg.Add(1)
+	go m.keepConnected(manualPeer)
+
+	return nil
+}
+
+// RemovePeer removes the peer with the given ID from the manual peers and drops the connection to it.
+func (m *ManualPeering) RemovePeer(id identity.ID) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	manualPeer, exists := m.peers[id]
+	if !exists {
+		return ErrUnknownManualPeer
+	}
+	delete(m.peers, id)
+	close(manualPeer.stop)
+
+	return nil
+}
+
+// IsManualPeer returns whether the peer with the given ID was added as a manual peer.
+func (m *ManualPeering) IsManualPeer(id identity.ID) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	_, exists := m.peers[id]
+	return exists
+}
+
+// Peers returns all manual peers.
+func (m *ManualPeering) Peers() (peers []*peer.Peer) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	peers = make([]*peer.Peer, 0, len(m.peers))
+	for _, manualPeer := range m.peers {
+		peers = append(peers, manualPeer.Peer)
+	}
+
+	return
+}
+
+// Close stops connecting to the manual peers and waits until all background routines are done.
+func (m *ManualPeering) Close() {
+	m.mutex.Lock()
+	m.closed = true
+	for id, manualPeer := range m.peers {
+		delete(m.peers, id)
+		close(manualPeer.stop)
+	}
+	m.mutex.Unlock()
+
+	m.wg.Wait()
+	m.manager.Events().NeighborRemoved.Detach(m.closure)
+}
+
+// keepConnected (re-)establishes the connection to the manual peer until it is removed.
+func (m *ManualPeering) keepConnected(manualPeer *manualPeer) {
+	defer m.wg.Done()
+
+	reconnectDelay := minReconnectDelay
+	for {
+		select {
+		case <-manualPeer.stop:
+			_ = m.manager.DropNeighbor(manualPeer.ID())
+			return
+		default:
+		}
+
+		if m.manager.IsNeighbor(manualPeer.ID()) {
+			reconnectDelay = minReconnectDelay
+
+			select {
+			case <-manualPeer.stop:
+			case <-manualPeer.disconnected:
+			}
+			continue
+		}
+
+		err := m.connect(manualPeer.Peer)
+		switch {
+		case err == nil, errors.Is(err, ErrDuplicateNeighbor):
+			continue
+		case errors.Is(err, server.ErrTimeout):
+			// the peer did not dial within the accept timeout, so we keep waiting for it
+			continue
+		}
+
+		m.log.Debugw("error connecting to manual peer", "id", manualPeer.ID(), "err", err, "retry", reconnectDelay)
+		select {
+		case <-manualPeer.stop:
+		case <-time.After(reconnectDelay):
+			if reconnectDelay *= 2; reconnectDelay > maxReconnectDelay {
+				reconnectDelay = maxReconnectDelay
+			}
+		}
+	}
+}
+
+// connect tries to establish a connection to the given peer. The peer with the smaller public key dials.
+func (m *ManualPeering) connect(p *peer.Peer) error {
+	if bytes.Compare(m.manager.local.PublicKey().Bytes(), p.PublicKey().Bytes()) < 0 {
+		return m.manager.AddOutbound(p)
+	}
+
+	return m.manager.AddInbound(p)
+}
+
+// onNeighborRemoved notifies the background routine of a manual peer that the connection was lost.
+func (m *ManualPeering) onNeighborRemoved(neighbor *Neighbor) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	manualPeer, exists := m.peers[neighbor.ID()]
+	if !exists {
+		return
+	}
+
+	select {
+	case manualPeer.disconnected <- types.Void:
+	default:
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region manualPeer ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// manualPeer contains the signals that control the background routine of a single manual peer.
+type manualPeer struct {
+	*peer.Peer
+
+	disconnected chan types.Empty
+	stop         chan types.Empty
+}
+
+// newManualPeer is the constructor of the manualPeer.
+func newManualPeer(p *peer.Peer) *manualPeer {
+	return &manualPeer{
+		Peer:         p,
+		disconnected: make(chan types.Empty, 1),
+		stop:         make(chan types.Empty),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
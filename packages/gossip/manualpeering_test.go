@@ -0,0 +1,43 @@
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManualPeering(t *testing.T) {
+	mgrA, closeA, peerA := newTestManager(t, "A")
+	defer closeA()
+	mgrB, closeB, peerB := newTestManager(t, "B")
+	defer closeB()
+
+	manualPeeringA := NewManualPeering(mgrA, log.Named("A"))
+	defer manualPeeringA.Close()
+	manualPeeringB := NewManualPeering(mgrB, log.Named("B"))
+	defer manualPeeringB.Close()
+
+	assert.Equal(t, ErrLoopbackNeighbor, manualPeeringA.AddPeer(peerA))
+	require.NoError(t, manualPeeringA.AddPeer(peerB))
+	require.NoError(t, manualPeeringB.AddPeer(peerA))
+	assert.Equal(t, ErrManualPeerExists, manualPeeringA.AddPeer(peerB))
+	assert.True(t, manualPeeringA.IsManualPeer(peerB.ID()))
+	assert.Len(t, manualPeeringA.Peers(), 1)
+
+	connected := func() bool { return mgrA.IsNeighbor(peerB.ID()) && mgrB.IsNeighbor(peerA.ID()) }
+	require.Eventually(t, connected, 5*time.Second, graceTime)
+
+	// the connection is re-established after it was lost
+	require.NoError(t, mgrA.DropNeighbor(peerB.ID()))
+	require.Eventually(t, connected, 5*time.Second, graceTime)
+
+	// removing the manual peer drops the connection
+	require.NoError(t, manualPeeringA.RemovePeer(peerB.ID()))
+	assert.Equal(t, ErrUnknownManualPeer, manualPeeringA.RemovePeer(peerB.ID()))
+	assert.False(t, manualPeeringA.IsManualPeer(peerB.ID()))
+	require.Eventually(t, func() bool {
+		return !mgrA.IsNeighbor(peerB.ID()) && !mgrB.IsNeighbor(peerA.ID())
+	}, 5*time.Second, graceTime)
+}
@@ -7,7 +7,9 @@ import (
 	"sync"
 	"time"
 
+	pb "github.com/iotaledger/goshimmer/packages/gossip/proto"
 	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/netutil"
 	"github.com/iotaledger/hive.go/netutil/buffconn"
@@ -28,12 +30,17 @@ type Neighbor struct {
 	log             *logger.Logger
 	queue           chan []byte
 	messagesDropped atomic.Int32
+	stats           *NeighborStats
 
 	wg             sync.WaitGroup
 	closing        chan struct{}
 	disconnectOnce sync.Once
 
 	connectionEstablished time.Time
+
+	heartbeat         *pb.Heartbeat
+	heartbeatReceived time.Time
+	heartbeatMutex    sync.RWMutex
 }
 
 // NewNeighbor creates a new neighbor from the provided peer and connection.
@@ -49,14 +56,20 @@ func NewNeighbor(peer *peer.Peer, conn net.Conn, log *logger.Logger) *Neighbor {
 		"addr", conn.RemoteAddr().String(),
 	)
 
-	return &Neighbor{
+	n := &Neighbor{
 		Peer:                  peer,
 		BufferedConnection:    buffconn.NewBufferedConnection(conn, maxPacketSize),
 		log:                   log,
 		queue:                 make(chan []byte, neighborQueueSize),
+		stats:                 &NeighborStats{},
 		closing:               make(chan struct{}),
 		connectionEstablished: time.Now(),
 	}
+	n.BufferedConnection.Events.ReceiveMessage.Attach(events.NewClosure(func([]byte) {
+		n.stats.packetsReceived.Inc()
+	}))
+
+	return n
 }
 
 // ConnectionEstablished returns the connection established.
@@ -64,6 +77,23 @@ func (n *Neighbor) ConnectionEstablished() time.Time {
 	return n.connectionEstablished
 }
 
+// LastHeartbeat returns the latest heartbeat of the neighbor together with the time it was received. It returns nil if
+// the neighbor did not send a heartbeat yet.
+func (n *Neighbor) LastHeartbeat() (heartbeat *pb.Heartbeat, received time.Time) {
+	n.heartbeatMutex.RLock()
+	defer n.heartbeatMutex.RUnlock()
+
+	return n.heartbeat, n.heartbeatReceived
+}
+
+func (n *Neighbor) setHeartbeat(heartbeat *pb.Heartbeat) {
+	n.heartbeatMutex.Lock()
+	defer n.heartbeatMutex.Unlock()
+
+	n.heartbeat = heartbeat
+	n.heartbeatReceived = time.Now()
+}
+
 // Listen starts the communication to the neighbor.
 func (n *Neighbor) Listen() {
 	n.wg.Add(2)
@@ -83,6 +113,11 @@ func (n *Neighbor) Close() error {
 	return err
 }
 
+// Stats returns the statistics of the traffic that was exchanged with the neighbor.
+func (n *Neighbor) Stats() *NeighborStats {
+	return n.stats
+}
+
 // IsOutbound returns true if the neighbor is an outbound neighbor.
 func (n *Neighbor) IsOutbound() bool {
 	return GetAddress(n.Peer) == n.RemoteAddr().String()
@@ -110,6 +145,7 @@ func (n *Neighbor) writeLoop() {
 				_ = n.BufferedConnection.Close()
 				return
 			}
+			n.stats.packetsSent.Inc()
 		case <-n.closing:
 			return
 		}
@@ -157,6 +193,7 @@ func (n *Neighbor) Write(b []byte) (int, error) {
 	case <-n.closing:
 		return 0, nil
 	default:
+		n.stats.packetsDropped.Inc()
 		if n.messagesDropped.Inc() >= droppedMessagesThreshold {
 			n.messagesDropped.Store(0)
 			return 0, ErrNeighborQueueFull
@@ -61,6 +61,38 @@ func TestNeighborWrite(t *testing.T) {
 	assert.Eventually(t, func() bool { return atomic.LoadUint32(&count) == 1 }, time.Second, 10*time.Millisecond)
 }
 
+func TestNeighborStats(t *testing.T) {
+	a, b, teardown := newPipe()
+	defer teardown()
+
+	neighborA := newTestNeighbor("A", a)
+	defer neighborA.Close()
+	neighborA.Listen()
+
+	neighborB := newTestNeighbor("B", b)
+	defer neighborB.Close()
+	neighborB.Listen()
+
+	_, err := neighborA.Write(testData)
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool { return neighborB.Stats().PacketsReceived() == 1 }, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 1, neighborA.Stats().PacketsSent())
+	assert.EqualValues(t, 0, neighborA.Stats().PacketsReceived())
+
+	// only the answers to requests are used to measure the round trip time
+	neighborB.Stats().countRequests([]byte("requested"))
+	neighborB.Stats().CountNewMessage([]byte("unrequested"))
+	assert.Zero(t, neighborB.Stats().RoundTripTime())
+	time.Sleep(10 * time.Millisecond)
+	neighborB.Stats().CountNewMessage([]byte("requested"))
+	neighborB.Stats().CountDuplicateMessage()
+	assert.EqualValues(t, 1, neighborB.Stats().RequestsSent())
+	assert.EqualValues(t, 2, neighborB.Stats().NewMessages())
+	assert.EqualValues(t, 1, neighborB.Stats().DuplicateMessages())
+	assert.GreaterOrEqual(t, int64(neighborB.Stats().RoundTripTime()), int64(10*time.Millisecond))
+}
+
 func TestNeighborParallelWrite(t *testing.T) {
 	a, b, teardown := newPipe()
 	defer teardown()
@@ -0,0 +1,148 @@
+package gossip
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+const (
+	// roundTripTimeSmoothingFactor defines the weight of a new sample in the exponential moving average of the round
+	// trip time.
+	roundTripTimeSmoothingFactor = 0.1
+
+	// maxPendingRequests defines how many unanswered requests are remembered per neighbor to measure the round trip time.
+	maxPendingRequests = 1000
+
+	// pendingRequestTimeout defines after which time an unanswered request is no longer used to measure the round trip
+	// time.
+	pendingRequestTimeout = time.Minute
+)
+
+// NeighborStats keeps track of the traffic that was exchanged with a neighbor since the connection was established.
+type NeighborStats struct {
+	packetsSent       atomic.Uint64
+	packetsReceived   atomic.Uint64
+	packetsDropped    atomic.Uint64
+	newMessages       atomic.Uint64
+	duplicateMessages atomic.Uint64
+	requestsSent      atomic.Uint64
+	requestsReceived  atomic.Uint64
+	requestsAnswered  atomic.Uint64
+
+	roundTripTime   time.Duration
+	pendingRequests map[string]time.Time
+	mutex           sync.RWMutex
+}
+
+// PacketsSent returns the amount of packets that were written to the connection.
+func (s *NeighborStats) PacketsSent() uint64 {
+	return s.packetsSent.Load()
+}
+
+// PacketsReceived returns the amount of packets that were read from the connection.
+func (s *NeighborStats) PacketsReceived() uint64 {
+	return s.packetsReceived.Load()
+}
+
+// PacketsDropped returns the amount of packets that were dropped because the send queue was full.
+func (s *NeighborStats) PacketsDropped() uint64 {
+	return s.packetsDropped.Load()
+}
+
+// NewMessages returns the amount of received messages that were not known to the node, yet.
+func (s *NeighborStats) NewMessages() uint64 {
+	return s.newMessages.Load()
+}
+
+// DuplicateMessages returns the amount of received messages that were already known to the node.
+func (s *NeighborStats) DuplicateMessages() uint64 {
+	return s.duplicateMessages.Load()
+}
+
+// RequestsSent returns the amount of message requests that were sent to the neighbor.
+func (s *NeighborStats) RequestsSent() uint64 {
+	return s.requestsSent.Load()
+}
+
+// RequestsReceived returns the amount of message requests that were received from the neighbor.
+func (s *NeighborStats) RequestsReceived() uint64 {
+	return s.requestsReceived.Load()
+}
+
+// RequestsAnswered returns the amount of message requests of the neighbor that were answered with the message.
+func (s *NeighborStats) RequestsAnswered() uint64 {
+	return s.requestsAnswered.Load()
+}
+
+// RoundTripTime returns the moving average of the time between sending a message request to the neighbor and receiving
+// the requested message from it.
+func (s *NeighborStats) RoundTripTime() time.Duration {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.roundTripTime
+}
+
+// CountNewMessage records a received message that was not known to the node. If the message was requested from the
+// neighbor, the time since the request was sent is added to the round trip time.
+func (s *NeighborStats) CountNewMessage(messageID []byte) {
+	s.newMessages.Inc()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	requestTime, requested := s.pendingRequests[string(messageID)]
+	if !requested {
+		return
+	}
+	delete(s.pendingRequests, string(messageID))
+
+	roundTripTime := time.Since(requestTime)
+	if s.roundTripTime == 0 {
+		s.roundTripTime = roundTripTime
+		return
+	}
+	s.roundTripTime += time.Duration(roundTripTimeSmoothingFactor * float64(roundTripTime-s.roundTripTime))
+}
+
+// CountDuplicateMessage records a received message that was already known to the node.
+func (s *NeighborStats) CountDuplicateMessage() {
+	s.duplicateMessages.Inc()
+}
+
+// countRequests records the requests for the given messages that were sent to the neighbor.
+func (s *NeighborStats) countRequests(messageIDs ...[]byte) {
+	s.requestsSent.Add(uint64(len(messageIDs)))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.pendingRequests == nil {
+		s.pendingRequests = make(map[string]time.Time)
+	}
+
+	now := time.Now()
+	for _, messageID := range messageIDs {
+		if len(s.pendingRequests) >= maxPendingRequests {
+			s.prunePendingRequests(now)
+		}
+		if len(s.pendingRequests) >= maxPendingRequests {
+			return
+		}
+
+		if _, exists := s.pendingRequests[string(messageID)]; !exists {
+			s.pendingRequests[string(messageID)] = now
+		}
+	}
+}
+
+// prunePendingRequests removes the requests that were not answered in time.
+func (s *NeighborStats) prunePendingRequests(now time.Time) {
+	for messageID, requestTime := range s.pendingRequests {
+		if now.Sub(requestTime) > pendingRequestTimeout {
+			delete(s.pendingRequests, messageID)
+		}
+	}
+}
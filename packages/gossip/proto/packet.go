@@ -11,6 +11,8 @@ type PacketType uint
 const (
 	PacketMessage PacketType = 20 + iota
 	PacketMessageRequest
+	PacketMessageBatchRequest
+	PacketHeartbeat
 )
 
 // Packet extends the proto.Message interface with additional util functions.
@@ -34,3 +36,15 @@ func (m *MessageRequest) Name() string { return "message_request" }
 
 // Type returns the packet type id of the message request packet.
 func (m *MessageRequest) Type() PacketType { return PacketMessageRequest }
+
+// Name returns the name of the message batch request packet.
+func (m *MessageBatchRequest) Name() string { return "message_batch_request" }
+
+// Type returns the packet type id of the message batch request packet.
+func (m *MessageBatchRequest) Type() PacketType { return PacketMessageBatchRequest }
+
+// Name returns the name of the heartbeat packet.
+func (m *Heartbeat) Name() string { return "heartbeat" }
+
+// Type returns the packet type id of the heartbeat packet.
+func (m *Heartbeat) Type() PacketType { return PacketHeartbeat }
@@ -0,0 +1,292 @@
+package gossip
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+const (
+	// DefaultReputationThreshold defines the default score below which a peer gets blacklisted.
+	DefaultReputationThreshold = -100
+	// DefaultBlacklistCooldown defines the default duration for which a peer stays blacklisted.
+	DefaultBlacklistCooldown = 30 * time.Minute
+
+	// reputationInterval defines the interval in which the scores recover and duplicate floods are detected.
+	reputationInterval = 10 * time.Second
+	// reputationRecovery defines the factor by which the scores approach zero every reputationInterval.
+	reputationRecovery = 0.9
+	// duplicateAllowance defines how many duplicates a neighbor can send per reputationInterval on top of the messages
+	// that were new to the node before it is considered to flood.
+	duplicateAllowance = 100
+)
+
+// region Misbehavior //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Misbehavior represents a type of misbehavior of a neighbor that lowers its reputation.
+type Misbehavior uint8
+
+const (
+	// InvalidMessage denotes data that could not be parsed or that was rejected by the message filters.
+	InvalidMessage Misbehavior = iota
+	// InvalidPoW denotes a message whose proof of work does not satisfy the difficulty.
+	InvalidPoW
+	// InvalidSignature denotes a message whose signature is invalid.
+	InvalidSignature
+	// DuplicateFlood denotes a neighbor that sent considerably more duplicates than there were new messages.
+	DuplicateFlood
+)
+
+// misbehaviorPenalties contains the amount by which the score is lowered for every Misbehavior.
+var misbehaviorPenalties = map[Misbehavior]float64{
+	InvalidMessage:   10,
+	InvalidPoW:       20,
+	InvalidSignature: 20,
+	DuplicateFlood:   20,
+}
+
+// String returns a human readable version of the Misbehavior.
+func (m Misbehavior) String() string {
+	switch m {
+	case InvalidMessage:
+		return "InvalidMessage"
+	case InvalidPoW:
+		return "InvalidPoW"
+	case InvalidSignature:
+		return "InvalidSignature"
+	case DuplicateFlood:
+		return "DuplicateFlood"
+	default:
+		return "Unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Reputation ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Reputation keeps track of the misbehavior of the neighbors. Every Misbehavior lowers the score of a peer, while the
+// scores recover over time. Peers whose score falls below the threshold are blacklisted for a cooldown period.
+type Reputation struct {
+	// Events contains the events of the Reputation.
+	Events *ReputationEvents
+
+	manager         *Manager
+	options         *ReputationOptions
+	scores          map[identity.ID]float64
+	blacklist       map[identity.ID]time.Time
+	duplicateCounts map[identity.ID]*duplicateCount
+	mutex           sync.RWMutex
+
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+	wg           sync.WaitGroup
+}
+
+// NewReputation is the constructor of the Reputation.
+func NewReputation(manager *Manager, optionalOptions ...ReputationOption) *Reputation {
+	return &Reputation{
+		Events: &ReputationEvents{
+			PeerBlacklisted: events.NewEvent(peerBlacklistedCaller),
+		},
+		manager:         manager,
+		options:         newReputationOptions(optionalOptions),
+		scores:          make(map[identity.ID]float64),
+		blacklist:       make(map[identity.ID]time.Time),
+		duplicateCounts: make(map[identity.ID]*duplicateCount),
+		shutdown:        make(chan struct{}),
+	}
+}
+
+// Start starts the background routine that lets the scores recover and detects duplicate floods.
+func (r *Reputation) Start() {
+	r.wg.Add(1)
+	go r.run()
+}
+
+// Close stops the background routine.
+func (r *Reputation) Close() {
+	r.shutdownOnce.Do(func() {
+		close(r.shutdown)
+	})
+	r.wg.Wait()
+}
+
+// Penalize lowers the score of the given peer according to the Misbehavior and blacklists it if the score falls below
+// the threshold.
+func (r *Reputation) Penalize(id identity.ID, misbehavior Misbehavior) {
+	r.mutex.Lock()
+	if _, blacklisted := r.blacklist[id]; blacklisted {
+		r.mutex.Unlock()
+		return
+	}
+
+	r.scores[id] -= misbehaviorPenalties[misbehavior]
+	if r.scores[id] >= r.options.threshold {
+		r.mutex.Unlock()
+		return
+	}
+
+	delete(r.scores, id)
+	r.blacklist[id] = time.Now().Add(r.options.cooldown)
+	r.mutex.Unlock()
+
+	r.Events.PeerBlacklisted.Trigger(id, misbehavior)
+}
+
+// Score returns the current score of the given peer (0 means that there was no recent misbehavior).
+func (r *Reputation) Score(id identity.ID) float64 {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.scores[id]
+}
+
+// IsBlacklisted returns whether the given peer is currently blacklisted.
+func (r *Reputation) IsBlacklisted(id identity.ID) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	blacklistedUntil, blacklisted := r.blacklist[id]
+	return blacklisted && time.Now().Before(blacklistedUntil)
+}
+
+func (r *Reputation) run() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(reputationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.recover()
+			r.detectDuplicateFloods(r.manager.AllNeighbors())
+		case <-r.shutdown:
+			return
+		}
+	}
+}
+
+// recover lets the scores approach zero and removes the expired entries of the blacklist.
+func (r *Reputation) recover() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for id, score := range r.scores {
+		if score *= reputationRecovery; score > -1 {
+			delete(r.scores, id)
+			continue
+		}
+		r.scores[id] = score
+	}
+
+	now := time.Now()
+	for id, blacklistedUntil := range r.blacklist {
+		if now.After(blacklistedUntil) {
+			delete(r.blacklist, id)
+		}
+	}
+}
+
+// detectDuplicateFloods penalizes the neighbors that sent more duplicates since the last check than there were new
+// messages in total (an honest neighbor sends every message at most once).
+func (r *Reputation) detectDuplicateFloods(neighbors []*Neighbor) {
+	currentCounts := make(map[identity.ID]*duplicateCount, len(neighbors))
+	var newMessages uint64
+	for _, nbr := range neighbors {
+		currentCount := &duplicateCount{
+			stats:             nbr.Stats(),
+			newMessages:       nbr.Stats().NewMessages(),
+			duplicateMessages: nbr.Stats().DuplicateMessages(),
+		}
+		currentCounts[nbr.ID()] = currentCount
+
+		// the stats are reset with every new connection
+		if previousCount, exists := r.duplicateCounts[nbr.ID()]; exists && previousCount.stats == currentCount.stats {
+			newMessages += currentCount.newMessages - previousCount.newMessages
+		}
+	}
+
+	var flooders []identity.ID
+	for id, currentCount := range currentCounts {
+		previousCount, exists := r.duplicateCounts[id]
+		if !exists || previousCount.stats != currentCount.stats {
+			continue
+		}
+
+		if currentCount.duplicateMessages-previousCount.duplicateMessages > newMessages+duplicateAllowance {
+			flooders = append(flooders, id)
+		}
+	}
+	r.duplicateCounts = currentCounts
+
+	for _, id := range flooders {
+		r.Penalize(id, DuplicateFlood)
+	}
+}
+
+// duplicateCount contains the message counters of a neighbor at the time of the last duplicate flood detection.
+type duplicateCount struct {
+	stats             *NeighborStats
+	newMessages       uint64
+	duplicateMessages uint64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ReputationOptions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// ReputationOptions holds the options of the Reputation.
+type ReputationOptions struct {
+	threshold float64
+	cooldown  time.Duration
+}
+
+func newReputationOptions(optionalOptions []ReputationOption) *ReputationOptions {
+	result := &ReputationOptions{
+		threshold: DefaultReputationThreshold,
+		cooldown:  DefaultBlacklistCooldown,
+	}
+
+	for _, optionalOption := range optionalOptions {
+		optionalOption(result)
+	}
+
+	return result
+}
+
+// ReputationOption is a function which inits an option.
+type ReputationOption func(*ReputationOptions)
+
+// ReputationThreshold creates an option which sets the score below which a peer gets blacklisted.
+func ReputationThreshold(threshold float64) ReputationOption {
+	return func(args *ReputationOptions) {
+		args.threshold = threshold
+	}
+}
+
+// BlacklistCooldown creates an option which sets the duration for which a peer stays blacklisted.
+func BlacklistCooldown(cooldown time.Duration) ReputationOption {
+	return func(args *ReputationOptions) {
+		args.cooldown = cooldown
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ReputationEvents /////////////////////////////////////////////////////////////////////////////////////////////
+
+// ReputationEvents contains the events of the Reputation.
+type ReputationEvents struct {
+	// Fired when a peer was blacklisted because its score fell below the threshold.
+	PeerBlacklisted *events.Event
+}
+
+func peerBlacklistedCaller(handler interface{}, params ...interface{}) {
+	handler.(func(identity.ID, Misbehavior))(params[0].(identity.ID), params[1].(Misbehavior))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,75 @@
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReputation_Penalize(t *testing.T) {
+	reputation := NewReputation(nil, ReputationThreshold(-30), BlacklistCooldown(time.Hour))
+
+	var blacklisted []identity.ID
+	reputation.Events.PeerBlacklisted.Attach(events.NewClosure(func(id identity.ID, misbehavior Misbehavior) {
+		assert.Equal(t, InvalidSignature, misbehavior)
+		blacklisted = append(blacklisted, id)
+	}))
+
+	id := identity.GenerateIdentity().ID()
+	reputation.Penalize(id, InvalidMessage)
+	reputation.Penalize(id, InvalidMessage)
+	assert.Equal(t, float64(-20), reputation.Score(id))
+	assert.False(t, reputation.IsBlacklisted(id))
+
+	reputation.Penalize(id, InvalidSignature)
+	assert.True(t, reputation.IsBlacklisted(id))
+	assert.Equal(t, []identity.ID{id}, blacklisted)
+
+	// blacklisted peers are not penalized again
+	reputation.Penalize(id, InvalidSignature)
+	assert.Len(t, blacklisted, 1)
+}
+
+func TestReputation_Recover(t *testing.T) {
+	reputation := NewReputation(nil)
+
+	id := identity.GenerateIdentity().ID()
+	reputation.Penalize(id, InvalidMessage)
+	reputation.recover()
+	assert.Equal(t, -10*reputationRecovery, reputation.Score(id))
+
+	// the score is reset once it is close to zero
+	for i := 0; i < 30; i++ {
+		reputation.recover()
+	}
+	assert.Zero(t, reputation.Score(id))
+
+	// expired blacklist entries are removed
+	reputation.blacklist[id] = time.Now().Add(-time.Second)
+	assert.False(t, reputation.IsBlacklisted(id))
+	reputation.recover()
+	assert.Empty(t, reputation.blacklist)
+}
+
+func TestReputation_DetectDuplicateFloods(t *testing.T) {
+	a, b, teardown := newPipe()
+	defer teardown()
+
+	honestNeighbor := newTestNeighbor("A", a)
+	floodingNeighbor := newTestNeighbor("B", b)
+	neighbors := []*Neighbor{honestNeighbor, floodingNeighbor}
+
+	reputation := NewReputation(nil)
+	reputation.detectDuplicateFloods(neighbors)
+
+	honestNeighbor.Stats().newMessages.Add(50)
+	honestNeighbor.Stats().duplicateMessages.Add(100)
+	floodingNeighbor.Stats().duplicateMessages.Add(50 + duplicateAllowance + 1)
+	reputation.detectDuplicateFloods(neighbors)
+
+	assert.Zero(t, reputation.Score(honestNeighbor.ID()))
+	assert.Equal(t, -misbehaviorPenalties[DuplicateFlood], reputation.Score(floodingNeighbor.ID()))
+}
@@ -64,3 +64,50 @@ func TestBLSAddress(t *testing.T) {
 	assert.Equal(t, address.Type(), addressFromBase58.Type())
 	assert.Equal(t, address.Digest(), addressFromBase58.Digest())
 }
+
+func TestAliasAddress(t *testing.T) {
+	address := NewAliasAddress(NewOutputID(TransactionID{1}, 0).Bytes())
+	assert.False(t, address.IsEmpty())
+
+	// alias address from bytes using AddressFromBytes
+	address1, _, err := AddressFromBytes(address.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, AliasAddressType, address1.Type())
+	assert.True(t, address.Equals(address1))
+
+	// alias address from base58 string
+	addressFromBase58, err := AliasAddressFromBase58EncodedString(address.Base58())
+	require.NoError(t, err)
+	assert.Equal(t, address.Digest(), addressFromBase58.Digest())
+}
+
+func TestThresholdAddress(t *testing.T) {
+	keyPairs := []ed25519.KeyPair{ed25519.GenerateKeyPair(), ed25519.GenerateKeyPair(), ed25519.GenerateKeyPair()}
+
+	address, err := NewThresholdAddress(2, keyPairs[0].PublicKey, keyPairs[1].PublicKey, keyPairs[2].PublicKey)
+	require.NoError(t, err)
+
+	// the order of the keys does not matter
+	address1, err := NewThresholdAddress(2, keyPairs[2].PublicKey, keyPairs[0].PublicKey, keyPairs[1].PublicKey)
+	require.NoError(t, err)
+	assert.Equal(t, address.Bytes(), address1.Bytes())
+
+	// the threshold is part of the address
+	address2, err := NewThresholdAddress(1, keyPairs[0].PublicKey, keyPairs[1].PublicKey, keyPairs[2].PublicKey)
+	require.NoError(t, err)
+	assert.NotEqual(t, address.Bytes(), address2.Bytes())
+
+	// threshold address from base58 string
+	addressFromBase58, err := AddressFromBase58EncodedString(address.Base58())
+	require.NoError(t, err)
+	assert.Equal(t, ThresholdAddressType, addressFromBase58.Type())
+	assert.Equal(t, address.Digest(), addressFromBase58.Digest())
+
+	// invalid thresholds and key sets
+	_, err = NewThresholdAddress(0, keyPairs[0].PublicKey)
+	assert.Error(t, err)
+	_, err = NewThresholdAddress(2, keyPairs[0].PublicKey)
+	assert.Error(t, err)
+	_, err = NewThresholdAddress(2, keyPairs[0].PublicKey, keyPairs[0].PublicKey)
+	assert.Error(t, err)
+}
@@ -0,0 +1,301 @@
+package ledgerstate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/stringify"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// AddressHistoryBucketDuration defines the time span of the Outputs that are grouped into the same
+	// AddressHistoryBucket.
+	AddressHistoryBucketDuration = time.Hour
+
+	// addressHistoryTimeLength contains the amount of bytes that a big endian encoded time or bucket index takes up in
+	// the storage keys of the address history.
+	addressHistoryTimeLength = marshalutil.Uint64Size
+)
+
+// snapshotOutputCreationTime is the creation time of the Outputs whose Transactions are not known because they were
+// loaded from a snapshot. It orders them before all other Outputs in the history of an Address.
+var snapshotOutputCreationTime = time.Unix(0, 0)
+
+// region AddressHistoryEntry //////////////////////////////////////////////////////////////////////////////////////////
+
+// AddressHistoryEntry is an entry of the time ordered index of the Outputs that were ever created on an Address. Its
+// storage key starts with the AddressHistoryBucket of the creation time followed by the big endian encoded creation
+// time, so that the entries of a bucket can be ordered by their keys.
+type AddressHistoryEntry struct {
+	address      Address
+	creationTime time.Time
+	outputID     OutputID
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewAddressHistoryEntry returns a new AddressHistoryEntry.
+func NewAddressHistoryEntry(address Address, creationTime time.Time, outputID OutputID) *AddressHistoryEntry {
+	return &AddressHistoryEntry{
+		address:      address,
+		creationTime: creationTime,
+		outputID:     outputID,
+	}
+}
+
+// AddressHistoryEntryFromBytes unmarshals an AddressHistoryEntry from a sequence of bytes.
+func AddressHistoryEntryFromBytes(bytes []byte) (addressHistoryEntry *AddressHistoryEntry, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if addressHistoryEntry, err = AddressHistoryEntryFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse AddressHistoryEntry from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// AddressHistoryEntryFromMarshalUtil unmarshals an AddressHistoryEntry using a MarshalUtil (for easier unmarshaling).
+func AddressHistoryEntryFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (addressHistoryEntry *AddressHistoryEntry, err error) {
+	addressHistoryEntry = &AddressHistoryEntry{}
+	if addressHistoryEntry.address, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse Address from MarshalUtil: %w", err)
+		return
+	}
+	if _, err = marshalUtil.ReadBytes(addressHistoryTimeLength); err != nil {
+		err = xerrors.Errorf("failed to parse bucket (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	creationTimeBytes, err := marshalUtil.ReadBytes(addressHistoryTimeLength)
+	if err != nil {
+		err = xerrors.Errorf("failed to parse creation time (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	addressHistoryEntry.creationTime = time.Unix(0, int64(binary.BigEndian.Uint64(creationTimeBytes)))
+	if addressHistoryEntry.outputID, err = OutputIDFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse OutputID from MarshalUtil: %w", err)
+		return
+	}
+
+	return
+}
+
+// AddressHistoryEntryFromObjectStorage is a factory method that creates a new AddressHistoryEntry instance from a
+// storage key of the object storage. It is used by the object storage, to create new instances of this entity.
+func AddressHistoryEntryFromObjectStorage(key []byte, _ []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = AddressHistoryEntryFromBytes(key); err != nil {
+		err = xerrors.Errorf("failed to parse AddressHistoryEntry from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// Address returns the Address of the AddressHistoryEntry.
+func (a *AddressHistoryEntry) Address() Address {
+	return a.address
+}
+
+// CreationTime returns the time at which the Output was created.
+func (a *AddressHistoryEntry) CreationTime() time.Time {
+	return a.creationTime
+}
+
+// OutputID returns the OutputID of the AddressHistoryEntry.
+func (a *AddressHistoryEntry) OutputID() OutputID {
+	return a.outputID
+}
+
+// Bucket returns the index of the AddressHistoryBucket that the AddressHistoryEntry belongs to.
+func (a *AddressHistoryEntry) Bucket() uint64 {
+	return addressHistoryBucket(a.creationTime)
+}
+
+// Bytes marshals the AddressHistoryEntry into a sequence of bytes.
+func (a *AddressHistoryEntry) Bytes() []byte {
+	return a.ObjectStorageKey()
+}
+
+// String returns a human readable version of the AddressHistoryEntry.
+func (a *AddressHistoryEntry) String() string {
+	return stringify.Struct("AddressHistoryEntry",
+		stringify.StructField("address", a.address),
+		stringify.StructField("creationTime", a.creationTime),
+		stringify.StructField("outputID", a.outputID),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (a *AddressHistoryEntry) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (a *AddressHistoryEntry) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes(
+		a.address.Bytes(),
+		bigEndianUint64(a.Bucket()),
+		bigEndianUint64(uint64(a.creationTime.UnixNano())),
+		a.outputID.Bytes(),
+	)
+}
+
+// ObjectStorageValue marshals the AddressHistoryEntry into a sequence of bytes that are used as the value part in the
+// object storage.
+func (a *AddressHistoryEntry) ObjectStorageValue() []byte {
+	return nil
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &AddressHistoryEntry{}
+
+// AddressHistoryEntryKeyPartition defines the partition of the storage key of the AddressHistoryEntry model.
+var AddressHistoryEntryKeyPartition = objectstorage.PartitionKey(AddressLength, addressHistoryTimeLength, addressHistoryTimeLength+OutputIDLength)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AddressHistoryBucket /////////////////////////////////////////////////////////////////////////////////////////
+
+// AddressHistoryBucket marks a time span of the length of the AddressHistoryBucketDuration in which Outputs were created
+// on an Address. It allows to skip the time spans without Outputs when iterating over the history of an Address.
+type AddressHistoryBucket struct {
+	address Address
+	index   uint64
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewAddressHistoryBucket returns a new AddressHistoryBucket.
+func NewAddressHistoryBucket(address Address, index uint64) *AddressHistoryBucket {
+	return &AddressHistoryBucket{
+		address: address,
+		index:   index,
+	}
+}
+
+// AddressHistoryBucketFromBytes unmarshals an AddressHistoryBucket from a sequence of bytes.
+func AddressHistoryBucketFromBytes(bytes []byte) (addressHistoryBucket *AddressHistoryBucket, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if addressHistoryBucket, err = AddressHistoryBucketFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse AddressHistoryBucket from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// AddressHistoryBucketFromMarshalUtil unmarshals an AddressHistoryBucket using a MarshalUtil (for easier unmarshaling).
+func AddressHistoryBucketFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (addressHistoryBucket *AddressHistoryBucket, err error) {
+	addressHistoryBucket = &AddressHistoryBucket{}
+	if addressHistoryBucket.address, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse Address from MarshalUtil: %w", err)
+		return
+	}
+	indexBytes, err := marshalUtil.ReadBytes(addressHistoryTimeLength)
+	if err != nil {
+		err = xerrors.Errorf("failed to parse index (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	addressHistoryBucket.index = binary.BigEndian.Uint64(indexBytes)
+
+	return
+}
+
+// AddressHistoryBucketFromObjectStorage is a factory method that creates a new AddressHistoryBucket instance from a
+// storage key of the object storage. It is used by the object storage, to create new instances of this entity.
+func AddressHistoryBucketFromObjectStorage(key []byte, _ []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = AddressHistoryBucketFromBytes(key); err != nil {
+		err = xerrors.Errorf("failed to parse AddressHistoryBucket from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// Address returns the Address of the AddressHistoryBucket.
+func (a *AddressHistoryBucket) Address() Address {
+	return a.address
+}
+
+// Index returns the index of the time span of the AddressHistoryBucket.
+func (a *AddressHistoryBucket) Index() uint64 {
+	return a.index
+}
+
+// Bytes marshals the AddressHistoryBucket into a sequence of bytes.
+func (a *AddressHistoryBucket) Bytes() []byte {
+	return a.ObjectStorageKey()
+}
+
+// String returns a human readable version of the AddressHistoryBucket.
+func (a *AddressHistoryBucket) String() string {
+	return stringify.Struct("AddressHistoryBucket",
+		stringify.StructField("address", a.address),
+		stringify.StructField("index", a.index),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (a *AddressHistoryBucket) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (a *AddressHistoryBucket) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes(a.address.Bytes(), bigEndianUint64(a.index))
+}
+
+// ObjectStorageValue marshals the AddressHistoryBucket into a sequence of bytes that are used as the value part in the
+// object storage.
+func (a *AddressHistoryBucket) ObjectStorageValue() []byte {
+	return nil
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &AddressHistoryBucket{}
+
+// AddressHistoryBucketKeyPartition defines the partition of the storage key of the AddressHistoryBucket model.
+var AddressHistoryBucketKeyPartition = objectstorage.PartitionKey(AddressLength, addressHistoryTimeLength)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// addressHistoryBucket returns the index of the AddressHistoryBucket that contains the given time.
+func addressHistoryBucket(creationTime time.Time) uint64 {
+	return uint64(creationTime.UnixNano()) / uint64(AddressHistoryBucketDuration)
+}
+
+// bigEndianUint64 encodes the given value in big endian, so that the encoded values keep their order when they are
+// compared byte by byte.
+func bigEndianUint64(value uint64) (encoded []byte) {
+	encoded = make([]byte, addressHistoryTimeLength)
+	binary.BigEndian.PutUint64(encoded, value)
+
+	return
+}
+
+// sortAddressHistoryEntries sorts the given AddressHistoryEntries by their storage keys, which orders them by their
+// creation time and their OutputID.
+func sortAddressHistoryEntries(entries []*AddressHistoryEntry) {
+	keys := make(map[*AddressHistoryEntry][]byte, len(entries))
+	for _, entry := range entries {
+		keys[entry] = entry.ObjectStorageKey()
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(keys[entries[i]], keys[entries[j]]) < 0
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
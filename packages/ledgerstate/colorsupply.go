@@ -0,0 +1,238 @@
+package ledgerstate
+
+import (
+	"sync"
+
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/stringify"
+	"golang.org/x/xerrors"
+)
+
+// region ColorSupply //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ColorSupply keeps track of the amount of tokens of a Color that were minted and destroyed (uncolored back to IOTA).
+// The index contains all Transactions that were booked into a Branch that was neither invalid nor rejected at the time
+// of booking. The Transactions of a Branch that gets rejected later are removed from the index again.
+type ColorSupply struct {
+	color         Color
+	minted        uint64
+	destroyed     uint64
+	suppliesMutex sync.RWMutex
+	objectstorage.StorableObjectFlags
+}
+
+// NewColorSupply creates a new empty ColorSupply object.
+func NewColorSupply(color Color) *ColorSupply {
+	return &ColorSupply{
+		color: color,
+	}
+}
+
+// ColorSupplyFromBytes unmarshals a ColorSupply object from a sequence of bytes.
+func ColorSupplyFromBytes(bytes []byte) (colorSupply *ColorSupply, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if colorSupply, err = ColorSupplyFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse ColorSupply from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// ColorSupplyFromMarshalUtil unmarshals a ColorSupply object using a MarshalUtil (for easier unmarshaling).
+func ColorSupplyFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (colorSupply *ColorSupply, err error) {
+	colorSupply = &ColorSupply{}
+	if colorSupply.color, err = ColorFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse Color: %w", err)
+		return
+	}
+	if colorSupply.minted, err = marshalUtil.ReadUint64(); err != nil {
+		err = xerrors.Errorf("failed to parse minted tokens (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if colorSupply.destroyed, err = marshalUtil.ReadUint64(); err != nil {
+		err = xerrors.Errorf("failed to parse destroyed tokens (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return
+}
+
+// ColorSupplyFromObjectStorage restores a ColorSupply object that was stored in the ObjectStorage.
+func ColorSupplyFromObjectStorage(key []byte, data []byte) (colorSupply objectstorage.StorableObject, err error) {
+	if colorSupply, _, err = ColorSupplyFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = xerrors.Errorf("failed to parse ColorSupply from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// Color returns the Color that the ColorSupply belongs to.
+func (c *ColorSupply) Color() Color {
+	return c.color
+}
+
+// Minted returns the total amount of tokens that were minted with the Color.
+func (c *ColorSupply) Minted() uint64 {
+	c.suppliesMutex.RLock()
+	defer c.suppliesMutex.RUnlock()
+
+	return c.minted
+}
+
+// Destroyed returns the total amount of tokens of the Color that were uncolored back to IOTA.
+func (c *ColorSupply) Destroyed() uint64 {
+	c.suppliesMutex.RLock()
+	defer c.suppliesMutex.RUnlock()
+
+	return c.destroyed
+}
+
+// Supply returns the amount of tokens of the Color that are currently in circulation.
+func (c *ColorSupply) Supply() uint64 {
+	c.suppliesMutex.RLock()
+	defer c.suppliesMutex.RUnlock()
+
+	// the rejected Transactions are reverted in no particular order, so the destroyed tokens can temporarily exceed the
+	// minted ones
+	if c.destroyed > c.minted {
+		return 0
+	}
+
+	return c.minted - c.destroyed
+}
+
+// Mint increases the amount of minted tokens of the Color.
+func (c *ColorSupply) Mint(amount uint64) {
+	c.suppliesMutex.Lock()
+	defer c.suppliesMutex.Unlock()
+
+	c.minted += amount
+	c.SetModified()
+}
+
+// Destroy increases the amount of destroyed tokens of the Color.
+func (c *ColorSupply) Destroy(amount uint64) {
+	c.suppliesMutex.Lock()
+	defer c.suppliesMutex.Unlock()
+
+	c.destroyed += amount
+	c.SetModified()
+}
+
+// RevertMint decreases the amount of minted tokens of the Color (i.e. if the minting Transaction was rejected).
+func (c *ColorSupply) RevertMint(amount uint64) {
+	c.suppliesMutex.Lock()
+	defer c.suppliesMutex.Unlock()
+
+	if amount > c.minted {
+		amount = c.minted
+	}
+	c.minted -= amount
+	c.SetModified()
+}
+
+// RevertDestroy decreases the amount of destroyed tokens of the Color (i.e. if the destroying Transaction was
+// rejected).
+func (c *ColorSupply) RevertDestroy(amount uint64) {
+	c.suppliesMutex.Lock()
+	defer c.suppliesMutex.Unlock()
+
+	if amount > c.destroyed {
+		amount = c.destroyed
+	}
+	c.destroyed -= amount
+	c.SetModified()
+}
+
+// Bytes marshals the ColorSupply into a sequence of bytes.
+func (c *ColorSupply) Bytes() []byte {
+	return byteutils.ConcatBytes(c.ObjectStorageKey(), c.ObjectStorageValue())
+}
+
+// String returns a human readable version of the ColorSupply.
+func (c *ColorSupply) String() string {
+	return stringify.Struct("ColorSupply",
+		stringify.StructField("color", c.Color()),
+		stringify.StructField("minted", c.Minted()),
+		stringify.StructField("destroyed", c.Destroyed()),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (c *ColorSupply) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (c *ColorSupply) ObjectStorageKey() []byte {
+	return c.color.Bytes()
+}
+
+// ObjectStorageValue marshals the ColorSupply into a sequence of bytes that are used as the value part in the object
+// storage.
+func (c *ColorSupply) ObjectStorageValue() []byte {
+	c.suppliesMutex.RLock()
+	defer c.suppliesMutex.RUnlock()
+
+	return marshalutil.New(2 * marshalutil.Uint64Size).
+		WriteUint64(c.minted).
+		WriteUint64(c.destroyed).
+		Bytes()
+}
+
+// code contract (make sure the type implements all required methods)
+var _ objectstorage.StorableObject = &ColorSupply{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CachedColorSupply ////////////////////////////////////////////////////////////////////////////////////////////
+
+// CachedColorSupply is a wrapper for the generic CachedObject returned by the object storage that overrides the
+// accessor methods with a type-casted one.
+type CachedColorSupply struct {
+	objectstorage.CachedObject
+}
+
+// Retain marks the CachedObject to still be in use by the program.
+func (c *CachedColorSupply) Retain() *CachedColorSupply {
+	return &CachedColorSupply{c.CachedObject.Retain()}
+}
+
+// Unwrap is the type-casted equivalent of Get. It returns nil if the object does not exist.
+func (c *CachedColorSupply) Unwrap() *ColorSupply {
+	untypedObject := c.Get()
+	if untypedObject == nil {
+		return nil
+	}
+
+	typedObject := untypedObject.(*ColorSupply)
+	if typedObject == nil || typedObject.IsDeleted() {
+		return nil
+	}
+
+	return typedObject
+}
+
+// Consume unwraps the CachedObject and passes a type-casted version to the consumer (if the object is not empty - it
+// exists). It automatically releases the object when the consumer finishes.
+func (c *CachedColorSupply) Consume(consumer func(colorSupply *ColorSupply), forceRelease ...bool) (consumed bool) {
+	return c.CachedObject.Consume(func(object objectstorage.StorableObject) {
+		consumer(object.(*ColorSupply))
+	}, forceRelease...)
+}
+
+// String returns a human readable version of the CachedColorSupply.
+func (c *CachedColorSupply) String() string {
+	return stringify.Struct("CachedColorSupply",
+		stringify.StructField("CachedObject", c.Unwrap()),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
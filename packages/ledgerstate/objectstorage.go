@@ -36,6 +36,15 @@ const (
 
 	// PrefixAddressOutputMappingStorage defines the storage prefix for the AddressOutputMapping object storage.
 	PrefixAddressOutputMappingStorage
+
+	// PrefixColorSupplyStorage defines the storage prefix for the ColorSupply object storage.
+	PrefixColorSupplyStorage
+
+	// PrefixAddressHistoryStorage defines the storage prefix for the AddressHistoryEntry object storage.
+	PrefixAddressHistoryStorage
+
+	// PrefixAddressHistoryBucketStorage defines the storage prefix for the AddressHistoryBucket object storage.
+	PrefixAddressHistoryBucketStorage
 )
 
 // branchStorageOptions contains a list of default settings for the Branch object storage.
@@ -101,3 +110,23 @@ var addressOutputMappingStorageOptions = []objectstorage.Option{
 	objectstorage.PartitionKey(AddressLength, OutputIDLength),
 	objectstorage.LeakDetectionEnabled(false),
 }
+
+// colorSupplyStorageOptions contains a list of default settings for the ColorSupply object storage.
+var colorSupplyStorageOptions = []objectstorage.Option{
+	objectstorage.CacheTime(10 * time.Second),
+	objectstorage.LeakDetectionEnabled(false),
+}
+
+// addressHistoryStorageOptions contains a list of default settings for the AddressHistoryEntry object storage.
+var addressHistoryStorageOptions = []objectstorage.Option{
+	AddressHistoryEntryKeyPartition,
+	objectstorage.CacheTime(10 * time.Second),
+	objectstorage.LeakDetectionEnabled(false),
+}
+
+// addressHistoryBucketStorageOptions contains a list of default settings for the AddressHistoryBucket object storage.
+var addressHistoryBucketStorageOptions = []objectstorage.Option{
+	AddressHistoryBucketKeyPartition,
+	objectstorage.CacheTime(10 * time.Second),
+	objectstorage.LeakDetectionEnabled(false),
+}
@@ -51,6 +51,13 @@ const (
 
 	// SigLockedColoredOutputType represents an Output that holds colored coins that gets unlocked by a signature.
 	SigLockedColoredOutputType
+
+	// ExtendedLockedOutputType represents an Output that holds colored coins that gets unlocked by a signature and that
+	// can additionally be time locked and fall back to a different Address after a deadline.
+	ExtendedLockedOutputType
+
+	// AliasOutputType represents an Output that holds the state of an alias which keeps its identity across Transactions.
+	AliasOutputType
 )
 
 // String returns a human readable representation of the OutputType.
@@ -58,6 +65,8 @@ func (o OutputType) String() string {
 	return [...]string{
 		"SigLockedSingleOutputType",
 		"SigLockedColoredOutputType",
+		"ExtendedLockedOutputType",
+		"AliasOutputType",
 	}[o]
 }
 
@@ -210,6 +219,17 @@ type Output interface {
 	objectstorage.StorableObject
 }
 
+// OutputAddresses returns the Addresses that can unlock the given Output, which is its Address and - for
+// ExtendedLockedOutputs with a fallback - the fallback Address.
+func OutputAddresses(output Output) (addresses []Address) {
+	addresses = []Address{output.Address()}
+	if extendedLockedOutput, isExtendedLockedOutput := output.(*ExtendedLockedOutput); isExtendedLockedOutput && extendedLockedOutput.FallbackAddress() != nil {
+		addresses = append(addresses, extendedLockedOutput.FallbackAddress())
+	}
+
+	return
+}
+
 // OutputFromBytes unmarshals an Output from a sequence of bytes.
 func OutputFromBytes(bytes []byte) (output Output, consumedBytes int, err error) {
 	marshalUtil := marshalutil.New(bytes)
@@ -241,6 +261,16 @@ func OutputFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (output Output,
 			err = xerrors.Errorf("failed to parse SigLockedColoredOutput: %w", err)
 			return
 		}
+	case ExtendedLockedOutputType:
+		if output, err = ExtendedLockedOutputFromMarshalUtil(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse ExtendedLockedOutput: %w", err)
+			return
+		}
+	case AliasOutputType:
+		if output, err = AliasOutputFromMarshalUtil(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse AliasOutput: %w", err)
+			return
+		}
 	default:
 		err = xerrors.Errorf("unsupported OutputType (%X): %w", outputType, cerrors.ErrParseBytesFailed)
 		return
@@ -581,7 +611,7 @@ func (s *SigLockedSingleOutput) Balances() *ColoredBalances {
 
 // UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
 func (s *SigLockedSingleOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock) (unlockValid bool, err error) {
-	signatureUnlockBlock, correctType := unlockBlock.(*SignatureUnlockBlock)
+	signatureUnlockBlock, correctType := unlockBlock.(AddressSignatureUnlockBlock)
 	if !correctType {
 		err = xerrors.Errorf("UnlockBlock does not match expected OutputType: %w", cerrors.ErrParseBytesFailed)
 		return
@@ -752,7 +782,7 @@ func (s *SigLockedColoredOutput) Balances() *ColoredBalances {
 
 // UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
 func (s *SigLockedColoredOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock) (unlockValid bool, err error) {
-	signatureUnlockBlock, correctType := unlockBlock.(*SignatureUnlockBlock)
+	signatureUnlockBlock, correctType := unlockBlock.(AddressSignatureUnlockBlock)
 	if !correctType {
 		err = xerrors.Errorf("UnlockBlock does not match expected OutputType: %w", cerrors.ErrParseBytesFailed)
 		return
@@ -846,6 +876,662 @@ var _ Output = &SigLockedColoredOutput{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region ExtendedLockedOutput /////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// flagExtendedLockedOutputFallbackPresent signals that the ExtendedLockedOutput has a fallback Address and deadline.
+	flagExtendedLockedOutputFallbackPresent = 1 << iota
+
+	// flagExtendedLockedOutputTimeLockPresent signals that the ExtendedLockedOutput has a time lock.
+	flagExtendedLockedOutputTimeLockPresent
+)
+
+// ExtendedLockedOutput is an Output that holds colored balances and that can be unlocked by providing a signature for
+// an Address. In addition, the Output can be time locked, so that it can only be spent by Transactions with a timestamp
+// after the time lock, and it can have a fallback Address, which is allowed to spend the Output (instead of the main
+// Address) if it was not claimed before the fallback deadline.
+type ExtendedLockedOutput struct {
+	id               OutputID
+	idMutex          sync.RWMutex
+	balances         *ColoredBalances
+	address          Address
+	fallbackAddress  Address
+	fallbackDeadline time.Time
+	timeLock         time.Time
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewExtendedLockedOutput is the constructor for an ExtendedLockedOutput.
+func NewExtendedLockedOutput(balances *ColoredBalances, address Address) *ExtendedLockedOutput {
+	return &ExtendedLockedOutput{
+		balances: balances,
+		address:  address,
+	}
+}
+
+// WithFallbackOptions adds a fallback Address and the deadline after which the fallback Address (and no longer the main
+// Address) is allowed to spend the Output.
+func (o *ExtendedLockedOutput) WithFallbackOptions(fallbackAddress Address, fallbackDeadline time.Time) *ExtendedLockedOutput {
+	o.fallbackAddress = fallbackAddress
+	o.fallbackDeadline = fallbackDeadline
+
+	return o
+}
+
+// WithTimeLock adds a time lock to the Output, so that it can only be spent by Transactions with a later timestamp.
+func (o *ExtendedLockedOutput) WithTimeLock(timeLock time.Time) *ExtendedLockedOutput {
+	o.timeLock = timeLock
+
+	return o
+}
+
+// ExtendedLockedOutputFromBytes unmarshals an ExtendedLockedOutput from a sequence of bytes.
+func ExtendedLockedOutputFromBytes(bytes []byte) (output *ExtendedLockedOutput, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if output, err = ExtendedLockedOutputFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse ExtendedLockedOutput from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// ExtendedLockedOutputFromMarshalUtil unmarshals an ExtendedLockedOutput using a MarshalUtil (for easier unmarshaling).
+func ExtendedLockedOutputFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (output *ExtendedLockedOutput, err error) {
+	outputType, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse OutputType (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if OutputType(outputType) != ExtendedLockedOutputType {
+		err = xerrors.Errorf("invalid OutputType (%X): %w", outputType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	output = &ExtendedLockedOutput{}
+	if output.balances, err = ColoredBalancesFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse ColoredBalances: %w", err)
+		return
+	}
+	if output.address, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse Address (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	flags, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse flags (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if flags&flagExtendedLockedOutputFallbackPresent != 0 {
+		if output.fallbackAddress, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse fallback Address (%v): %w", err, cerrors.ErrParseBytesFailed)
+			return
+		}
+		if output.fallbackDeadline, err = marshalUtil.ReadTime(); err != nil {
+			err = xerrors.Errorf("failed to parse fallback deadline (%v): %w", err, cerrors.ErrParseBytesFailed)
+			return
+		}
+	}
+	if flags&flagExtendedLockedOutputTimeLockPresent != 0 {
+		if output.timeLock, err = marshalUtil.ReadTime(); err != nil {
+			err = xerrors.Errorf("failed to parse time lock (%v): %w", err, cerrors.ErrParseBytesFailed)
+			return
+		}
+	}
+
+	return
+}
+
+// ID returns the identifier of the Output that is used to address the Output in the UTXODAG.
+func (o *ExtendedLockedOutput) ID() OutputID {
+	o.idMutex.RLock()
+	defer o.idMutex.RUnlock()
+
+	return o.id
+}
+
+// SetID allows to set the identifier of the Output. We offer a setter for the property since Outputs that are
+// created to become part of a transaction usually do not have an identifier, yet as their identifier depends on
+// the TransactionID that is only determinable after the Transaction has been fully constructed. The ID is therefore
+// only accessed when the Output is supposed to be persisted by the node.
+func (o *ExtendedLockedOutput) SetID(outputID OutputID) Output {
+	o.idMutex.Lock()
+	defer o.idMutex.Unlock()
+
+	o.id = outputID
+
+	return o
+}
+
+// Type returns the type of the Output which allows us to generically handle Outputs of different types.
+func (o *ExtendedLockedOutput) Type() OutputType {
+	return ExtendedLockedOutputType
+}
+
+// Balances returns the funds that are associated with the Output.
+func (o *ExtendedLockedOutput) Balances() *ColoredBalances {
+	return o.balances
+}
+
+// TimeLock returns the time before which the Output can not be spent (the zero time if the Output is not time locked).
+func (o *ExtendedLockedOutput) TimeLock() time.Time {
+	return o.timeLock
+}
+
+// TimeLockedNow returns true if the Output can not be spent at the given time.
+func (o *ExtendedLockedOutput) TimeLockedNow(now time.Time) bool {
+	return now.Before(o.timeLock)
+}
+
+// FallbackAddress returns the Address that is allowed to spend the Output after the fallback deadline (nil if the
+// Output has no fallback).
+func (o *ExtendedLockedOutput) FallbackAddress() Address {
+	return o.fallbackAddress
+}
+
+// FallbackDeadline returns the time after which the fallback Address is allowed to spend the Output.
+func (o *ExtendedLockedOutput) FallbackDeadline() time.Time {
+	return o.fallbackDeadline
+}
+
+// UnlockAddressNow returns the Address that is allowed to spend the Output at the given time.
+func (o *ExtendedLockedOutput) UnlockAddressNow(now time.Time) Address {
+	if o.fallbackAddress == nil || now.Before(o.fallbackDeadline) {
+		return o.address
+	}
+
+	return o.fallbackAddress
+}
+
+// UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
+// The time lock and the fallback deadline are evaluated against the timestamp of the Transaction.
+func (o *ExtendedLockedOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock) (unlockValid bool, err error) {
+	signatureUnlockBlock, correctType := unlockBlock.(AddressSignatureUnlockBlock)
+	if !correctType {
+		err = xerrors.Errorf("UnlockBlock does not match expected OutputType: %w", cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	if o.TimeLockedNow(tx.Essence().Timestamp()) {
+		return
+	}
+
+	unlockValid = signatureUnlockBlock.AddressSignatureValid(o.UnlockAddressNow(tx.Essence().Timestamp()), tx.Essence().Bytes())
+
+	return
+}
+
+// Address returns the Address that the Output is associated to.
+func (o *ExtendedLockedOutput) Address() Address {
+	return o.address
+}
+
+// Input returns an Input that references the Output.
+func (o *ExtendedLockedOutput) Input() Input {
+	if o.ID() == EmptyOutputID {
+		panic("Outputs that haven't been assigned an ID, yet cannot be converted to an Input")
+	}
+
+	return NewUTXOInput(o.ID())
+}
+
+// Clone creates a copy of the Output.
+func (o *ExtendedLockedOutput) Clone() Output {
+	clonedOutput := &ExtendedLockedOutput{
+		id:               o.ID(),
+		balances:         o.balances.Clone(),
+		address:          o.address.Clone(),
+		fallbackDeadline: o.fallbackDeadline,
+		timeLock:         o.timeLock,
+	}
+	if o.fallbackAddress != nil {
+		clonedOutput.fallbackAddress = o.fallbackAddress.Clone()
+	}
+
+	return clonedOutput
+}
+
+// UpdateMintingColor replaces the ColorMint in the balances of the Output with the hash of the OutputID. It returns a
+// copy of the original Output with the modified balances.
+func (o *ExtendedLockedOutput) UpdateMintingColor() (updatedOutput *ExtendedLockedOutput) {
+	coloredBalances := o.Balances().Map()
+	if mintedCoins, mintedCoinsExist := coloredBalances[ColorMint]; mintedCoinsExist {
+		delete(coloredBalances, ColorMint)
+		coloredBalances[Color(blake2b.Sum256(o.ID().Bytes()))] = mintedCoins
+	}
+	updatedOutput = NewExtendedLockedOutput(NewColoredBalances(coloredBalances), o.Address())
+	updatedOutput.fallbackAddress = o.fallbackAddress
+	updatedOutput.fallbackDeadline = o.fallbackDeadline
+	updatedOutput.timeLock = o.timeLock
+	updatedOutput.SetID(o.ID())
+
+	return
+}
+
+// Bytes returns a marshaled version of the Output.
+func (o *ExtendedLockedOutput) Bytes() []byte {
+	return o.ObjectStorageValue()
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (o *ExtendedLockedOutput) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (o *ExtendedLockedOutput) ObjectStorageKey() []byte {
+	return o.id.Bytes()
+}
+
+// ObjectStorageValue marshals the Output into a sequence of bytes. The ID is not serialized here as it is only used as
+// a key in the ObjectStorage.
+func (o *ExtendedLockedOutput) ObjectStorageValue() []byte {
+	flags := byte(0)
+	if o.fallbackAddress != nil {
+		flags |= flagExtendedLockedOutputFallbackPresent
+	}
+	if !o.timeLock.IsZero() {
+		flags |= flagExtendedLockedOutputTimeLockPresent
+	}
+
+	marshalUtil := marshalutil.New().
+		WriteByte(byte(ExtendedLockedOutputType)).
+		WriteBytes(o.balances.Bytes()).
+		WriteBytes(o.address.Bytes()).
+		WriteByte(flags)
+	if o.fallbackAddress != nil {
+		marshalUtil.WriteBytes(o.fallbackAddress.Bytes()).WriteTime(o.fallbackDeadline)
+	}
+	if !o.timeLock.IsZero() {
+		marshalUtil.WriteTime(o.timeLock)
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// Compare offers a comparator for Outputs which returns -1 if the other Output is bigger, 1 if it is smaller and 0 if
+// they are the same.
+func (o *ExtendedLockedOutput) Compare(other Output) int {
+	return bytes.Compare(o.Bytes(), other.Bytes())
+}
+
+// String returns a human readable version of the Output.
+func (o *ExtendedLockedOutput) String() string {
+	return stringify.Struct("ExtendedLockedOutput",
+		stringify.StructField("id", o.ID()),
+		stringify.StructField("address", o.address),
+		stringify.StructField("balances", o.balances),
+		stringify.StructField("fallbackAddress", o.fallbackAddress),
+		stringify.StructField("fallbackDeadline", o.fallbackDeadline),
+		stringify.StructField("timeLock", o.timeLock),
+	)
+}
+
+// code contract (make sure the type implements all required methods)
+var _ Output = &ExtendedLockedOutput{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AliasOutput //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AliasOutput is an Output that represents a persistent on-ledger identity (an alias) that survives being spent. The
+// alias is identified by an AliasAddress which is derived from the OutputID of the AliasOutput that created it and
+// which is carried over to the AliasOutputs that continue the alias in later Transactions. The alias is controlled by
+// two different Addresses: the state controller is allowed to update the state (increasing the state index by one),
+// while the governance controller is allowed to change the controllers or to destroy the alias.
+type AliasOutput struct {
+	id                OutputID
+	idMutex           sync.RWMutex
+	aliasAddress      *AliasAddress
+	balances          *ColoredBalances
+	stateAddress      Address
+	governanceAddress Address
+	stateIndex        uint32
+	stateData         []byte
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewAliasOutput is the constructor for an AliasOutput that creates a new alias. The AliasAddress of the new alias is
+// derived from its OutputID when the Transaction is booked.
+func NewAliasOutput(balances *ColoredBalances, stateAddress Address, governanceAddress Address) *AliasOutput {
+	return &AliasOutput{
+		aliasAddress:      &AliasAddress{digest: emptyAliasAddressDigest[:]},
+		balances:          balances,
+		stateAddress:      stateAddress,
+		governanceAddress: governanceAddress,
+	}
+}
+
+// WithStateData sets the arbitrary state data that is associated with the alias.
+func (o *AliasOutput) WithStateData(stateData []byte) *AliasOutput {
+	o.stateData = stateData
+
+	return o
+}
+
+// NextStateTransition creates the AliasOutput that continues the alias with the given balances and state data. It
+// increases the state index and needs to be unlocked by the state controller.
+func (o *AliasOutput) NextStateTransition(balances *ColoredBalances, stateData []byte) *AliasOutput {
+	return &AliasOutput{
+		aliasAddress:      o.AliasAddress().Clone().(*AliasAddress),
+		balances:          balances,
+		stateAddress:      o.stateAddress.Clone(),
+		governanceAddress: o.governanceAddress.Clone(),
+		stateIndex:        o.stateIndex + 1,
+		stateData:         stateData,
+	}
+}
+
+// NextGovernanceTransition creates the AliasOutput that continues the alias with the given controllers. It keeps the
+// balances and the state of the alias and needs to be unlocked by the governance controller.
+func (o *AliasOutput) NextGovernanceTransition(stateAddress Address, governanceAddress Address) *AliasOutput {
+	return &AliasOutput{
+		aliasAddress:      o.AliasAddress().Clone().(*AliasAddress),
+		balances:          o.balances.Clone(),
+		stateAddress:      stateAddress,
+		governanceAddress: governanceAddress,
+		stateIndex:        o.stateIndex,
+		stateData:         o.cloneStateData(),
+	}
+}
+
+// AliasOutputFromBytes unmarshals an AliasOutput from a sequence of bytes.
+func AliasOutputFromBytes(bytes []byte) (output *AliasOutput, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if output, err = AliasOutputFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse AliasOutput from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// AliasOutputFromMarshalUtil unmarshals an AliasOutput using a MarshalUtil (for easier unmarshaling).
+func AliasOutputFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (output *AliasOutput, err error) {
+	outputType, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse OutputType (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if OutputType(outputType) != AliasOutputType {
+		err = xerrors.Errorf("invalid OutputType (%X): %w", outputType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	output = &AliasOutput{}
+	if output.aliasAddress, err = AliasAddressFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse AliasAddress: %w", err)
+		return
+	}
+	if output.balances, err = ColoredBalancesFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse ColoredBalances: %w", err)
+		return
+	}
+	if output.stateAddress, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse state Address (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.governanceAddress, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse governance Address (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.stateIndex, err = marshalUtil.ReadUint32(); err != nil {
+		err = xerrors.Errorf("failed to parse state index (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	stateDataLength, err := marshalUtil.ReadUint16()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse state data length (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.stateData, err = marshalUtil.ReadBytes(int(stateDataLength)); err != nil {
+		err = xerrors.Errorf("failed to parse state data (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return
+}
+
+// ID returns the identifier of the Output that is used to address the Output in the UTXODAG.
+func (o *AliasOutput) ID() OutputID {
+	o.idMutex.RLock()
+	defer o.idMutex.RUnlock()
+
+	return o.id
+}
+
+// SetID allows to set the identifier of the Output. We offer a setter for the property since Outputs that are
+// created to become part of a transaction usually do not have an identifier, yet as their identifier depends on
+// the TransactionID that is only determinable after the Transaction has been fully constructed. The ID is therefore
+// only accessed when the Output is supposed to be persisted by the node.
+func (o *AliasOutput) SetID(outputID OutputID) Output {
+	o.idMutex.Lock()
+	defer o.idMutex.Unlock()
+
+	o.id = outputID
+
+	return o
+}
+
+// Type returns the type of the Output which allows us to generically handle Outputs of different types.
+func (o *AliasOutput) Type() OutputType {
+	return AliasOutputType
+}
+
+// Balances returns the funds that are associated with the Output.
+func (o *AliasOutput) Balances() *ColoredBalances {
+	return o.balances
+}
+
+// AliasAddress returns the AliasAddress that identifies the alias. If the Output creates a new alias, the AliasAddress
+// is derived from the OutputID.
+func (o *AliasOutput) AliasAddress() *AliasAddress {
+	if o.aliasAddress.IsEmpty() && o.ID() != EmptyOutputID {
+		return NewAliasAddress(o.ID().Bytes())
+	}
+
+	return o.aliasAddress
+}
+
+// IsOrigin returns true if the Output creates a new alias.
+func (o *AliasOutput) IsOrigin() bool {
+	return o.aliasAddress.IsEmpty()
+}
+
+// StateAddress returns the Address of the state controller.
+func (o *AliasOutput) StateAddress() Address {
+	return o.stateAddress
+}
+
+// GovernanceAddress returns the Address of the governance controller.
+func (o *AliasOutput) GovernanceAddress() Address {
+	return o.governanceAddress
+}
+
+// StateIndex returns the counter that is increased with every state transition of the alias.
+func (o *AliasOutput) StateIndex() uint32 {
+	return o.stateIndex
+}
+
+// StateData returns the arbitrary state data that is associated with the alias.
+func (o *AliasOutput) StateData() []byte {
+	return o.stateData
+}
+
+// UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
+// Transactions that continue the alias with an increased state index need to be signed by the state controller, while
+// Transactions that change the controllers or that destroy the alias need to be signed by the governance controller.
+func (o *AliasOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock) (unlockValid bool, err error) {
+	signatureUnlockBlock, correctType := unlockBlock.(AddressSignatureUnlockBlock)
+	if !correctType {
+		err = xerrors.Errorf("UnlockBlock does not match expected OutputType: %w", cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	chainedOutput, err := o.chainedOutput(tx)
+	if err != nil {
+		err = xerrors.Errorf("failed to determine the chained AliasOutput: %w", err)
+		return
+	}
+
+	switch {
+	case chainedOutput == nil:
+		unlockValid = signatureUnlockBlock.AddressSignatureValid(o.governanceAddress, tx.Essence().Bytes())
+	case o.isStateTransition(chainedOutput):
+		unlockValid = signatureUnlockBlock.AddressSignatureValid(o.stateAddress, tx.Essence().Bytes())
+	case o.isGovernanceTransition(chainedOutput):
+		unlockValid = signatureUnlockBlock.AddressSignatureValid(o.governanceAddress, tx.Essence().Bytes())
+	}
+
+	return
+}
+
+// Address returns the AliasAddress that identifies the alias.
+func (o *AliasOutput) Address() Address {
+	return o.AliasAddress()
+}
+
+// Input returns an Input that references the Output.
+func (o *AliasOutput) Input() Input {
+	if o.ID() == EmptyOutputID {
+		panic("Outputs that haven't been assigned an ID, yet cannot be converted to an Input")
+	}
+
+	return NewUTXOInput(o.ID())
+}
+
+// Clone creates a copy of the Output.
+func (o *AliasOutput) Clone() Output {
+	return &AliasOutput{
+		id:                o.ID(),
+		aliasAddress:      o.aliasAddress.Clone().(*AliasAddress),
+		balances:          o.balances.Clone(),
+		stateAddress:      o.stateAddress.Clone(),
+		governanceAddress: o.governanceAddress.Clone(),
+		stateIndex:        o.stateIndex,
+		stateData:         o.cloneStateData(),
+	}
+}
+
+// UpdateMintingColor replaces the ColorMint in the balances of the Output with the hash of the OutputID and derives the
+// AliasAddress of newly created aliases. It returns a copy of the original Output with the modified fields.
+func (o *AliasOutput) UpdateMintingColor() (updatedOutput *AliasOutput) {
+	coloredBalances := o.Balances().Map()
+	if mintedCoins, mintedCoinsExist := coloredBalances[ColorMint]; mintedCoinsExist {
+		delete(coloredBalances, ColorMint)
+		coloredBalances[Color(blake2b.Sum256(o.ID().Bytes()))] = mintedCoins
+	}
+	updatedOutput = o.Clone().(*AliasOutput)
+	updatedOutput.aliasAddress = o.AliasAddress().Clone().(*AliasAddress)
+	updatedOutput.balances = NewColoredBalances(coloredBalances)
+
+	return
+}
+
+// Bytes returns a marshaled version of the Output.
+func (o *AliasOutput) Bytes() []byte {
+	return o.ObjectStorageValue()
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (o *AliasOutput) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (o *AliasOutput) ObjectStorageKey() []byte {
+	return o.id.Bytes()
+}
+
+// ObjectStorageValue marshals the Output into a sequence of bytes. The ID is not serialized here as it is only used as
+// a key in the ObjectStorage.
+func (o *AliasOutput) ObjectStorageValue() []byte {
+	return marshalutil.New().
+		WriteByte(byte(AliasOutputType)).
+		WriteBytes(o.aliasAddress.Bytes()).
+		WriteBytes(o.balances.Bytes()).
+		WriteBytes(o.stateAddress.Bytes()).
+		WriteBytes(o.governanceAddress.Bytes()).
+		WriteUint32(o.stateIndex).
+		WriteUint16(uint16(len(o.stateData))).
+		WriteBytes(o.stateData).
+		Bytes()
+}
+
+// Compare offers a comparator for Outputs which returns -1 if the other Output is bigger, 1 if it is smaller and 0 if
+// they are the same.
+func (o *AliasOutput) Compare(other Output) int {
+	return bytes.Compare(o.Bytes(), other.Bytes())
+}
+
+// String returns a human readable version of the Output.
+func (o *AliasOutput) String() string {
+	return stringify.Struct("AliasOutput",
+		stringify.StructField("id", o.ID()),
+		stringify.StructField("aliasAddress", o.AliasAddress()),
+		stringify.StructField("balances", o.balances),
+		stringify.StructField("stateAddress", o.stateAddress),
+		stringify.StructField("governanceAddress", o.governanceAddress),
+		stringify.StructField("stateIndex", o.stateIndex),
+		stringify.StructField("stateData", o.stateData),
+	)
+}
+
+// chainedOutput returns the AliasOutput of the given Transaction that continues the alias (nil if the alias is
+// destroyed). It returns an error if the alias is continued by more than one Output.
+func (o *AliasOutput) chainedOutput(tx *Transaction) (chainedOutput *AliasOutput, err error) {
+	for _, output := range tx.Essence().Outputs() {
+		aliasOutput, isAliasOutput := output.(*AliasOutput)
+		if !isAliasOutput || aliasOutput.IsOrigin() || !o.AliasAddress().Equals(aliasOutput.aliasAddress) {
+			continue
+		}
+
+		if chainedOutput != nil {
+			err = xerrors.Errorf("alias %s is continued by more than one Output: %w", o.AliasAddress().Base58(), ErrTransactionInvalid)
+			return
+		}
+		chainedOutput = aliasOutput
+	}
+
+	return
+}
+
+// isStateTransition returns true if the given AliasOutput continues the alias with the next state index and unchanged
+// controllers.
+func (o *AliasOutput) isStateTransition(chainedOutput *AliasOutput) bool {
+	return chainedOutput.stateIndex == o.stateIndex+1 &&
+		bytes.Equal(chainedOutput.stateAddress.Bytes(), o.stateAddress.Bytes()) &&
+		bytes.Equal(chainedOutput.governanceAddress.Bytes(), o.governanceAddress.Bytes())
+}
+
+// isGovernanceTransition returns true if the given AliasOutput continues the alias with the same state, state index and
+// balances (only the controllers are allowed to change).
+func (o *AliasOutput) isGovernanceTransition(chainedOutput *AliasOutput) bool {
+	return chainedOutput.stateIndex == o.stateIndex &&
+		bytes.Equal(chainedOutput.stateData, o.stateData) &&
+		bytes.Equal(chainedOutput.balances.Bytes(), o.balances.Bytes())
+}
+
+// cloneStateData returns a copy of the state data.
+func (o *AliasOutput) cloneStateData() (clonedStateData []byte) {
+	clonedStateData = make([]byte, len(o.stateData))
+	copy(clonedStateData, o.stateData)
+
+	return
+}
+
+// code contract (make sure the type implements all required methods)
+var _ Output = &AliasOutput{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region CachedOutput /////////////////////////////////////////////////////////////////////////////////////////////////
 
 // CachedOutput is a wrapper for the generic CachedObject returned by the object storage that overrides the accessor
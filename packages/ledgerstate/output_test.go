@@ -0,0 +1,265 @@
+package ledgerstate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedLockedOutput_Bytes(t *testing.T) {
+	wallets := createWallets(2)
+	balances := NewColoredBalances(map[Color]uint64{ColorIOTA: 100, ColorMint: 5})
+
+	for _, output := range []*ExtendedLockedOutput{
+		NewExtendedLockedOutput(balances, wallets[0].address),
+		NewExtendedLockedOutput(balances, wallets[0].address).WithTimeLock(time.Unix(1000, 0)),
+		NewExtendedLockedOutput(balances, wallets[0].address).WithFallbackOptions(wallets[1].address, time.Unix(2000, 0)),
+		NewExtendedLockedOutput(balances, wallets[0].address).WithTimeLock(time.Unix(1000, 0)).WithFallbackOptions(wallets[1].address, time.Unix(2000, 0)),
+	} {
+		restoredOutput, consumedBytes, err := OutputFromBytes(output.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, len(output.Bytes()), consumedBytes)
+		assert.Equal(t, ExtendedLockedOutputType, restoredOutput.Type())
+		assert.Equal(t, output.Bytes(), restoredOutput.Bytes())
+		assert.True(t, output.TimeLock().Equal(restoredOutput.(*ExtendedLockedOutput).TimeLock()))
+		assert.Equal(t, output.Bytes(), output.Clone().Bytes())
+	}
+}
+
+func TestExtendedLockedOutput_UnlockValid(t *testing.T) {
+	wallets := createWallets(3)
+	timeLock := time.Now()
+	fallbackDeadline := timeLock.Add(time.Hour)
+	output := NewExtendedLockedOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 100}), wallets[0].address).
+		WithTimeLock(timeLock).
+		WithFallbackOptions(wallets[1].address, fallbackDeadline)
+	output.SetID(NewOutputID(TransactionID{1}, 0))
+
+	spend := func(spender wallet, timestamp time.Time) bool {
+		txEssence := NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, NewInputs(output.Input()), NewOutputs(NewSigLockedSingleOutput(100, wallets[2].address)))
+		tx := NewTransaction(txEssence, spender.unlockBlocks(txEssence))
+
+		unlockValid, err := output.UnlockValid(tx, tx.UnlockBlocks()[0])
+		require.NoError(t, err)
+
+		return unlockValid
+	}
+
+	// the output is time locked
+	assert.False(t, spend(wallets[0], timeLock.Add(-time.Second)))
+	assert.False(t, spend(wallets[1], timeLock.Add(-time.Second)))
+
+	// only the main address can spend before the fallback deadline
+	assert.True(t, spend(wallets[0], timeLock.Add(time.Second)))
+	assert.False(t, spend(wallets[1], timeLock.Add(time.Second)))
+	assert.False(t, spend(wallets[2], timeLock.Add(time.Second)))
+
+	// only the fallback address can spend after the fallback deadline
+	assert.False(t, spend(wallets[0], fallbackDeadline.Add(time.Second)))
+	assert.True(t, spend(wallets[1], fallbackDeadline.Add(time.Second)))
+}
+
+func TestAliasOutput_Bytes(t *testing.T) {
+	wallets := createWallets(2)
+	origin := NewAliasOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 100}), wallets[0].address, wallets[1].address).WithStateData([]byte("state"))
+
+	restoredOutput, consumedBytes, err := OutputFromBytes(origin.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, len(origin.Bytes()), consumedBytes)
+	assert.Equal(t, AliasOutputType, restoredOutput.Type())
+	assert.Equal(t, origin.Bytes(), restoredOutput.Bytes())
+	assert.Equal(t, []byte("state"), restoredOutput.(*AliasOutput).StateData())
+	assert.True(t, restoredOutput.(*AliasOutput).IsOrigin())
+	assert.Equal(t, origin.Bytes(), origin.Clone().Bytes())
+
+	// the alias ID is derived from the OutputID and stays the same for the next state
+	origin.SetID(NewOutputID(TransactionID{1}, 0))
+	booked := origin.UpdateMintingColor()
+	assert.False(t, booked.IsOrigin())
+	assert.True(t, NewAliasAddress(origin.ID().Bytes()).Equals(booked.AliasAddress()))
+
+	next := booked.NextStateTransition(booked.Balances(), []byte("next"))
+	assert.True(t, booked.AliasAddress().Equals(next.AliasAddress()))
+	assert.Equal(t, uint32(1), next.StateIndex())
+}
+
+func TestAliasOutput_UnlockValid(t *testing.T) {
+	wallets := createWallets(3)
+	balances := NewColoredBalances(map[Color]uint64{ColorIOTA: 100})
+	aliasOutput := NewAliasOutput(balances, wallets[0].address, wallets[1].address)
+	aliasOutput.SetID(NewOutputID(TransactionID{1}, 0))
+	aliasOutput = aliasOutput.UpdateMintingColor()
+
+	spend := func(spender wallet, outputs ...Output) bool {
+		txEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(aliasOutput.Input()), NewOutputs(outputs...))
+		tx := NewTransaction(txEssence, spender.unlockBlocks(txEssence))
+
+		unlockValid, err := aliasOutput.UnlockValid(tx, tx.UnlockBlocks()[0])
+		require.NoError(t, err)
+
+		return unlockValid && AliasOutputsValid(Outputs{aliasOutput}, tx.Essence().Outputs())
+	}
+
+	// state transitions need to be signed by the state controller
+	stateTransition := aliasOutput.NextStateTransition(balances, []byte("state"))
+	assert.True(t, spend(wallets[0], stateTransition))
+	assert.False(t, spend(wallets[1], stateTransition))
+
+	// governance transitions need to be signed by the governance controller
+	governanceTransition := aliasOutput.NextGovernanceTransition(wallets[2].address, wallets[1].address)
+	assert.True(t, spend(wallets[1], governanceTransition))
+	assert.False(t, spend(wallets[0], governanceTransition))
+
+	// the state controller can not change the controllers while updating the state
+	invalidTransition := aliasOutput.NextStateTransition(balances, []byte("state"))
+	invalidTransition.stateAddress = wallets[2].address
+	assert.False(t, spend(wallets[0], invalidTransition))
+	assert.False(t, spend(wallets[1], invalidTransition))
+
+	// destroying the alias needs to be signed by the governance controller
+	assert.True(t, spend(wallets[1], NewSigLockedSingleOutput(100, wallets[2].address)))
+	assert.False(t, spend(wallets[0], NewSigLockedSingleOutput(100, wallets[2].address)))
+
+	// foreign aliases can not be continued
+	foreignAlias := NewAliasOutput(balances, wallets[0].address, wallets[1].address)
+	foreignAlias.SetID(NewOutputID(TransactionID{2}, 0))
+	assert.False(t, spend(wallets[0], stateTransition, foreignAlias.UpdateMintingColor().NextStateTransition(balances, nil)))
+}
+
+func TestAliasUnlockBlock(t *testing.T) {
+	wallets := createWallets(2)
+	balances := NewColoredBalances(map[Color]uint64{ColorIOTA: 100})
+	aliasOutput := NewAliasOutput(balances, wallets[0].address, wallets[1].address)
+	aliasOutput.SetID(NewOutputID(TransactionID{1}, 0))
+	aliasOutput = aliasOutput.UpdateMintingColor()
+
+	ownedOutput := NewSigLockedSingleOutput(100, aliasOutput.AliasAddress())
+	ownedOutput.SetID(NewOutputID(TransactionID{2}, 0))
+
+	spend := func(signer wallet, chainedOutput Output) bool {
+		txEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(aliasOutput.Input(), ownedOutput.Input()), NewOutputs(chainedOutput, NewSigLockedSingleOutput(100, wallets[0].address)))
+		inputs := Outputs{aliasOutput, ownedOutput}
+		if txEssence.Inputs()[0].(*UTXOInput).ReferencedOutputID() != aliasOutput.ID() {
+			inputs = Outputs{ownedOutput, aliasOutput}
+		}
+
+		unlockBlocks := make(UnlockBlocks, len(inputs))
+		for i, input := range inputs {
+			if input == aliasOutput {
+				unlockBlocks[i] = NewSignatureUnlockBlock(signer.sign(txEssence))
+				unlockBlocks[1-i] = NewAliasUnlockBlock(uint16(i))
+			}
+		}
+
+		restoredUnlockBlocks, _, err := UnlockBlocksFromBytes(unlockBlocks.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, unlockBlocks.Bytes(), restoredUnlockBlocks.Bytes())
+
+		return UnlockBlocksValid(inputs, NewTransaction(txEssence, unlockBlocks))
+	}
+
+	// outputs owned by the alias can be spent in a state transition
+	assert.True(t, spend(wallets[0], aliasOutput.NextStateTransition(balances, nil)))
+
+	// outputs owned by the alias can not be spent in a governance transition
+	assert.False(t, spend(wallets[1], aliasOutput.NextGovernanceTransition(wallets[0].address, wallets[0].address)))
+}
+
+func TestAliasUnlockBlock_ThresholdStateController(t *testing.T) {
+	wallets := createWallets(3)
+	thresholdAddress, err := NewThresholdAddress(2, wallets[0].publicKey(), wallets[1].publicKey(), wallets[2].publicKey())
+	require.NoError(t, err)
+	balances := NewColoredBalances(map[Color]uint64{ColorIOTA: 100})
+	aliasOutput := NewAliasOutput(balances, thresholdAddress, wallets[0].address)
+	aliasOutput.SetID(NewOutputID(TransactionID{1}, 0))
+	aliasOutput = aliasOutput.UpdateMintingColor()
+
+	ownedOutput := NewSigLockedSingleOutput(100, aliasOutput.AliasAddress())
+	ownedOutput.SetID(NewOutputID(TransactionID{2}, 0))
+
+	txEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(aliasOutput.Input(), ownedOutput.Input()), NewOutputs(aliasOutput.NextStateTransition(balances, nil), NewSigLockedSingleOutput(100, wallets[0].address)))
+	require.Equal(t, aliasOutput.ID(), txEssence.Inputs()[0].(*UTXOInput).ReferencedOutputID(), "the test expects the alias to be the first Input")
+
+	thresholdUnlockBlock, err := NewThresholdUnlockBlock(2, wallets[0].publicKey(), wallets[1].publicKey(), wallets[2].publicKey())
+	require.NoError(t, err)
+	for _, w := range wallets[:2] {
+		require.NoError(t, thresholdUnlockBlock.AddSignature(w.publicKey(), w.privateKey().Sign(txEssence.Bytes())))
+	}
+
+	// the Outputs owned by an alias with a threshold state controller can be spent in a state transition
+	assert.True(t, UnlockBlocksValid(Outputs{aliasOutput, ownedOutput}, NewTransaction(txEssence, UnlockBlocks{thresholdUnlockBlock, NewAliasUnlockBlock(0)})))
+}
+
+func TestAliasUnlockBlock_Invalid(t *testing.T) {
+	wallets := createWallets(2)
+	balances := NewColoredBalances(map[Color]uint64{ColorIOTA: 100})
+	newAlias := func(transactionID TransactionID) *AliasOutput {
+		aliasOutput := NewAliasOutput(balances, wallets[0].address, wallets[1].address)
+		aliasOutput.SetID(NewOutputID(transactionID, 0))
+		return aliasOutput.UpdateMintingColor()
+	}
+	aliasOutput := newAlias(TransactionID{1})
+	ownedOutput := NewSigLockedSingleOutput(100, aliasOutput.AliasAddress())
+	ownedOutput.SetID(NewOutputID(TransactionID{2}, 0))
+
+	// valid returns the result of UnlockBlocksValid for the given Inputs (in the order of the Transaction) and the
+	// UnlockBlocks that are created by the given function.
+	valid := func(inputOutputs Outputs, outputs Outputs, unlockBlocks func(txEssence *TransactionEssence) UnlockBlocks) bool {
+		inputs := make(Inputs, len(inputOutputs))
+		for i, output := range inputOutputs {
+			inputs[i] = output.Input()
+		}
+		txEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(inputs...), outputs)
+		sortedInputs := make(Outputs, len(inputOutputs))
+		for i, input := range txEssence.Inputs() {
+			for _, output := range inputOutputs {
+				if output.ID() == input.(*UTXOInput).ReferencedOutputID() {
+					sortedInputs[i] = output
+				}
+			}
+		}
+		require.Equal(t, inputOutputs, sortedInputs, "the test expects the Inputs to be sorted")
+
+		return UnlockBlocksValid(sortedInputs, NewTransaction(txEssence, unlockBlocks(txEssence)))
+	}
+	stateTransition := NewOutputs(aliasOutput.NextStateTransition(balances, nil), NewSigLockedSingleOutput(100, wallets[0].address))
+
+	// the alias needs to be unlocked before the Outputs that it owns
+	assert.True(t, valid(Outputs{aliasOutput, ownedOutput}, stateTransition, func(txEssence *TransactionEssence) UnlockBlocks {
+		return UnlockBlocks{NewSignatureUnlockBlock(wallets[0].sign(txEssence)), NewAliasUnlockBlock(0)}
+	}))
+
+	// an AliasUnlockBlock can not reference itself
+	assert.False(t, valid(Outputs{aliasOutput}, NewOutputs(aliasOutput.NextStateTransition(balances, nil)), func(txEssence *TransactionEssence) UnlockBlocks {
+		return UnlockBlocks{NewAliasUnlockBlock(0)}
+	}))
+	assert.False(t, valid(Outputs{aliasOutput, ownedOutput}, stateTransition, func(txEssence *TransactionEssence) UnlockBlocks {
+		return UnlockBlocks{NewSignatureUnlockBlock(wallets[0].sign(txEssence)), NewAliasUnlockBlock(1)}
+	}))
+
+	// an AliasUnlockBlock can not reference a later Input
+	laterAlias := newAlias(TransactionID{3})
+	ownedByLaterAlias := NewSigLockedSingleOutput(100, laterAlias.AliasAddress())
+	ownedByLaterAlias.SetID(NewOutputID(TransactionID{2}, 0))
+	assert.False(t, valid(Outputs{ownedByLaterAlias, laterAlias}, NewOutputs(laterAlias.NextStateTransition(balances, nil), NewSigLockedSingleOutput(100, wallets[0].address)), func(txEssence *TransactionEssence) UnlockBlocks {
+		return UnlockBlocks{NewAliasUnlockBlock(1), NewSignatureUnlockBlock(wallets[0].sign(txEssence))}
+	}))
+
+	// AliasOutputs can not be unlocked by another alias, even if they are owned by it
+	ownedAlias := NewAliasOutput(balances, aliasOutput.AliasAddress(), aliasOutput.AliasAddress())
+	ownedAlias.SetID(NewOutputID(TransactionID{2}, 0))
+	ownedAlias = ownedAlias.UpdateMintingColor()
+	assert.False(t, valid(Outputs{aliasOutput, ownedAlias}, NewOutputs(aliasOutput.NextStateTransition(balances, nil), ownedAlias.NextStateTransition(balances, nil)), func(txEssence *TransactionEssence) UnlockBlocks {
+		return UnlockBlocks{NewSignatureUnlockBlock(wallets[0].sign(txEssence)), NewAliasUnlockBlock(0)}
+	}))
+
+	// the referenced Input has to be an alias
+	signatureLockedOutput := NewSigLockedSingleOutput(100, wallets[0].address)
+	signatureLockedOutput.SetID(NewOutputID(TransactionID{1}, 0))
+	assert.False(t, valid(Outputs{signatureLockedOutput, ownedOutput}, NewOutputs(NewSigLockedSingleOutput(200, wallets[0].address)), func(txEssence *TransactionEssence) UnlockBlocks {
+		return UnlockBlocks{NewSignatureUnlockBlock(wallets[0].sign(txEssence)), NewAliasUnlockBlock(0)}
+	}))
+}
@@ -616,6 +616,8 @@ type TransactionMetadata struct {
 	finalizedMutex          sync.RWMutex
 	lazyBooked              bool
 	lazyBookedMutex         sync.RWMutex
+	colorSupplyCounted      bool
+	colorSupplyCountedMutex sync.RWMutex
 
 	objectstorage.StorableObjectFlags
 }
@@ -666,6 +668,10 @@ func TransactionMetadataFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (t
 		err = xerrors.Errorf("failed to parse lazy booked flag (%v): %w", err, cerrors.ErrParseBytesFailed)
 		return
 	}
+	if transactionMetadata.colorSupplyCounted, err = marshalUtil.ReadBool(); err != nil {
+		err = xerrors.Errorf("failed to parse color supply counted flag (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
 
 	return
 }
@@ -798,6 +804,32 @@ func (t *TransactionMetadata) SetLazyBooked(lazyBooked bool) (modified bool) {
 	return
 }
 
+// ColorSupplyCounted returns a boolean flag that indicates if the tokens that were minted and destroyed by the
+// Transaction are counted in the ColorSupply index.
+func (t *TransactionMetadata) ColorSupplyCounted() (colorSupplyCounted bool) {
+	t.colorSupplyCountedMutex.RLock()
+	defer t.colorSupplyCountedMutex.RUnlock()
+
+	return t.colorSupplyCounted
+}
+
+// SetColorSupplyCounted updates the color supply counted flag of the Transaction. It returns true if the value was
+// modified.
+func (t *TransactionMetadata) SetColorSupplyCounted(colorSupplyCounted bool) (modified bool) {
+	t.colorSupplyCountedMutex.Lock()
+	defer t.colorSupplyCountedMutex.Unlock()
+
+	if t.colorSupplyCounted == colorSupplyCounted {
+		return
+	}
+
+	t.colorSupplyCounted = colorSupplyCounted
+	t.SetModified()
+	modified = true
+
+	return
+}
+
 // Bytes marshals the TransactionMetadata into a sequence of bytes.
 func (t *TransactionMetadata) Bytes() []byte {
 	return byteutils.ConcatBytes(t.ObjectStorageKey(), t.ObjectStorageValue())
@@ -812,6 +844,7 @@ func (t *TransactionMetadata) String() string {
 		stringify.StructField("solidificationTime", t.SolidificationTime()),
 		stringify.StructField("finalized", t.Finalized()),
 		stringify.StructField("lazyBooked", t.LazyBooked()),
+		stringify.StructField("colorSupplyCounted", t.ColorSupplyCounted()),
 	)
 }
 
@@ -835,6 +868,7 @@ func (t *TransactionMetadata) ObjectStorageValue() []byte {
 		WriteTime(t.SolidificationTime()).
 		WriteBool(t.Finalized()).
 		WriteBool(t.LazyBooked()).
+		WriteBool(t.ColorSupplyCounted()).
 		Bytes()
 }
 
@@ -1,10 +1,12 @@
 package ledgerstate
 
 import (
+	"bytes"
 	"strconv"
 
 	"github.com/iotaledger/hive.go/byteutils"
 	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/iotaledger/hive.go/stringify"
 	"golang.org/x/xerrors"
@@ -18,6 +20,12 @@ const (
 
 	// ReferenceUnlockBlockType represents the type of a ReferenceUnlockBlock.
 	ReferenceUnlockBlockType
+
+	// AliasUnlockBlockType represents the type of an AliasUnlockBlock.
+	AliasUnlockBlockType
+
+	// ThresholdUnlockBlockType represents the type of a ThresholdUnlockBlock.
+	ThresholdUnlockBlockType
 )
 
 // UnlockBlockType represents the type of the UnlockBlock. Different types of UnlockBlocks can unlock different types of
@@ -29,6 +37,8 @@ func (a UnlockBlockType) String() string {
 	return [...]string{
 		"SignatureUnlockBlockType",
 		"ReferenceUnlockBlockType",
+		"AliasUnlockBlockType",
+		"ThresholdUnlockBlockType",
 	}[a]
 }
 
@@ -49,6 +59,14 @@ type UnlockBlock interface {
 	String() string
 }
 
+// AddressSignatureUnlockBlock is an UnlockBlock that unlocks Outputs by providing valid signatures for their Address.
+type AddressSignatureUnlockBlock interface {
+	UnlockBlock
+
+	// AddressSignatureValid returns true if the UnlockBlock correctly signs the given Address.
+	AddressSignatureValid(address Address, signedData []byte) bool
+}
+
 // UnlockBlockFromBytes unmarshals an UnlockBlock from a sequence of bytes.
 func UnlockBlockFromBytes(bytes []byte) (unlockBlock UnlockBlock, consumedBytes int, err error) {
 	marshalUtil := marshalutil.New(bytes)
@@ -81,6 +99,16 @@ func UnlockBlockFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (unlockBlo
 			err = xerrors.Errorf("failed to parse ReferenceUnlockBlock from MarshalUtil: %w", err)
 			return
 		}
+	case AliasUnlockBlockType:
+		if unlockBlock, err = AliasUnlockBlockFromMarshalUtil(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse AliasUnlockBlock from MarshalUtil: %w", err)
+			return
+		}
+	case ThresholdUnlockBlockType:
+		if unlockBlock, err = ThresholdUnlockBlockFromMarshalUtil(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse ThresholdUnlockBlock from MarshalUtil: %w", err)
+			return
+		}
 	default:
 		err = xerrors.Errorf("unsupported UnlockBlockType (%X): %w", unlockBlockType, cerrors.ErrParseBytesFailed)
 		return
@@ -219,7 +247,7 @@ func (s *SignatureUnlockBlock) String() string {
 }
 
 // code contract (make sure the type implements all required methods)
-var _ UnlockBlock = &SignatureUnlockBlock{}
+var _ AddressSignatureUnlockBlock = &SignatureUnlockBlock{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -299,3 +327,312 @@ func (r *ReferenceUnlockBlock) String() string {
 var _ UnlockBlock = &ReferenceUnlockBlock{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AliasUnlockBlock /////////////////////////////////////////////////////////////////////////////////////////////
+
+// AliasUnlockBlock defines an UnlockBlock which unlocks an Output that is owned by an AliasAddress. It references the
+// Input of the same Transaction that consumes the corresponding AliasOutput (which needs to be unlocked by its state
+// controller).
+type AliasUnlockBlock struct {
+	referencedIndex uint16
+}
+
+// NewAliasUnlockBlock is the constructor for AliasUnlockBlocks.
+func NewAliasUnlockBlock(referencedIndex uint16) *AliasUnlockBlock {
+	return &AliasUnlockBlock{
+		referencedIndex: referencedIndex,
+	}
+}
+
+// AliasUnlockBlockFromBytes unmarshals an AliasUnlockBlock from a sequence of bytes.
+func AliasUnlockBlockFromBytes(bytes []byte) (unlockBlock *AliasUnlockBlock, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if unlockBlock, err = AliasUnlockBlockFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse AliasUnlockBlock from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// AliasUnlockBlockFromMarshalUtil unmarshals an AliasUnlockBlock using a MarshalUtil (for easier unmarshaling).
+func AliasUnlockBlockFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (unlockBlock *AliasUnlockBlock, err error) {
+	unlockBlockType, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse UnlockBlockType (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if UnlockBlockType(unlockBlockType) != AliasUnlockBlockType {
+		err = xerrors.Errorf("invalid UnlockBlockType (%X): %w", unlockBlockType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	unlockBlock = &AliasUnlockBlock{}
+	if unlockBlock.referencedIndex, err = marshalUtil.ReadUint16(); err != nil {
+		err = xerrors.Errorf("failed to parse referencedIndex (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	return
+}
+
+// AliasInputIndex returns the index of the Input that consumes the AliasOutput which unlocks the Output.
+func (a *AliasUnlockBlock) AliasInputIndex() uint16 {
+	return a.referencedIndex
+}
+
+// Type returns the UnlockBlockType of the UnlockBlock.
+func (a *AliasUnlockBlock) Type() UnlockBlockType {
+	return AliasUnlockBlockType
+}
+
+// Bytes returns a marshaled version of the UnlockBlock.
+func (a *AliasUnlockBlock) Bytes() []byte {
+	return marshalutil.New(1 + marshalutil.Uint16Size).
+		WriteByte(byte(AliasUnlockBlockType)).
+		WriteUint16(a.referencedIndex).
+		Bytes()
+}
+
+// String returns a human readable version of the UnlockBlock.
+func (a *AliasUnlockBlock) String() string {
+	return stringify.Struct("AliasUnlockBlock",
+		stringify.StructField("referencedIndex", int(a.referencedIndex)),
+	)
+}
+
+// code contract (make sure the type implements all required methods)
+var _ UnlockBlock = &AliasUnlockBlock{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ThresholdUnlockBlock /////////////////////////////////////////////////////////////////////////////////////////
+
+// ThresholdUnlockBlock represents an UnlockBlock that unlocks a ThresholdAddress. It contains the threshold and the full
+// key set of the Address together with the signatures that were collected from the owners of the keys so far.
+type ThresholdUnlockBlock struct {
+	threshold  uint8
+	publicKeys []ed25519.PublicKey
+	signatures map[uint8]ed25519.Signature
+}
+
+// NewThresholdUnlockBlock is the constructor for ThresholdUnlockBlocks. It creates an UnlockBlock without signatures
+// that can subsequently be signed by the owners of the given public keys.
+func NewThresholdUnlockBlock(threshold uint8, publicKeys ...ed25519.PublicKey) (unlockBlock *ThresholdUnlockBlock, err error) {
+	sortedPublicKeys, err := sortThresholdPublicKeys(threshold, publicKeys)
+	if err != nil {
+		err = xerrors.Errorf("invalid key set of ThresholdUnlockBlock: %w", err)
+		return
+	}
+
+	return &ThresholdUnlockBlock{
+		threshold:  threshold,
+		publicKeys: sortedPublicKeys,
+		signatures: make(map[uint8]ed25519.Signature),
+	}, nil
+}
+
+// ThresholdUnlockBlockFromBytes unmarshals a ThresholdUnlockBlock from a sequence of bytes.
+func ThresholdUnlockBlockFromBytes(bytes []byte) (unlockBlock *ThresholdUnlockBlock, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if unlockBlock, err = ThresholdUnlockBlockFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse ThresholdUnlockBlock from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// ThresholdUnlockBlockFromMarshalUtil unmarshals a ThresholdUnlockBlock using a MarshalUtil (for easier unmarshaling).
+func ThresholdUnlockBlockFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (unlockBlock *ThresholdUnlockBlock, err error) {
+	unlockBlockType, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse UnlockBlockType (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if UnlockBlockType(unlockBlockType) != ThresholdUnlockBlockType {
+		err = xerrors.Errorf("invalid UnlockBlockType (%X): %w", unlockBlockType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	threshold, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse threshold (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	publicKeysCount, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse public keys count (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	publicKeys := make([]ed25519.PublicKey, publicKeysCount)
+	for i := range publicKeys {
+		if publicKeys[i], err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse public key (%v): %w", err, cerrors.ErrParseBytesFailed)
+			return
+		}
+	}
+	if unlockBlock, err = NewThresholdUnlockBlock(threshold, publicKeys...); err != nil {
+		err = xerrors.Errorf("failed to create ThresholdUnlockBlock: %w", err)
+		return
+	}
+	// the key set has to be marshaled in its canonical order, so that every UnlockBlock has a unique representation
+	for i, publicKey := range publicKeys {
+		if unlockBlock.publicKeys[i] != publicKey {
+			err = xerrors.Errorf("public keys are not sorted: %w", cerrors.ErrParseBytesFailed)
+			return
+		}
+	}
+
+	var previousKeyIndex uint8
+	signaturesCount, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse signatures count (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if signaturesCount > threshold {
+		err = xerrors.Errorf("signatures count (%d) exceeds the threshold (%d): %w", signaturesCount, threshold, cerrors.ErrParseBytesFailed)
+		return
+	}
+	for i := uint8(0); i < signaturesCount; i++ {
+		keyIndex, keyIndexErr := marshalUtil.ReadUint8()
+		if keyIndexErr != nil {
+			err = xerrors.Errorf("failed to parse key index (%v): %w", keyIndexErr, cerrors.ErrParseBytesFailed)
+			return
+		}
+		if int(keyIndex) >= len(unlockBlock.publicKeys) {
+			err = xerrors.Errorf("key index (%d) out of bounds: %w", keyIndex, cerrors.ErrParseBytesFailed)
+			return
+		}
+		if i > 0 && keyIndex <= previousKeyIndex {
+			err = xerrors.Errorf("key index (%d) is not strictly increasing: %w", keyIndex, cerrors.ErrParseBytesFailed)
+			return
+		}
+		previousKeyIndex = keyIndex
+
+		if unlockBlock.signatures[keyIndex], err = ed25519.ParseSignature(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse signature (%v): %w", err, cerrors.ErrParseBytesFailed)
+			return
+		}
+	}
+
+	return
+}
+
+// Threshold returns the amount of signatures that are required to unlock the Address.
+func (t *ThresholdUnlockBlock) Threshold() uint8 {
+	return t.threshold
+}
+
+// PublicKeys returns the sorted key set of the Address.
+func (t *ThresholdUnlockBlock) PublicKeys() []ed25519.PublicKey {
+	return t.publicKeys
+}
+
+// Address returns the ThresholdAddress that is unlocked by the UnlockBlock.
+func (t *ThresholdUnlockBlock) Address() *ThresholdAddress {
+	return &ThresholdAddress{
+		digest: thresholdAddressDigest(t.threshold, t.publicKeys),
+	}
+}
+
+// AddSignature adds the signature of the owner of the given public key to the UnlockBlock. It fails if the UnlockBlock
+// already contains the required amount of signatures.
+func (t *ThresholdUnlockBlock) AddSignature(publicKey ed25519.PublicKey, signature ed25519.Signature) (err error) {
+	for i, existingPublicKey := range t.publicKeys {
+		if existingPublicKey != publicKey {
+			continue
+		}
+
+		if _, exists := t.signatures[uint8(i)]; !exists && len(t.signatures) >= int(t.threshold) {
+			return xerrors.Errorf("UnlockBlock already contains %d signatures: %w", t.threshold, cerrors.ErrFatal)
+		}
+		t.signatures[uint8(i)] = signature
+		return
+	}
+
+	return xerrors.Errorf("public key %s is not part of the key set: %w", publicKey, cerrors.ErrFatal)
+}
+
+// Merge adds the signatures of another (partially signed) UnlockBlock for the same Address to the UnlockBlock. The
+// signatures are added in the order of the key set until the threshold is reached.
+func (t *ThresholdUnlockBlock) Merge(other *ThresholdUnlockBlock) (err error) {
+	if !bytes.Equal(t.Address().Digest(), other.Address().Digest()) {
+		return xerrors.Errorf("UnlockBlocks belong to different ThresholdAddresses: %w", cerrors.ErrFatal)
+	}
+
+	for keyIndex := range other.publicKeys {
+		if len(t.signatures) >= int(t.threshold) {
+			break
+		}
+		if signature, exists := other.signatures[uint8(keyIndex)]; exists {
+			t.signatures[uint8(keyIndex)] = signature
+		}
+	}
+
+	return
+}
+
+// SignatureCount returns the amount of signatures that were added to the UnlockBlock.
+func (t *ThresholdUnlockBlock) SignatureCount() int {
+	return len(t.signatures)
+}
+
+// AddressSignatureValid returns true if the UnlockBlock belongs to the given Address and if it contains exactly
+// threshold signatures that are all valid signatures of the signed data.
+func (t *ThresholdUnlockBlock) AddressSignatureValid(address Address, signedData []byte) bool {
+	if address.Type() != ThresholdAddressType || !bytes.Equal(t.Address().Digest(), address.Digest()) {
+		return false
+	}
+	if len(t.signatures) != int(t.threshold) {
+		return false
+	}
+
+	for keyIndex, signature := range t.signatures {
+		if !t.publicKeys[keyIndex].VerifySignature(signedData, signature) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Type returns the UnlockBlockType of the UnlockBlock.
+func (t *ThresholdUnlockBlock) Type() UnlockBlockType {
+	return ThresholdUnlockBlockType
+}
+
+// Bytes returns a marshaled version of the UnlockBlock.
+func (t *ThresholdUnlockBlock) Bytes() []byte {
+	marshalUtil := marshalutil.New().
+		WriteByte(byte(ThresholdUnlockBlockType)).
+		WriteUint8(t.threshold).
+		WriteUint8(uint8(len(t.publicKeys)))
+	for _, publicKey := range t.publicKeys {
+		marshalUtil.WriteBytes(publicKey.Bytes())
+	}
+
+	marshalUtil.WriteUint8(uint8(len(t.signatures)))
+	for keyIndex := range t.publicKeys {
+		if signature, signatureExists := t.signatures[uint8(keyIndex)]; signatureExists {
+			marshalUtil.WriteUint8(uint8(keyIndex)).WriteBytes(signature.Bytes())
+		}
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// String returns a human readable version of the UnlockBlock.
+func (t *ThresholdUnlockBlock) String() string {
+	return stringify.Struct("ThresholdUnlockBlock",
+		stringify.StructField("threshold", int(t.threshold)),
+		stringify.StructField("publicKeys", t.publicKeys),
+		stringify.StructField("signatures", len(t.signatures)),
+	)
+}
+
+// code contract (make sure the type implements all required methods)
+var _ AddressSignatureUnlockBlock = &ThresholdUnlockBlock{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
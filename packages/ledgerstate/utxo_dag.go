@@ -1,10 +1,13 @@
 package ledgerstate
 
 import (
+	"bytes"
 	"container/list"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/database"
 	"github.com/iotaledger/hive.go/byteutils"
@@ -17,6 +20,7 @@ import (
 	"github.com/iotaledger/hive.go/stringify"
 	"github.com/iotaledger/hive.go/types"
 	"github.com/iotaledger/hive.go/typeutils"
+	"golang.org/x/crypto/blake2b"
 	"golang.org/x/xerrors"
 )
 
@@ -33,6 +37,9 @@ type UTXODAG struct {
 	outputMetadataStorage       *objectstorage.ObjectStorage
 	consumerStorage             *objectstorage.ObjectStorage
 	addressOutputMappingStorage *objectstorage.ObjectStorage
+	colorSupplyStorage          *objectstorage.ObjectStorage
+	addressHistoryStorage       *objectstorage.ObjectStorage
+	addressHistoryBucketStorage *objectstorage.ObjectStorage
 	branchDAG                   *BranchDAG
 	shutdownOnce                sync.Once
 }
@@ -43,6 +50,7 @@ func NewUTXODAG(store kvstore.KVStore, branchDAG *BranchDAG) (utxoDAG *UTXODAG)
 	utxoDAG = &UTXODAG{
 		Events: &UTXODAGEvents{
 			TransactionBranchIDUpdated: events.NewEvent(transactionIDEventHandler),
+			TransactionBooked:          events.NewEvent(transactionBookedEventHandler),
 		},
 		transactionStorage:          osFactory.New(PrefixTransactionStorage, TransactionFromObjectStorage, transactionStorageOptions...),
 		transactionMetadataStorage:  osFactory.New(PrefixTransactionMetadataStorage, TransactionMetadataFromObjectStorage, transactionMetadataStorageOptions...),
@@ -50,8 +58,13 @@ func NewUTXODAG(store kvstore.KVStore, branchDAG *BranchDAG) (utxoDAG *UTXODAG)
 		outputMetadataStorage:       osFactory.New(PrefixOutputMetadataStorage, OutputMetadataFromObjectStorage, outputMetadataStorageOptions...),
 		consumerStorage:             osFactory.New(PrefixConsumerStorage, ConsumerFromObjectStorage, consumerStorageOptions...),
 		addressOutputMappingStorage: osFactory.New(PrefixAddressOutputMappingStorage, AddressOutputMappingFromObjectStorage, addressOutputMappingStorageOptions...),
+		colorSupplyStorage:          osFactory.New(PrefixColorSupplyStorage, ColorSupplyFromObjectStorage, colorSupplyStorageOptions...),
+		addressHistoryStorage:       osFactory.New(PrefixAddressHistoryStorage, AddressHistoryEntryFromObjectStorage, addressHistoryStorageOptions...),
+		addressHistoryBucketStorage: osFactory.New(PrefixAddressHistoryBucketStorage, AddressHistoryBucketFromObjectStorage, addressHistoryBucketStorageOptions...),
 		branchDAG:                   branchDAG,
 	}
+	branchDAG.Events.BranchRejected.Attach(events.NewClosure(utxoDAG.revertColorSupplies))
+
 	return
 }
 
@@ -64,6 +77,9 @@ func (u *UTXODAG) Shutdown() {
 		u.outputMetadataStorage.Shutdown()
 		u.consumerStorage.Shutdown()
 		u.addressOutputMappingStorage.Shutdown()
+		u.colorSupplyStorage.Shutdown()
+		u.addressHistoryStorage.Shutdown()
+		u.addressHistoryBucketStorage.Shutdown()
 	})
 }
 
@@ -86,6 +102,10 @@ func (u *UTXODAG) CheckTransaction(transaction *Transaction) (valid bool, err er
 		err = xerrors.Errorf("spending of referenced consumedOutputs is not authorized: %w", ErrTransactionInvalid)
 		return
 	}
+	if !AliasOutputsValid(consumedOutputs, transaction.Essence().Outputs()) {
+		err = xerrors.Errorf("aliases of transaction are not continued correctly: %w", ErrTransactionInvalid)
+		return
+	}
 
 	valid = true
 	return
@@ -178,6 +198,15 @@ func (u *UTXODAG) BookTransaction(transaction *Transaction) (targetBranch Branch
 		targetBranch = u.bookConflictingTransaction(transaction, transactionMetadata, inputsMetadata, normalizedBranchIDs, conflictingInputs.ByID())
 	}
 
+	if transactionMetadata.SetColorSupplyCounted(true) {
+		u.updateColorSupplies(consumedOutputs, transaction.Essence().Outputs(), false)
+	}
+
+	u.Events.TransactionBooked.Trigger(&TransactionBookedEvent{
+		Transaction: transaction,
+		Inputs:      consumedOutputs,
+	})
+
 	return
 }
 
@@ -246,6 +275,24 @@ func (u *UTXODAG) Consumers(outputID OutputID) (cachedConsumers CachedConsumers)
 	return
 }
 
+// FutureCone returns the IDs of the given Transaction and of all the Transactions that (directly or indirectly) spend
+// its Outputs. If the given Transaction gets rejected, all of these Transactions are rejected as well.
+func (u *UTXODAG) FutureCone(transactionID TransactionID) (transactionIDs TransactionIDs) {
+	transactionIDs = TransactionIDs{transactionID: types.Void}
+	u.walkFutureCone(u.createdOutputIDsOfTransaction(transactionID), func(transactionID TransactionID) (nextOutputsToVisit []OutputID) {
+		transactionIDs[transactionID] = types.Void
+
+		return u.createdOutputIDsOfTransaction(transactionID)
+	}, types.True)
+
+	return
+}
+
+// ColorSupply retrieves the ColorSupply of the given Color which keeps track of the minted and destroyed tokens.
+func (u *UTXODAG) ColorSupply(color Color) (cachedColorSupply *CachedColorSupply) {
+	return &CachedColorSupply{CachedObject: u.colorSupplyStorage.Load(color.Bytes())}
+}
+
 // LoadSnapshot creates a set of outputs in the UTXO-DAG, that are forming the genesis for future transactions.
 func (u *UTXODAG) LoadSnapshot(snapshot map[TransactionID]map[Address]*ColoredBalances) {
 	index := uint16(0)
@@ -258,41 +305,117 @@ func (u *UTXODAG) LoadSnapshot(snapshot map[TransactionID]map[Address]*ColoredBa
 			fmt.Println("Balance: ", balance)
 			output := NewSigLockedColoredOutput(balance, address)
 			output.SetID(NewOutputID(transactionID, index))
-			cachedOutput, stored := u.outputStorage.StoreIfAbsent(output)
-			if stored {
-				cachedOutput.Release()
-			}
-
-			//store addressOutputMapping
-			u.StoreAddressOutputMapping(address, output.ID())
-
-			// store OutputMetadata
-			metadata := NewOutputMetadata(output.ID())
-			metadata.SetBranchID(MasterBranchID)
-			metadata.SetSolid(true)
-			metadata.SetFinalized(true)
-			cachedMetadata, stored := u.outputMetadataStorage.StoreIfAbsent(metadata)
-			if stored {
-				cachedMetadata.Release()
-			}
+			u.storeSnapshotOutput(output)
 
 			index++
 		}
 
-		// store TransactionMetadata
-		transactionMetadata := NewTransactionMetadata(transactionID)
-		transactionMetadata.SetSolid(true)
-		transactionMetadata.SetBranchID(MasterBranchID)
-		transactionMetadata.SetFinalized(true)
+		u.storeSnapshotTransactionMetadata(transactionID)
+	}
+}
+
+// LoadOutputs creates the given Outputs (that have their IDs set already) in the UTXO-DAG as confirmed and unspent
+// Outputs of the MasterBranch. It is used to bootstrap a node from a snapshot of the ledger state of another node.
+func (u *UTXODAG) LoadOutputs(outputs Outputs) {
+	transactionIDs := make(TransactionIDs)
+	for _, output := range outputs {
+		u.storeSnapshotOutput(output)
+		transactionIDs[output.ID().TransactionID()] = types.Void
+	}
 
-		(&CachedTransactionMetadata{CachedObject: u.transactionMetadataStorage.ComputeIfAbsent(transactionID.Bytes(), func(key []byte) objectstorage.StorableObject {
-			transactionMetadata.Persist()
-			transactionMetadata.SetModified()
-			return transactionMetadata
-		})}).Release()
+	for transactionID := range transactionIDs {
+		u.storeSnapshotTransactionMetadata(transactionID)
 	}
 }
 
+// ConfirmedUnspentOutputs returns all Outputs that were created by a confirmed Transaction and that are not spent by
+// another confirmed Transaction.
+func (u *UTXODAG) ConfirmedUnspentOutputs() (outputs Outputs) {
+	outputs = make(Outputs, 0)
+	u.outputStorage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		(&CachedOutput{CachedObject: cachedObject}).Consume(func(output Output) {
+			if !u.transactionConfirmed(output.ID().TransactionID()) || u.spentByConfirmedTransaction(output.ID()) {
+				return
+			}
+
+			outputs = append(outputs, output.Clone())
+		})
+
+		return true
+	})
+
+	return
+}
+
+// storeSnapshotOutput is an internal utility function that stores an Output of a snapshot together with its
+// OutputMetadata, its AddressOutputMappings and its AddressHistoryEntries.
+func (u *UTXODAG) storeSnapshotOutput(output Output) {
+	cachedOutput, stored := u.outputStorage.StoreIfAbsent(output)
+	if stored {
+		cachedOutput.Release()
+		u.updateColorSupplies(Outputs{}, Outputs{output}, false)
+	}
+
+	//store addressOutputMapping
+	u.StoreAddressOutputMappings(output)
+
+	// store OutputMetadata
+	metadata := NewOutputMetadata(output.ID())
+	metadata.SetBranchID(MasterBranchID)
+	metadata.SetSolid(true)
+	metadata.SetFinalized(true)
+	cachedMetadata, stored := u.outputMetadataStorage.StoreIfAbsent(metadata)
+	if stored {
+		cachedMetadata.Release()
+	}
+}
+
+// storeSnapshotTransactionMetadata is an internal utility function that stores the TransactionMetadata of a
+// Transaction whose Outputs are part of a snapshot.
+func (u *UTXODAG) storeSnapshotTransactionMetadata(transactionID TransactionID) {
+	transactionMetadata := NewTransactionMetadata(transactionID)
+	transactionMetadata.SetSolid(true)
+	transactionMetadata.SetBranchID(MasterBranchID)
+	transactionMetadata.SetFinalized(true)
+
+	(&CachedTransactionMetadata{CachedObject: u.transactionMetadataStorage.ComputeIfAbsent(transactionID.Bytes(), func(key []byte) objectstorage.StorableObject {
+		transactionMetadata.Persist()
+		transactionMetadata.SetModified()
+		return transactionMetadata
+	})}).Release()
+}
+
+// transactionConfirmed is an internal utility function that returns true if the Transaction with the given ID is
+// confirmed.
+func (u *UTXODAG) transactionConfirmed(transactionID TransactionID) bool {
+	inclusionState, err := u.InclusionState(transactionID)
+
+	return err == nil && inclusionState == Confirmed
+}
+
+// spentByConfirmedTransaction is an internal utility function that returns true if the Output with the given ID is
+// spent by a confirmed Transaction.
+func (u *UTXODAG) spentByConfirmedTransaction(outputID OutputID) (spent bool) {
+	u.Consumers(outputID).Consume(func(consumer *Consumer) {
+		spent = spent || u.transactionConfirmed(consumer.TransactionID())
+	})
+
+	return
+}
+
+// OutputsSpentByConfirmedTransactions returns true if all Outputs of the given Transaction are spent by confirmed
+// Transactions.
+func (u *UTXODAG) OutputsSpentByConfirmedTransactions(transactionID TransactionID) (spent bool) {
+	spent = true
+	for _, outputID := range u.createdOutputIDsOfTransaction(transactionID) {
+		if !u.spentByConfirmedTransaction(outputID) {
+			return false
+		}
+	}
+
+	return
+}
+
 // AddressOutputMapping retrieves the outputs for the given address.
 func (u *UTXODAG) AddressOutputMapping(address Address) (cachedAddressOutputMappings CachedAddressOutputMappings) {
 	u.addressOutputMappingStorage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
@@ -302,6 +425,35 @@ func (u *UTXODAG) AddressOutputMapping(address Address) (cachedAddressOutputMapp
 	return
 }
 
+// AddressHistory calls the consumer with the IDs of all Outputs that were ever created on the given Address ordered by
+// their creation time (the Outputs of snapshots come first). The iteration starts after the Output with the given
+// cursor (or at the beginning if the cursor is nil) and stops as soon as the consumer returns false.
+func (u *UTXODAG) AddressHistory(address Address, cursor *OutputID, consumer func(outputID OutputID) bool) {
+	var cursorKey []byte
+	var firstBucket uint64
+	if cursor != nil {
+		cursorEntry := NewAddressHistoryEntry(address, u.outputCreationTime(*cursor), *cursor)
+		cursorKey = cursorEntry.ObjectStorageKey()
+		firstBucket = cursorEntry.Bucket()
+	}
+
+	for _, bucket := range u.addressHistoryBuckets(address) {
+		if bucket < firstBucket {
+			continue
+		}
+
+		for _, entry := range u.addressHistoryEntries(address, bucket) {
+			if cursorKey != nil && bytes.Compare(entry.ObjectStorageKey(), cursorKey) <= 0 {
+				continue
+			}
+
+			if !consumer(entry.OutputID()) {
+				return
+			}
+		}
+	}
+}
+
 // region booking functions ////////////////////////////////////////////////////////////////////////////////////////////
 
 // bookInvalidTransaction is an internal utility function that books the given Transaction into the Branch identified by
@@ -513,9 +665,14 @@ func (u *UTXODAG) bookConsumers(inputsMetadata OutputsMetadata, transactionID Tr
 // bookOutputs creates the Outputs and their corresponding OutputsMetadata in the object storage.
 func (u *UTXODAG) bookOutputs(transaction *Transaction, targetBranch BranchID) {
 	for _, output := range transaction.Essence().Outputs() {
-		// replace ColorMint color with unique color based on OutputID
-		if output.Type() == SigLockedColoredOutputType {
+		// replace ColorMint color with unique color based on OutputID (and derive the AliasAddress of new aliases)
+		switch output.Type() {
+		case SigLockedColoredOutputType:
 			output = output.(*SigLockedColoredOutput).UpdateMintingColor()
+		case ExtendedLockedOutputType:
+			output = output.(*ExtendedLockedOutput).UpdateMintingColor()
+		case AliasOutputType:
+			output = output.(*AliasOutput).UpdateMintingColor()
 		}
 
 		// store Output
@@ -529,6 +686,97 @@ func (u *UTXODAG) bookOutputs(transaction *Transaction, targetBranch BranchID) {
 	}
 }
 
+// revertColorSupplies removes the Transactions of a rejected ConflictBranch and the Transactions that spend their
+// Outputs from the ColorSupply index.
+func (u *UTXODAG) revertColorSupplies(branchDAGEvent *BranchDAGEvent) {
+	defer branchDAGEvent.Release()
+
+	branch := branchDAGEvent.Branch.Unwrap()
+	if branch == nil || branch.Type() != ConflictBranchType {
+		return
+	}
+
+	for transactionID := range u.FutureCone(TransactionID(branch.ID())) {
+		// only the Transactions that were counted before are reverted (exactly once)
+		reverted := false
+		u.TransactionMetadata(transactionID).Consume(func(transactionMetadata *TransactionMetadata) {
+			reverted = transactionMetadata.SetColorSupplyCounted(false)
+		})
+		if !reverted {
+			continue
+		}
+
+		u.Transaction(transactionID).Consume(func(transaction *Transaction) {
+			cachedConsumedOutputs := u.consumedOutputs(transaction)
+			defer cachedConsumedOutputs.Release()
+
+			u.updateColorSupplies(cachedConsumedOutputs.Unwrap(), transaction.Essence().Outputs(), true)
+		})
+	}
+}
+
+// updateColorSupplies updates the ColorSupply index with the tokens that were minted or destroyed by the given Outputs.
+// If revert is true, the previously counted tokens are removed from the index again.
+func (u *UTXODAG) updateColorSupplies(consumedOutputs Outputs, createdOutputs Outputs, revert bool) {
+	consumedTokens := make(map[Color]uint64)
+	for _, consumedOutput := range consumedOutputs {
+		consumedOutput.Balances().ForEach(func(color Color, balance uint64) bool {
+			consumedTokens[color] += balance
+			return true
+		})
+	}
+
+	createdTokens := make(map[Color]uint64)
+	for _, createdOutput := range createdOutputs {
+		createdOutput.Balances().ForEach(func(color Color, balance uint64) bool {
+			// minted tokens receive the Color that is derived from the OutputID (see UpdateMintingColor)
+			if color == ColorMint {
+				color = blake2b.Sum256(createdOutput.ID().Bytes())
+			}
+			createdTokens[color] += balance
+			return true
+		})
+	}
+
+	for color, amount := range createdTokens {
+		if color == ColorIOTA || amount <= consumedTokens[color] {
+			continue
+		}
+
+		u.colorSupply(color).Consume(func(colorSupply *ColorSupply) {
+			if revert {
+				colorSupply.RevertMint(amount - consumedTokens[color])
+				return
+			}
+			colorSupply.Mint(amount - consumedTokens[color])
+		})
+	}
+	for color, amount := range consumedTokens {
+		if color == ColorIOTA || amount <= createdTokens[color] {
+			continue
+		}
+
+		u.colorSupply(color).Consume(func(colorSupply *ColorSupply) {
+			if revert {
+				colorSupply.RevertDestroy(amount - createdTokens[color])
+				return
+			}
+			colorSupply.Destroy(amount - createdTokens[color])
+		})
+	}
+}
+
+// colorSupply retrieves the ColorSupply of the given Color and creates it if it does not exist, yet.
+func (u *UTXODAG) colorSupply(color Color) (cachedColorSupply *CachedColorSupply) {
+	return &CachedColorSupply{CachedObject: u.colorSupplyStorage.ComputeIfAbsent(color.Bytes(), func(key []byte) objectstorage.StorableObject {
+		colorSupply := NewColorSupply(color)
+		colorSupply.Persist()
+		colorSupply.SetModified()
+
+		return colorSupply
+	})}
+}
+
 // determineBookingDetails is an internal utility function that determines the information that are required to fully
 // book a newly arrived Transaction into the UTXODAG using the metadata of its referenced Inputs.
 func (u *UTXODAG) determineBookingDetails(inputsMetadata OutputsMetadata) (branchesOfInputsConflicting bool, normalizedBranchIDs BranchIDs, conflictingInputs OutputsMetadata, err error) {
@@ -627,11 +875,60 @@ func TransactionBalancesValid(inputs Outputs, outputs Outputs) (valid bool) {
 	return unspentCoins == recoloredCoins
 }
 
+// AliasOutputsValid is an internal utility function that checks if all AliasOutputs that continue an existing alias
+// consume the corresponding AliasOutput and if newly created aliases start with a state index of 0.
+func AliasOutputsValid(inputs Outputs, outputs Outputs) (valid bool) {
+	consumedAliases := make(map[[AddressLength]byte]types.Empty)
+	for _, input := range inputs {
+		if aliasInput, isAliasOutput := input.(*AliasOutput); isAliasOutput {
+			consumedAliases[aliasInput.AliasAddress().Array()] = types.Void
+		}
+	}
+
+	for _, output := range outputs {
+		aliasOutput, isAliasOutput := output.(*AliasOutput)
+		if !isAliasOutput {
+			continue
+		}
+
+		if aliasOutput.IsOrigin() {
+			if aliasOutput.StateIndex() != 0 {
+				return false
+			}
+			continue
+		}
+
+		if _, consumed := consumedAliases[aliasOutput.AliasAddress().Array()]; !consumed {
+			return false
+		}
+	}
+
+	return true
+}
+
 // UnlockBlocksValid is an internal utility function that checks if the UnlockBlocks are matching the referenced Inputs.
+// ReferenceUnlockBlocks are resolved to the (previous) UnlockBlock that they reference.
 func UnlockBlocksValid(inputs Outputs, transaction *Transaction) (valid bool) {
 	unlockBlocks := transaction.UnlockBlocks()
 	for i, input := range inputs {
-		unlockValid, unlockErr := input.UnlockValid(transaction, unlockBlocks[i])
+		unlockBlock := unlockBlocks[i]
+		if referenceUnlockBlock, isReferenceUnlockBlock := unlockBlock.(*ReferenceUnlockBlock); isReferenceUnlockBlock {
+			if int(referenceUnlockBlock.ReferencedIndex()) >= i {
+				return false
+			}
+			if unlockBlock = unlockBlocks[referenceUnlockBlock.ReferencedIndex()]; unlockBlock.Type() == ReferenceUnlockBlockType {
+				return false
+			}
+		}
+
+		if aliasUnlockBlock, isAliasUnlockBlock := unlockBlock.(*AliasUnlockBlock); isAliasUnlockBlock {
+			if !aliasUnlockValid(inputs, transaction, i, aliasUnlockBlock) {
+				return false
+			}
+			continue
+		}
+
+		unlockValid, unlockErr := input.UnlockValid(transaction, unlockBlock)
 		if !unlockValid || unlockErr != nil {
 			return false
 		}
@@ -640,6 +937,43 @@ func UnlockBlocksValid(inputs Outputs, transaction *Transaction) (valid bool) {
 	return true
 }
 
+// aliasUnlockValid is an internal utility function that checks if the Input at the given index is owned by the alias
+// that is referenced by the AliasUnlockBlock and if the alias is unlocked by a signature of its state controller in the
+// same Transaction. The alias has to be unlocked by an earlier Input and AliasOutputs can not be unlocked by other
+// aliases, so that the unlocks can neither reference themselves nor form cycles.
+func aliasUnlockValid(inputs Outputs, transaction *Transaction, inputIndex int, aliasUnlockBlock *AliasUnlockBlock) (valid bool) {
+	aliasInputIndex := int(aliasUnlockBlock.AliasInputIndex())
+	if aliasInputIndex >= inputIndex {
+		return false
+	}
+
+	input := inputs[inputIndex]
+	if _, isAliasOutput := input.(*AliasOutput); isAliasOutput {
+		return false
+	}
+
+	aliasInput, isAliasOutput := inputs[aliasInputIndex].(*AliasOutput)
+	if !isAliasOutput || !aliasInput.AliasAddress().Equals(input.Address()) {
+		return false
+	}
+
+	aliasUnlock := transaction.UnlockBlocks()[aliasInputIndex]
+	if referenceUnlockBlock, isReferenceUnlockBlock := aliasUnlock.(*ReferenceUnlockBlock); isReferenceUnlockBlock {
+		aliasUnlock = transaction.UnlockBlocks()[referenceUnlockBlock.ReferencedIndex()]
+	}
+	signatureUnlockBlock, isSignatureUnlockBlock := aliasUnlock.(AddressSignatureUnlockBlock)
+	if !isSignatureUnlockBlock {
+		return false
+	}
+
+	chainedOutput, err := aliasInput.chainedOutput(transaction)
+	if err != nil || chainedOutput == nil || !aliasInput.isStateTransition(chainedOutput) {
+		return false
+	}
+
+	return signatureUnlockBlock.AddressSignatureValid(aliasInput.StateAddress(), transaction.Essence().Bytes())
+}
+
 // transactionInputsMetadata is an internal utility function that returns the Metadata of the Outputs that are used as
 // Inputs by the given Transaction.
 func (u *UTXODAG) transactionInputsMetadata(transaction *Transaction) (cachedInputsMetadata CachedOutputsMetadata) {
@@ -859,6 +1193,71 @@ func (u *UTXODAG) StoreAddressOutputMapping(address Address, outputID OutputID)
 	}
 }
 
+// StoreAddressOutputMappings stores the address-output mappings and the AddressHistoryEntries of all Addresses that can
+// unlock the given Output.
+func (u *UTXODAG) StoreAddressOutputMappings(output Output) {
+	creationTime := u.outputCreationTime(output.ID())
+	for _, address := range OutputAddresses(output) {
+		u.StoreAddressOutputMapping(address, output.ID())
+		u.storeAddressHistoryEntry(NewAddressHistoryEntry(address, creationTime, output.ID()))
+	}
+}
+
+// storeAddressHistoryEntry is an internal utility function that stores the given AddressHistoryEntry together with the
+// AddressHistoryBucket that it belongs to.
+func (u *UTXODAG) storeAddressHistoryEntry(entry *AddressHistoryEntry) {
+	if cachedEntry, stored := u.addressHistoryStorage.StoreIfAbsent(entry); stored {
+		cachedEntry.Release()
+	}
+	if cachedBucket, stored := u.addressHistoryBucketStorage.StoreIfAbsent(NewAddressHistoryBucket(entry.Address(), entry.Bucket())); stored {
+		cachedBucket.Release()
+	}
+}
+
+// addressHistoryBuckets is an internal utility function that returns the indices of the AddressHistoryBuckets of the
+// given Address in ascending order.
+func (u *UTXODAG) addressHistoryBuckets(address Address) (buckets []uint64) {
+	u.addressHistoryBucketStorage.ForEachKeyOnly(func(key []byte) bool {
+		if bucket, _, err := AddressHistoryBucketFromBytes(key); err == nil {
+			buckets = append(buckets, bucket.Index())
+		}
+
+		return true
+	}, false, address.Bytes())
+
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i] < buckets[j]
+	})
+
+	return
+}
+
+// addressHistoryEntries is an internal utility function that returns the AddressHistoryEntries of the given
+// AddressHistoryBucket ordered by their creation time.
+func (u *UTXODAG) addressHistoryEntries(address Address, bucket uint64) (entries []*AddressHistoryEntry) {
+	u.addressHistoryStorage.ForEachKeyOnly(func(key []byte) bool {
+		if entry, _, err := AddressHistoryEntryFromBytes(key); err == nil {
+			entries = append(entries, entry)
+		}
+
+		return true
+	}, false, NewAddressHistoryBucket(address, bucket).Bytes())
+	sortAddressHistoryEntries(entries)
+
+	return
+}
+
+// outputCreationTime is an internal utility function that returns the timestamp of the Transaction that created the
+// given Output or the snapshotOutputCreationTime if the Transaction is unknown.
+func (u *UTXODAG) outputCreationTime(outputID OutputID) (creationTime time.Time) {
+	creationTime = snapshotOutputCreationTime
+	u.Transaction(outputID.TransactionID()).Consume(func(transaction *Transaction) {
+		creationTime = transaction.Essence().Timestamp()
+	})
+
+	return
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // TODO: IMPLEMENT A GOOD SYNCHRONIZATION MECHANISM FOR THE UTXODAG
@@ -884,12 +1283,29 @@ func (u *UTXODAG) lockTransaction(transaction *Transaction) {
 type UTXODAGEvents struct {
 	// TransactionBranchIDUpdated gets triggered when the BranchID of a Transaction is changed after the initial booking.
 	TransactionBranchIDUpdated *events.Event
+
+	// TransactionBooked gets triggered whenever a new Transaction was booked into a Branch that is neither invalid nor
+	// rejected.
+	TransactionBooked *events.Event
+}
+
+// TransactionBookedEvent is a container that acts as a dictionary for the TransactionBooked event related parameters.
+type TransactionBookedEvent struct {
+	// Transaction contains the booked Transaction.
+	Transaction *Transaction
+
+	// Inputs contains the Outputs that were consumed by the booked Transaction.
+	Inputs Outputs
 }
 
 func transactionIDEventHandler(handler interface{}, params ...interface{}) {
 	handler.(func(TransactionID))(params[0].(TransactionID))
 }
 
+func transactionBookedEventHandler(handler interface{}, params ...interface{}) {
+	handler.(func(*TransactionBookedEvent))(params[0].(*TransactionBookedEvent))
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region AddressOutputMapping /////////////////////////////////////////////////////////////////////////////////////////
@@ -1,17 +1,21 @@
 package ledgerstate
 
 import (
+	"bytes"
 	"math"
 	"testing"
 	"time"
 
 	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/iotaledger/hive.go/objectstorage"
 	"github.com/iotaledger/hive.go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
 )
 
 var (
@@ -260,10 +264,43 @@ func TestBookTransaction(t *testing.T) {
 	wallets := createWallets(1)
 	input := generateOutput(utxoDAG, wallets[0].address, 0)
 
+	var bookedEvent *TransactionBookedEvent
+	utxoDAG.Events.TransactionBooked.Attach(events.NewClosure(func(ev *TransactionBookedEvent) {
+		bookedEvent = ev
+	}))
+
 	tx := buildTransaction(utxoDAG, wallets[0], wallets[0], []*SigLockedSingleOutput{input})
 	targetBranch, err := utxoDAG.BookTransaction(tx)
 	require.NoError(t, err)
 	assert.Equal(t, MasterBranchID, targetBranch)
+
+	require.NotNil(t, bookedEvent)
+	assert.Equal(t, tx.ID(), bookedEvent.Transaction.ID())
+	assert.Len(t, bookedEvent.Inputs, 1)
+	assert.Equal(t, input.ID(), bookedEvent.Inputs[0].ID())
+}
+
+func TestFutureCone(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+
+	wallets := createWallets(2)
+	input := generateOutput(utxoDAG, wallets[0].address, 0)
+
+	tx1 := buildTransaction(utxoDAG, wallets[0], wallets[1], []*SigLockedSingleOutput{input})
+	_, err := utxoDAG.BookTransaction(tx1)
+	require.NoError(t, err)
+
+	var tx1Output *SigLockedSingleOutput
+	require.True(t, utxoDAG.Output(NewOutputID(tx1.ID(), 0)).Consume(func(output Output) {
+		tx1Output = output.(*SigLockedSingleOutput)
+	}))
+	tx2 := buildTransaction(utxoDAG, wallets[1], wallets[0], []*SigLockedSingleOutput{tx1Output})
+	_, err = utxoDAG.BookTransaction(tx2)
+	require.NoError(t, err)
+
+	assert.Equal(t, TransactionIDs{tx1.ID(): types.Void, tx2.ID(): types.Void}, utxoDAG.FutureCone(tx1.ID()))
+	assert.Equal(t, TransactionIDs{tx2.ID(): types.Void}, utxoDAG.FutureCone(tx2.ID()))
 }
 
 func TestBookInvalidTransaction(t *testing.T) {
@@ -760,6 +797,122 @@ func TestUnlockBlocksValid(t *testing.T) {
 
 }
 
+func TestUnlockBlocksValid_Threshold(t *testing.T) {
+	wallets := createWallets(3)
+	thresholdAddress, err := NewThresholdAddress(2, wallets[0].publicKey(), wallets[1].publicKey(), wallets[2].publicKey())
+	require.NoError(t, err)
+
+	inputs := make(Outputs, 2)
+	for i := range inputs {
+		inputs[i] = NewSigLockedSingleOutput(100, thresholdAddress).SetID(NewOutputID(TransactionID{byte(i + 1)}, 0))
+	}
+	txEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(inputs[0].Input(), inputs[1].Input()), NewOutputs(NewSigLockedSingleOutput(200, wallets[0].address)))
+
+	// collect the signatures of the different owners in separate UnlockBlocks
+	partialUnlockBlocks := make([]*ThresholdUnlockBlock, len(wallets))
+	for i, w := range wallets {
+		partialUnlockBlocks[i], err = NewThresholdUnlockBlock(2, wallets[0].publicKey(), wallets[1].publicKey(), wallets[2].publicKey())
+		require.NoError(t, err)
+		require.NoError(t, partialUnlockBlocks[i].AddSignature(w.publicKey(), w.privateKey().Sign(txEssence.Bytes())))
+	}
+
+	unlockBlocksValid := func(unlockBlock UnlockBlock) bool {
+		restoredUnlockBlock, _, err := UnlockBlockFromBytes(unlockBlock.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, unlockBlock.Bytes(), restoredUnlockBlock.Bytes())
+
+		return UnlockBlocksValid(inputs, NewTransaction(txEssence, UnlockBlocks{restoredUnlockBlock, NewReferenceUnlockBlock(0)}))
+	}
+
+	// a single signature does not reach the threshold
+	assert.False(t, unlockBlocksValid(partialUnlockBlocks[0]))
+
+	// two signatures reach the threshold
+	require.NoError(t, partialUnlockBlocks[0].Merge(partialUnlockBlocks[2]))
+	assert.Equal(t, 2, partialUnlockBlocks[0].SignatureCount())
+	assert.True(t, unlockBlocksValid(partialUnlockBlocks[0]))
+
+	// signatures of foreign keys can not be added
+	assert.Error(t, partialUnlockBlocks[1].AddSignature(createWallets(1)[0].publicKey(), ed25519.Signature{}))
+}
+
+func TestThresholdUnlockBlock_Malleability(t *testing.T) {
+	wallets := createWallets(3)
+	unlockBlock, err := NewThresholdUnlockBlock(2, wallets[0].publicKey(), wallets[1].publicKey(), wallets[2].publicKey())
+	require.NoError(t, err)
+	publicKeys := unlockBlock.PublicKeys()
+	thresholdAddress := unlockBlock.Address()
+
+	signedData := []byte("essence")
+	signatures := make(map[ed25519.PublicKey]ed25519.Signature)
+	for _, w := range wallets {
+		signatures[w.publicKey()] = w.privateKey().Sign(signedData)
+	}
+
+	// marshalUnlockBlock encodes an UnlockBlock with the given key order and the given (key index, signature) pairs
+	type indexedSignature struct {
+		keyIndex  uint8
+		signature ed25519.Signature
+	}
+	marshalUnlockBlock := func(keys []ed25519.PublicKey, indexedSignatures ...indexedSignature) []byte {
+		marshalUtil := marshalutil.New().WriteByte(byte(ThresholdUnlockBlockType)).WriteUint8(2).WriteUint8(uint8(len(keys)))
+		for _, publicKey := range keys {
+			marshalUtil.WriteBytes(publicKey.Bytes())
+		}
+		marshalUtil.WriteUint8(uint8(len(indexedSignatures)))
+		for _, indexedSig := range indexedSignatures {
+			marshalUtil.WriteUint8(indexedSig.keyIndex).WriteBytes(indexedSig.signature.Bytes())
+		}
+		return marshalUtil.Bytes()
+	}
+	sig := func(keyIndex uint8) indexedSignature {
+		return indexedSignature{keyIndex, signatures[publicKeys[keyIndex]]}
+	}
+
+	// the canonical encoding is accepted and valid
+	restoredUnlockBlock, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock(publicKeys, sig(0), sig(2)))
+	require.NoError(t, err)
+	assert.True(t, restoredUnlockBlock.AddressSignatureValid(thresholdAddress, signedData))
+
+	t.Run("CASE: Unsorted key indexes", func(t *testing.T) {
+		_, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock(publicKeys, sig(2), sig(0)))
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Duplicate key indexes", func(t *testing.T) {
+		_, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock(publicKeys, sig(1), sig(1)))
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Unsorted public keys", func(t *testing.T) {
+		_, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock([]ed25519.PublicKey{publicKeys[1], publicKeys[0], publicKeys[2]}, sig(0), sig(2)))
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: More signatures than the threshold", func(t *testing.T) {
+		_, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock(publicKeys, sig(0), sig(1), sig(2)))
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Invalid signature", func(t *testing.T) {
+		invalidUnlockBlock, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock(publicKeys, sig(0), indexedSignature{1, ed25519.Signature{}}))
+		require.NoError(t, err)
+		assert.False(t, invalidUnlockBlock.AddressSignatureValid(thresholdAddress, signedData))
+	})
+
+	t.Run("CASE: Signatures can not be added beyond the threshold", func(t *testing.T) {
+		fullUnlockBlock, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock(publicKeys, sig(0), sig(2)))
+		require.NoError(t, err)
+		assert.Error(t, fullUnlockBlock.AddSignature(publicKeys[1], signatures[publicKeys[1]]))
+
+		partialUnlockBlock, _, err := ThresholdUnlockBlockFromBytes(marshalUnlockBlock(publicKeys, sig(1)))
+		require.NoError(t, err)
+		require.NoError(t, partialUnlockBlock.Merge(fullUnlockBlock))
+		assert.Equal(t, 2, partialUnlockBlock.SignatureCount())
+		assert.True(t, partialUnlockBlock.AddressSignatureValid(thresholdAddress, signedData))
+	})
+}
+
 func TestAddressOutputMapping(t *testing.T) {
 	branchDAG, utxoDAG := setupDependencies(t)
 	defer branchDAG.Shutdown()
@@ -776,6 +929,238 @@ func TestAddressOutputMapping(t *testing.T) {
 	assert.Equal(t, 1, len(res))
 }
 
+func TestStoreAddressOutputMappings(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	wallets := createWallets(3)
+	balances := NewColoredBalances(map[Color]uint64{ColorIOTA: 100})
+	escrowOutput := NewExtendedLockedOutput(balances, wallets[0].address).WithFallbackOptions(wallets[1].address, time.Now().Add(time.Hour))
+	escrowOutput.SetID(NewOutputID(TransactionID{1}, 0))
+	plainOutput := NewExtendedLockedOutput(balances, wallets[2].address)
+	plainOutput.SetID(NewOutputID(TransactionID{1}, 1))
+
+	utxoDAG.StoreAddressOutputMappings(escrowOutput)
+	utxoDAG.StoreAddressOutputMappings(plainOutput)
+
+	// the fallback owner can find the Output as well
+	for _, address := range []Address{wallets[0].address, wallets[1].address} {
+		cachedMappings := utxoDAG.AddressOutputMapping(address)
+		require.Len(t, cachedMappings, 1)
+		cachedMappings.Consume(func(addressOutputMapping *AddressOutputMapping) {
+			assert.Equal(t, escrowOutput.ID(), addressOutputMapping.OutputID())
+		})
+	}
+
+	cachedMappings := utxoDAG.AddressOutputMapping(wallets[2].address)
+	assert.Len(t, cachedMappings, 1)
+	cachedMappings.Release()
+}
+
+func TestAddressHistory(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	wallets := createWallets(2)
+	now := time.Now()
+
+	// Outputs without a known Transaction are part of a snapshot and come first
+	snapshotOutput := NewSigLockedSingleOutput(100, wallets[0].address)
+	snapshotOutput.SetID(NewOutputID(GenesisTransactionID, 0))
+
+	// the Transactions are spread over several buckets and are stored out of order
+	transactions := make([]*Transaction, 0)
+	for i, timestamp := range []time.Time{now.Add(-3 * AddressHistoryBucketDuration), now.Add(-time.Second), now} {
+		outputs := NewOutputs(NewSigLockedSingleOutput(100, wallets[0].address), NewSigLockedSingleOutput(50, wallets[0].address))
+		if i == 1 {
+			outputs = NewOutputs(NewSigLockedSingleOutput(100, wallets[1].address), NewSigLockedSingleOutput(50, wallets[0].address))
+		}
+		essence := NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, NewInputs(NewUTXOInput(NewOutputID(GenesisTransactionID, uint16(i+1)))), outputs)
+		transactions = append(transactions, NewTransaction(essence, wallets[0].unlockBlocks(essence)))
+	}
+	for _, i := range []int{2, 0, 1} {
+		utxoDAG.transactionStorage.Store(transactions[i]).Release()
+		for _, output := range transactions[i].Essence().Outputs() {
+			utxoDAG.StoreAddressOutputMappings(output)
+		}
+	}
+	utxoDAG.StoreAddressOutputMappings(snapshotOutput)
+
+	expectedHistory := []OutputID{snapshotOutput.ID()}
+	for _, transaction := range transactions {
+		for _, output := range transaction.Essence().Outputs() {
+			if bytes.Equal(output.Address().Bytes(), wallets[0].address.Bytes()) {
+				expectedHistory = append(expectedHistory, output.ID())
+			}
+		}
+	}
+	require.Len(t, expectedHistory, 6)
+
+	history := func(cursor *OutputID, limit int) (result []OutputID) {
+		utxoDAG.AddressHistory(wallets[0].address, cursor, func(outputID OutputID) bool {
+			result = append(result, outputID)
+			return len(result) < limit
+		})
+		return
+	}
+
+	assert.Equal(t, expectedHistory, history(nil, 10))
+	assert.Equal(t, expectedHistory[:2], history(nil, 2))
+	assert.Equal(t, expectedHistory[2:4], history(&expectedHistory[1], 2))
+	assert.Equal(t, expectedHistory[1:], history(&expectedHistory[0], 10))
+	assert.Empty(t, history(&expectedHistory[5], 10))
+}
+
+func TestConfirmedUnspentOutputs(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	wallets := createWallets(2)
+	outputs := NewOutputs(
+		NewSigLockedSingleOutput(100, wallets[0].address).SetID(NewOutputID(GenesisTransactionID, 0)),
+		NewSigLockedSingleOutput(200, wallets[1].address).SetID(NewOutputID(GenesisTransactionID, 1)),
+	)
+	utxoDAG.LoadOutputs(outputs)
+	assert.ElementsMatch(t, []OutputID{outputs[0].ID(), outputs[1].ID()}, outputIDsOf(utxoDAG.ConfirmedUnspentOutputs()))
+
+	// spend the first output with a pending transaction
+	tx, _ := singleInputTransaction(utxoDAG, wallets[0], wallets[1], outputs[0].(*SigLockedSingleOutput), false)
+	outputsMetadata := OutputsMetadata{}
+	utxoDAG.transactionInputsMetadata(tx).Consume(func(metadata *OutputMetadata) {
+		outputsMetadata = append(outputsMetadata, metadata)
+	})
+	utxoDAG.bookConsumers(outputsMetadata, tx.ID(), types.True)
+	assert.Len(t, utxoDAG.ConfirmedUnspentOutputs(), 2)
+
+	// the output is spent as soon as the spending transaction is confirmed
+	utxoDAG.TransactionMetadata(tx.ID()).Consume(func(transactionMetadata *TransactionMetadata) {
+		transactionMetadata.SetFinalized(true)
+	})
+	assert.Equal(t, []OutputID{outputs[1].ID()}, outputIDsOf(utxoDAG.ConfirmedUnspentOutputs()))
+}
+
+func TestColorSupply(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	wallets := createWallets(1)
+	input := generateOutput(utxoDAG, wallets[0].address, 1)
+
+	// mint 60 tokens of a new color
+	mintingEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(
+		NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 40, ColorMint: 60}), wallets[0].address),
+	))
+	mintingTransaction := NewTransaction(mintingEssence, wallets[0].unlockBlocks(mintingEssence))
+	_, err := utxoDAG.BookTransaction(mintingTransaction)
+	require.NoError(t, err)
+
+	mintedOutputID := NewOutputID(mintingTransaction.ID(), 0)
+	mintedColor := Color(blake2b.Sum256(mintedOutputID.Bytes()))
+	assert.True(t, utxoDAG.ColorSupply(mintedColor).Consume(func(colorSupply *ColorSupply) {
+		assert.Equal(t, uint64(60), colorSupply.Minted())
+		assert.Equal(t, uint64(0), colorSupply.Destroyed())
+		assert.Equal(t, uint64(60), colorSupply.Supply())
+	}))
+	assert.False(t, utxoDAG.ColorSupply(ColorIOTA).Consume(func(*ColorSupply) {}))
+
+	// destroy 40 of the minted tokens by uncoloring them back to IOTA
+	destroyingEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(NewUTXOInput(mintedOutputID)), NewOutputs(
+		NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 80, mintedColor: 20}), wallets[0].address),
+	))
+	_, err = utxoDAG.BookTransaction(NewTransaction(destroyingEssence, wallets[0].unlockBlocks(destroyingEssence)))
+	require.NoError(t, err)
+
+	assert.True(t, utxoDAG.ColorSupply(mintedColor).Consume(func(colorSupply *ColorSupply) {
+		assert.Equal(t, uint64(60), colorSupply.Minted())
+		assert.Equal(t, uint64(40), colorSupply.Destroyed())
+		assert.Equal(t, uint64(20), colorSupply.Supply())
+	}))
+
+	// colored tokens of snapshots are counted as minted
+	utxoDAG.LoadOutputs(NewOutputs(NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{color1: 100}), wallets[0].address).SetID(NewOutputID(TransactionID{1}, 0))))
+	assert.True(t, utxoDAG.ColorSupply(color1).Consume(func(colorSupply *ColorSupply) {
+		assert.Equal(t, uint64(100), colorSupply.Supply())
+	}))
+}
+
+func TestColorSupply_Rejected(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	wallets := createWallets(1)
+	input := generateOutput(utxoDAG, wallets[0].address, 1)
+
+	// both sides of a double spend mint a new color
+	mintingTransactions := make([]*Transaction, 2)
+	mintedColors := make([]Color, 2)
+	for i, mintedAmount := range []uint64{60, 30} {
+		essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(
+			NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 100 - mintedAmount, ColorMint: mintedAmount}), wallets[0].address),
+		))
+		mintingTransactions[i] = NewTransaction(essence, wallets[0].unlockBlocks(essence))
+		_, err := utxoDAG.BookTransaction(mintingTransactions[i])
+		require.NoError(t, err)
+		mintedColors[i] = blake2b.Sum256(NewOutputID(mintingTransactions[i].ID(), 0).Bytes())
+	}
+
+	// the second minting Transaction is spent by a Transaction that destroys some of the tokens again
+	destroyingEssence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(NewUTXOInput(NewOutputID(mintingTransactions[1].ID(), 0))), NewOutputs(
+		NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 80, mintedColors[1]: 20}), wallets[0].address),
+	))
+	_, err := utxoDAG.BookTransaction(NewTransaction(destroyingEssence, wallets[0].unlockBlocks(destroyingEssence)))
+	require.NoError(t, err)
+
+	assertColorSupply := func(color Color, minted, destroyed uint64) {
+		assert.True(t, utxoDAG.ColorSupply(color).Consume(func(colorSupply *ColorSupply) {
+			assert.Equal(t, minted, colorSupply.Minted())
+			assert.Equal(t, destroyed, colorSupply.Destroyed())
+			assert.Equal(t, minted-destroyed, colorSupply.Supply())
+		}))
+	}
+	assertColorSupply(mintedColors[0], 60, 0)
+	assertColorSupply(mintedColors[1], 30, 10)
+
+	// the rejection of the second minting Transaction removes its future cone from the index
+	rejectedBranchID := NewBranchID(mintingTransactions[1].ID())
+	_, err = branchDAG.SetBranchLiked(rejectedBranchID, false)
+	require.NoError(t, err)
+	_, err = branchDAG.SetBranchFinalized(rejectedBranchID, true)
+	require.NoError(t, err)
+	assertColorSupply(mintedColors[0], 60, 0)
+	assertColorSupply(mintedColors[1], 0, 0)
+
+	// the Transactions are reverted only once
+	cachedRejectedBranch := branchDAG.Branch(rejectedBranchID)
+	branchDAG.Events.BranchRejected.Trigger(NewBranchDAGEvent(cachedRejectedBranch))
+	cachedRejectedBranch.Release()
+	assertColorSupply(mintedColors[0], 60, 0)
+	assertColorSupply(mintedColors[1], 0, 0)
+}
+
+func TestColorSupply_Supply(t *testing.T) {
+	colorSupply := NewColorSupply(color1)
+	colorSupply.Mint(10)
+	colorSupply.Destroy(30)
+	assert.Equal(t, uint64(0), colorSupply.Supply())
+
+	colorSupply.RevertDestroy(40)
+	assert.Equal(t, uint64(0), colorSupply.Destroyed())
+	assert.Equal(t, uint64(10), colorSupply.Supply())
+}
+
+func outputIDsOf(outputs Outputs) (outputIDs []OutputID) {
+	for _, output := range outputs {
+		outputIDs = append(outputIDs, output.ID())
+	}
+
+	return
+}
+
 func setupDependencies(t *testing.T) (*BranchDAG, *UTXODAG) {
 	store := mapdb.NewMapDB()
 	branchDAG := NewBranchDAG(store)
@@ -0,0 +1,257 @@
+package mana
+
+import (
+	"math"
+	"time"
+
+	"github.com/iotaledger/hive.go/stringify"
+)
+
+// region BaseMana /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// BaseMana is an interface for the different types of mana that are tracked per node.
+type BaseMana interface {
+	// BaseValue returns the base mana value.
+	BaseValue() float64
+
+	// EffectiveValue returns the effective base mana value (the moving average of the base mana).
+	EffectiveValue() float64
+
+	// LastUpdate returns the time of the last update of the BaseMana.
+	LastUpdate() time.Time
+
+	// Clone creates a copy of the BaseMana.
+	Clone() BaseMana
+
+	// String returns a human readable version of the BaseMana.
+	String() string
+
+	// update updates the BaseMana to the given time.
+	update(t time.Time) error
+
+	// pledge adds the given amount (valued at the given time) to the BaseMana.
+	pledge(amount float64, t time.Time)
+
+	// revoke removes the given amount (valued at the given time) from the BaseMana.
+	revoke(amount float64, t time.Time) error
+}
+
+// newBaseMana returns an empty BaseMana of the given Type.
+func newBaseMana(manaType Type) BaseMana {
+	switch manaType {
+	case AccessMana:
+		return &AccessBaseMana{}
+	case ConsensusMana:
+		return &ConsensusBaseMana{}
+	default:
+		panic("unsupported mana type")
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AccessBaseMana ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// AccessBaseMana represents the access mana of a node. The base value decays over time, while the effective value is
+// the exponential moving average of the base value.
+type AccessBaseMana struct {
+	baseValue      float64
+	effectiveValue float64
+	lastUpdated    time.Time
+}
+
+// NewAccessBaseMana creates a new AccessBaseMana from the given details.
+func NewAccessBaseMana(baseValue, effectiveValue float64, lastUpdated time.Time) *AccessBaseMana {
+	return &AccessBaseMana{
+		baseValue:      baseValue,
+		effectiveValue: effectiveValue,
+		lastUpdated:    lastUpdated,
+	}
+}
+
+// BaseValue returns the base access mana value.
+func (a *AccessBaseMana) BaseValue() float64 {
+	return a.baseValue
+}
+
+// EffectiveValue returns the effective access mana value.
+func (a *AccessBaseMana) EffectiveValue() float64 {
+	return a.effectiveValue
+}
+
+// LastUpdate returns the time of the last update of the AccessBaseMana.
+func (a *AccessBaseMana) LastUpdate() time.Time {
+	return a.lastUpdated
+}
+
+// Clone creates a copy of the AccessBaseMana.
+func (a *AccessBaseMana) Clone() BaseMana {
+	return NewAccessBaseMana(a.baseValue, a.effectiveValue, a.lastUpdated)
+}
+
+// String returns a human readable version of the AccessBaseMana.
+func (a *AccessBaseMana) String() string {
+	return stringify.Struct("AccessBaseMana",
+		stringify.StructField("baseValue", a.baseValue),
+		stringify.StructField("effectiveValue", a.effectiveValue),
+		stringify.StructField("lastUpdated", a.lastUpdated),
+	)
+}
+
+// update decays the base value and updates the moving average up to the given time.
+func (a *AccessBaseMana) update(t time.Time) error {
+	if t.Before(a.lastUpdated) {
+		return ErrAlreadyUpdated
+	}
+	if a.lastUpdated.IsZero() {
+		a.lastUpdated = t
+		return nil
+	}
+
+	emaCoefficient, _, decayRate := coefficients()
+	n := t.Sub(a.lastUpdated).Seconds()
+
+	// the effective value follows dEBM/dt = ema * (BM - EBM) with BM(t) = BM(0) * e^(-decay * t)
+	if emaCoefficient != decayRate {
+		a.effectiveValue = a.effectiveValue*math.Exp(-emaCoefficient*n) +
+			a.baseValue*emaCoefficient/(emaCoefficient-decayRate)*(math.Exp(-decayRate*n)-math.Exp(-emaCoefficient*n))
+	} else {
+		a.effectiveValue = (a.effectiveValue + a.baseValue*emaCoefficient*n) * math.Exp(-emaCoefficient*n)
+	}
+	a.baseValue *= math.Exp(-decayRate * n)
+	a.lastUpdated = t
+
+	return nil
+}
+
+// pledge adds the given amount to the base value. If the AccessBaseMana was already updated past the given time, the
+// amount is decayed accordingly.
+func (a *AccessBaseMana) pledge(amount float64, t time.Time) {
+	if err := a.update(t); err == ErrAlreadyUpdated {
+		amount = decayedAmount(amount, a.lastUpdated.Sub(t))
+	}
+
+	a.baseValue += amount
+}
+
+// revoke removes the given amount from the base value. If the AccessBaseMana was already updated past the given time,
+// the amount is decayed accordingly.
+func (a *AccessBaseMana) revoke(amount float64, t time.Time) error {
+	if err := a.update(t); err == ErrAlreadyUpdated {
+		amount = decayedAmount(amount, a.lastUpdated.Sub(t))
+	}
+
+	// tolerate the rounding errors of the decay calculations
+	if amount-a.baseValue > roundingTolerance*math.Max(1, amount) {
+		return ErrBaseManaNegative
+	}
+	a.baseValue = math.Max(0, a.baseValue-amount)
+
+	return nil
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ BaseMana = &AccessBaseMana{}
+
+// roundingTolerance is the relative tolerance that is applied when revoking decaying mana.
+const roundingTolerance = 1e-9
+
+// decayedAmount returns the value of the given amount after it decayed for the given duration.
+func decayedAmount(amount float64, duration time.Duration) float64 {
+	_, _, decayRate := coefficients()
+
+	return amount * math.Exp(-decayRate*duration.Seconds())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConsensusBaseMana ////////////////////////////////////////////////////////////////////////////////////////////
+
+// ConsensusBaseMana represents the consensus mana of a node. The base value is the amount of funds pledged to the node
+// and does not decay, while the effective value is the exponential moving average of the base value.
+type ConsensusBaseMana struct {
+	baseValue      float64
+	effectiveValue float64
+	lastUpdated    time.Time
+}
+
+// NewConsensusBaseMana creates a new ConsensusBaseMana from the given details.
+func NewConsensusBaseMana(baseValue, effectiveValue float64, lastUpdated time.Time) *ConsensusBaseMana {
+	return &ConsensusBaseMana{
+		baseValue:      baseValue,
+		effectiveValue: effectiveValue,
+		lastUpdated:    lastUpdated,
+	}
+}
+
+// BaseValue returns the base consensus mana value.
+func (c *ConsensusBaseMana) BaseValue() float64 {
+	return c.baseValue
+}
+
+// EffectiveValue returns the effective consensus mana value.
+func (c *ConsensusBaseMana) EffectiveValue() float64 {
+	return c.effectiveValue
+}
+
+// LastUpdate returns the time of the last update of the ConsensusBaseMana.
+func (c *ConsensusBaseMana) LastUpdate() time.Time {
+	return c.lastUpdated
+}
+
+// Clone creates a copy of the ConsensusBaseMana.
+func (c *ConsensusBaseMana) Clone() BaseMana {
+	return NewConsensusBaseMana(c.baseValue, c.effectiveValue, c.lastUpdated)
+}
+
+// String returns a human readable version of the ConsensusBaseMana.
+func (c *ConsensusBaseMana) String() string {
+	return stringify.Struct("ConsensusBaseMana",
+		stringify.StructField("baseValue", c.baseValue),
+		stringify.StructField("effectiveValue", c.effectiveValue),
+		stringify.StructField("lastUpdated", c.lastUpdated),
+	)
+}
+
+// update updates the moving average up to the given time.
+func (c *ConsensusBaseMana) update(t time.Time) error {
+	if t.Before(c.lastUpdated) {
+		return ErrAlreadyUpdated
+	}
+	if c.lastUpdated.IsZero() {
+		c.lastUpdated = t
+		return nil
+	}
+
+	_, emaCoefficient, _ := coefficients()
+	n := t.Sub(c.lastUpdated).Seconds()
+
+	c.effectiveValue = c.baseValue + (c.effectiveValue-c.baseValue)*math.Exp(-emaCoefficient*n)
+	c.lastUpdated = t
+
+	return nil
+}
+
+// pledge adds the given amount to the base value.
+func (c *ConsensusBaseMana) pledge(amount float64, t time.Time) {
+	_ = c.update(t)
+
+	c.baseValue += amount
+}
+
+// revoke removes the given amount from the base value.
+func (c *ConsensusBaseMana) revoke(amount float64, t time.Time) error {
+	_ = c.update(t)
+
+	if amount > c.baseValue {
+		return ErrBaseManaNegative
+	}
+	c.baseValue -= amount
+
+	return nil
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ BaseMana = &ConsensusBaseMana{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,65 @@
+package mana
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+func TestAccessBaseMana_Update(t *testing.T) {
+	_, _, decayRate := coefficients()
+	baseTime := time.Now()
+	accessBaseMana := NewAccessBaseMana(1000, 0, baseTime)
+
+	updateTime := baseTime.Add(6 * time.Hour)
+	assert.NoError(t, accessBaseMana.update(updateTime))
+	assert.InDelta(t, 1000*math.Exp(-decayRate*(6*time.Hour).Seconds()), accessBaseMana.BaseValue(), 1e-6)
+	assert.Greater(t, accessBaseMana.EffectiveValue(), 0.0)
+	assert.Less(t, accessBaseMana.EffectiveValue(), 1000.0)
+	assert.Equal(t, updateTime, accessBaseMana.LastUpdate())
+
+	assert.True(t, xerrors.Is(accessBaseMana.update(baseTime), ErrAlreadyUpdated))
+}
+
+func TestAccessBaseMana_PledgeAndRevoke(t *testing.T) {
+	baseTime := time.Now()
+	accessBaseMana := NewAccessBaseMana(0, 0, baseTime)
+
+	accessBaseMana.pledge(100, baseTime.Add(time.Minute))
+	assert.Equal(t, 100.0, accessBaseMana.BaseValue())
+
+	// revoking the pledged amount at the time it was pledged results in zero mana even if the mana decayed since
+	assert.NoError(t, accessBaseMana.update(baseTime.Add(time.Hour)))
+	assert.NoError(t, accessBaseMana.revoke(100, baseTime.Add(time.Minute)))
+	assert.Equal(t, 0.0, accessBaseMana.BaseValue())
+
+	assert.True(t, xerrors.Is(accessBaseMana.revoke(1, baseTime.Add(2*time.Hour)), ErrBaseManaNegative))
+}
+
+func TestConsensusBaseMana_Update(t *testing.T) {
+	_, emaCoefficient, _ := coefficients()
+	baseTime := time.Now()
+	consensusBaseMana := NewConsensusBaseMana(1000, 0, baseTime)
+
+	updateTime := baseTime.Add(time.Hour)
+	assert.NoError(t, consensusBaseMana.update(updateTime))
+	assert.Equal(t, 1000.0, consensusBaseMana.BaseValue())
+	assert.InDelta(t, 1000*(1-math.Exp(-emaCoefficient*time.Hour.Seconds())), consensusBaseMana.EffectiveValue(), 1e-6)
+}
+
+func TestConsensusBaseMana_PledgeAndRevoke(t *testing.T) {
+	baseTime := time.Now()
+	consensusBaseMana := NewConsensusBaseMana(0, 0, baseTime)
+
+	consensusBaseMana.pledge(100, baseTime.Add(time.Minute))
+	assert.Equal(t, 100.0, consensusBaseMana.BaseValue())
+
+	assert.NoError(t, consensusBaseMana.revoke(40, baseTime.Add(time.Hour)))
+	assert.Equal(t, 60.0, consensusBaseMana.BaseValue())
+
+	assert.True(t, xerrors.Is(consensusBaseMana.revoke(61, baseTime.Add(time.Hour)), ErrBaseManaNegative))
+	assert.Equal(t, 60.0, consensusBaseMana.BaseValue())
+}
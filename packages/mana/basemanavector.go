@@ -0,0 +1,355 @@
+package mana
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/types"
+	"golang.org/x/xerrors"
+)
+
+// region BaseManaVector ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// BaseManaVector keeps track of the BaseMana of all nodes for a single mana Type.
+type BaseManaVector struct {
+	// Events is a container for all of the BaseManaVector related events.
+	Events *BaseManaVectorEvents
+
+	vectorType           Type
+	vector               map[identity.ID]BaseMana
+	revertedTransactions ledgerstate.TransactionIDs
+	mutex                sync.RWMutex
+}
+
+// NewBaseManaVector creates a new BaseManaVector for the given mana Type.
+func NewBaseManaVector(vectorType Type) (baseManaVector *BaseManaVector, err error) {
+	if vectorType != AccessMana && vectorType != ConsensusMana {
+		err = xerrors.Errorf("failed to create BaseManaVector of type %s: %w", vectorType, ErrUnknownManaType)
+		return
+	}
+
+	return &BaseManaVector{
+		Events:               newBaseManaVectorEvents(),
+		vectorType:           vectorType,
+		vector:               make(map[identity.ID]BaseMana),
+		revertedTransactions: make(ledgerstate.TransactionIDs),
+	}, nil
+}
+
+// Type returns the mana Type of the BaseManaVector.
+func (b *BaseManaVector) Type() Type {
+	return b.vectorType
+}
+
+// Has returns true if the given node has an entry in the BaseManaVector.
+func (b *BaseManaVector) Has(nodeID identity.ID) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	_, exists := b.vector[nodeID]
+	return exists
+}
+
+// Size returns the number of nodes in the BaseManaVector.
+func (b *BaseManaVector) Size() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return len(b.vector)
+}
+
+// Book books the mana that is pledged by the given Transaction. If the consensus mana of any of its inputs can not be
+// revoked, the BaseManaVector stays unchanged and the Transaction is treated as reverted.
+func (b *BaseManaVector) Book(txInfo *TxInfo) (err error) {
+	revokedEvents, pledgedEvents, err := b.book(txInfo)
+
+	for _, revokedEvent := range revokedEvents {
+		b.Events.Revoked.Trigger(revokedEvent)
+	}
+	for _, pledgedEvent := range pledgedEvents {
+		b.Events.Pledged.Trigger(pledgedEvent)
+	}
+
+	return
+}
+
+// Revert reverts the changes to the BaseManaVector that were introduced by booking the given Transaction. It returns
+// ErrAlreadyReverted if the Transaction was reverted before.
+func (b *BaseManaVector) Revert(txInfo *TxInfo) (err error) {
+	revokedEvents, pledgedEvents, err := b.revert(txInfo)
+
+	for _, revokedEvent := range revokedEvents {
+		b.Events.Revoked.Trigger(revokedEvent)
+	}
+	for _, pledgedEvent := range pledgedEvents {
+		b.Events.Pledged.Trigger(pledgedEvent)
+	}
+
+	return
+}
+
+// Update updates the BaseMana of the given node to the given time.
+func (b *BaseManaVector) Update(nodeID identity.ID, t time.Time) (err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	baseMana, exists := b.vector[nodeID]
+	if !exists {
+		return xerrors.Errorf("failed to update BaseMana of %s: %w", nodeID, ErrNodeNotFoundInBaseManaVector)
+	}
+
+	return baseMana.update(t)
+}
+
+// GetMana returns the effective mana of the given node after it was updated to the current (or optionally the given)
+// time.
+func (b *BaseManaVector) GetMana(nodeID identity.ID, optionalUpdateTime ...time.Time) (mana float64, updateTime time.Time, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	updateTime = time.Now()
+	if len(optionalUpdateTime) > 0 {
+		updateTime = optionalUpdateTime[0]
+	}
+
+	baseMana, exists := b.vector[nodeID]
+	if !exists {
+		err = xerrors.Errorf("failed to retrieve mana of %s: %w", nodeID, ErrNodeNotFoundInBaseManaVector)
+		return
+	}
+	if updateErr := baseMana.update(updateTime); updateErr != nil && !xerrors.Is(updateErr, ErrAlreadyUpdated) {
+		err = xerrors.Errorf("failed to update BaseMana of %s: %w", nodeID, updateErr)
+		return
+	}
+	mana = baseMana.EffectiveValue()
+
+	return
+}
+
+// GetManaMap returns the effective mana of all nodes after they were updated to the current (or optionally the given)
+// time.
+func (b *BaseManaVector) GetManaMap(optionalUpdateTime ...time.Time) (nodeMap NodeMap, updateTime time.Time, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	updateTime = time.Now()
+	if len(optionalUpdateTime) > 0 {
+		updateTime = optionalUpdateTime[0]
+	}
+
+	nodeMap = make(NodeMap, len(b.vector))
+	for nodeID, baseMana := range b.vector {
+		if updateErr := baseMana.update(updateTime); updateErr != nil && !xerrors.Is(updateErr, ErrAlreadyUpdated) {
+			err = xerrors.Errorf("failed to update BaseMana of %s: %w", nodeID, updateErr)
+			return
+		}
+		nodeMap[nodeID] = baseMana.EffectiveValue()
+	}
+
+	return
+}
+
+// SetMana sets the BaseMana of the given node (i.e. when loading the BaseManaVector from the storage).
+func (b *BaseManaVector) SetMana(nodeID identity.ID, baseMana BaseMana) (err error) {
+	switch baseMana.(type) {
+	case *AccessBaseMana:
+		if b.vectorType != AccessMana {
+			return xerrors.Errorf("failed to set AccessBaseMana in BaseManaVector of type %s: %w", b.vectorType, ErrInvalidTargetManaType)
+		}
+	case *ConsensusBaseMana:
+		if b.vectorType != ConsensusMana {
+			return xerrors.Errorf("failed to set ConsensusBaseMana in BaseManaVector of type %s: %w", b.vectorType, ErrInvalidTargetManaType)
+		}
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.vector[nodeID] = baseMana
+
+	return
+}
+
+// SetReverted marks the given Transaction as reverted (i.e. when loading the BaseManaVector from the storage).
+func (b *BaseManaVector) SetReverted(transactionID ledgerstate.TransactionID) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.revertedTransactions[transactionID] = types.Void
+}
+
+// ForEachRevertedTransaction iterates through the Transactions whose mana was reverted and calls the callback for every
+// one of them. The iteration stops when the callback returns false.
+func (b *BaseManaVector) ForEachRevertedTransaction(callback func(transactionID ledgerstate.TransactionID) bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for transactionID := range b.revertedTransactions {
+		if !callback(transactionID) {
+			return
+		}
+	}
+}
+
+// ForEach iterates through the BaseManaVector and calls the callback for every node. The BaseMana that is passed to the
+// callback is a copy. The iteration stops when the callback returns false.
+func (b *BaseManaVector) ForEach(callback func(nodeID identity.ID, baseMana BaseMana) bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for nodeID, baseMana := range b.vector {
+		if !callback(nodeID, baseMana.Clone()) {
+			return
+		}
+	}
+}
+
+// book is an internal utility function that books the given Transaction while holding the lock and returns the events
+// that have to be triggered.
+func (b *BaseManaVector) book(txInfo *TxInfo) (revokedEvents []*RevokedEvent, pledgedEvents []*PledgedEvent, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.vectorType {
+	case ConsensusMana:
+		// the BaseManas of the input pledge nodes are restored if any of the revokes fails, so that a Transaction either
+		// moves all of its consensus mana or none of it
+		revokedBaseManas := make(map[identity.ID]BaseMana)
+		for _, inputInfo := range txInfo.InputInfos {
+			inputPledgeID, pledgeIDKnown := inputInfo.PledgeID[ConsensusMana]
+			if !pledgeIDKnown {
+				continue
+			}
+
+			if baseMana, exists := b.vector[inputPledgeID]; exists {
+				if _, saved := revokedBaseManas[inputPledgeID]; !saved {
+					revokedBaseManas[inputPledgeID] = baseMana.Clone()
+				}
+			}
+
+			if revokeErr := b.revoke(inputPledgeID, inputInfo.Amount, txInfo.TimeStamp); revokeErr != nil {
+				for nodeID, baseMana := range revokedBaseManas {
+					b.vector[nodeID] = baseMana
+				}
+				// the Transaction is marked as reverted, as there is nothing that a later revert could undo
+				b.revertedTransactions[txInfo.TransactionID] = types.Void
+
+				return nil, nil, xerrors.Errorf("failed to revoke mana of Transaction with %s from %s: %w", txInfo.TransactionID, inputPledgeID, revokeErr)
+			}
+			revokedEvents = append(revokedEvents, &RevokedEvent{
+				NodeID:        inputPledgeID,
+				Amount:        inputInfo.Amount,
+				Time:          txInfo.TimeStamp,
+				ManaType:      b.vectorType,
+				TransactionID: txInfo.TransactionID,
+			})
+		}
+
+		pledgedEvents = append(pledgedEvents, b.pledge(txInfo.PledgeID[ConsensusMana], txInfo.TotalBalance, txInfo))
+	case AccessMana:
+		pledgedEvents = append(pledgedEvents, b.pledge(txInfo.PledgeID[AccessMana], accessManaPledged(txInfo), txInfo))
+	}
+
+	return
+}
+
+// revert is an internal utility function that reverts the given Transaction while holding the lock and returns the
+// events that have to be triggered.
+func (b *BaseManaVector) revert(txInfo *TxInfo) (revokedEvents []*RevokedEvent, pledgedEvents []*PledgedEvent, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, reverted := b.revertedTransactions[txInfo.TransactionID]; reverted {
+		err = xerrors.Errorf("failed to revert mana of Transaction with %s: %w", txInfo.TransactionID, ErrAlreadyReverted)
+		return
+	}
+
+	var amount float64
+	switch b.vectorType {
+	case ConsensusMana:
+		amount = txInfo.TotalBalance
+	case AccessMana:
+		amount = accessManaPledged(txInfo)
+	}
+
+	pledgeID := txInfo.PledgeID[b.vectorType]
+	if revokeErr := b.revoke(pledgeID, amount, txInfo.TimeStamp); revokeErr != nil {
+		err = xerrors.Errorf("failed to revert mana of Transaction with %s from %s: %w", txInfo.TransactionID, pledgeID, revokeErr)
+		return
+	}
+	b.revertedTransactions[txInfo.TransactionID] = types.Void
+	revokedEvents = append(revokedEvents, &RevokedEvent{
+		NodeID:        pledgeID,
+		Amount:        amount,
+		Time:          txInfo.TimeStamp,
+		ManaType:      b.vectorType,
+		TransactionID: txInfo.TransactionID,
+	})
+
+	if b.vectorType != ConsensusMana {
+		return
+	}
+
+	for _, inputInfo := range txInfo.InputInfos {
+		inputPledgeID, pledgeIDKnown := inputInfo.PledgeID[ConsensusMana]
+		if !pledgeIDKnown {
+			continue
+		}
+
+		pledgedEvents = append(pledgedEvents, b.pledge(inputPledgeID, inputInfo.Amount, txInfo))
+	}
+
+	return
+}
+
+// pledge is an internal utility function that pledges the given amount to the given node.
+func (b *BaseManaVector) pledge(nodeID identity.ID, amount float64, txInfo *TxInfo) *PledgedEvent {
+	baseMana, exists := b.vector[nodeID]
+	if !exists {
+		baseMana = newBaseMana(b.vectorType)
+		b.vector[nodeID] = baseMana
+	}
+	baseMana.pledge(amount, txInfo.TimeStamp)
+
+	return &PledgedEvent{
+		NodeID:        nodeID,
+		Amount:        amount,
+		Time:          txInfo.TimeStamp,
+		ManaType:      b.vectorType,
+		TransactionID: txInfo.TransactionID,
+	}
+}
+
+// revoke is an internal utility function that revokes the given amount from the given node.
+func (b *BaseManaVector) revoke(nodeID identity.ID, amount float64, t time.Time) error {
+	baseMana, exists := b.vector[nodeID]
+	if !exists {
+		return ErrNodeNotFoundInBaseManaVector
+	}
+
+	return baseMana.revoke(amount, t)
+}
+
+// accessManaPledged returns the amount of access mana that is pledged by the given Transaction. Every consumed Output
+// generates access mana for the time between its creation and its consumption, which saturates at the amount of funds
+// it holds. Outputs whose creation time is unknown (i.e. genesis Outputs) have no holding time and generate no access
+// mana, as they would otherwise pledge their full amount.
+func accessManaPledged(txInfo *TxInfo) (pledged float64) {
+	_, _, decayRate := coefficients()
+
+	for _, inputInfo := range txInfo.InputInfos {
+		if inputInfo.TimeStamp.IsZero() {
+			continue
+		}
+
+		holdingTime := math.Max(0, txInfo.TimeStamp.Sub(inputInfo.TimeStamp).Seconds())
+		pledged += inputInfo.Amount * (1 - math.Exp(-decayRate*holdingTime))
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
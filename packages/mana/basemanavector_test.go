@@ -0,0 +1,258 @@
+package mana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+)
+
+func TestBaseManaVector_ConsensusBookAndRevert(t *testing.T) {
+	baseManaVector, err := NewBaseManaVector(ConsensusMana)
+	require.NoError(t, err)
+
+	nodeA := randomNodeID()
+	nodeB := randomNodeID()
+	baseTime := time.Now()
+
+	// genesis funds are not pledged to anybody and therefore nothing is revoked
+	genesisTx := newTestTxInfo(baseTime, nodeA, nodeA, InputInfo{Amount: 100, PledgeID: map[Type]identity.ID{}})
+	assert.NoError(t, baseManaVector.Book(genesisTx))
+
+	var pledged, revoked []identity.ID
+	baseManaVector.Events.Pledged.Attach(events.NewClosure(func(ev *PledgedEvent) { pledged = append(pledged, ev.NodeID) }))
+	baseManaVector.Events.Revoked.Attach(events.NewClosure(func(ev *RevokedEvent) { revoked = append(revoked, ev.NodeID) }))
+
+	spendingTx := newTestTxInfo(baseTime.Add(time.Minute), nodeB, nodeB, InputInfo{
+		TimeStamp: baseTime,
+		Amount:    100,
+		PledgeID:  map[Type]identity.ID{AccessMana: nodeA, ConsensusMana: nodeA},
+	})
+	assert.NoError(t, baseManaVector.Book(spendingTx))
+	assert.Equal(t, []identity.ID{nodeB}, pledged)
+	assert.Equal(t, []identity.ID{nodeA}, revoked)
+
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		switch nodeID {
+		case nodeA:
+			assert.Equal(t, 0.0, baseMana.BaseValue())
+		case nodeB:
+			assert.Equal(t, 100.0, baseMana.BaseValue())
+		}
+		return true
+	})
+
+	assert.NoError(t, baseManaVector.Revert(spendingTx))
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		switch nodeID {
+		case nodeA:
+			assert.Equal(t, 100.0, baseMana.BaseValue())
+		case nodeB:
+			assert.Equal(t, 0.0, baseMana.BaseValue())
+		}
+		return true
+	})
+}
+
+func TestBaseManaVector_AccessBookAndRevert(t *testing.T) {
+	baseManaVector, err := NewBaseManaVector(AccessMana)
+	require.NoError(t, err)
+
+	nodeA := randomNodeID()
+	baseTime := time.Now()
+
+	txInfo := newTestTxInfo(baseTime.Add(6*time.Hour), nodeA, nodeA, InputInfo{
+		TimeStamp: baseTime,
+		Amount:    100,
+		PledgeID:  map[Type]identity.ID{},
+	})
+	assert.NoError(t, baseManaVector.Book(txInfo))
+
+	// holding the funds for one half-life generates half of their amount as access mana
+	manaMap, _, err := baseManaVector.GetManaMap(baseTime.Add(6 * time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, manaMap, 1)
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		assert.InDelta(t, 50.0, baseMana.BaseValue(), 1e-6)
+		return true
+	})
+
+	_, _, err = baseManaVector.GetMana(nodeA, baseTime.Add(12*time.Hour))
+	require.NoError(t, err)
+	assert.NoError(t, baseManaVector.Revert(txInfo))
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		assert.InDelta(t, 0.0, baseMana.BaseValue(), 1e-6)
+		return true
+	})
+
+	// the pledge can not be revoked twice
+	assert.True(t, xerrors.Is(baseManaVector.Revert(txInfo), ErrAlreadyReverted))
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		assert.InDelta(t, 0.0, baseMana.BaseValue(), 1e-6)
+		return true
+	})
+}
+
+func TestBaseManaVector_RevertChain(t *testing.T) {
+	baseManaVector, err := NewBaseManaVector(ConsensusMana)
+	require.NoError(t, err)
+
+	nodeA := randomNodeID()
+	nodeB := randomNodeID()
+	baseTime := time.Now()
+
+	tx1 := newTestTxInfo(baseTime, nodeA, nodeA, InputInfo{Amount: 100, PledgeID: map[Type]identity.ID{}})
+	assert.NoError(t, baseManaVector.Book(tx1))
+	tx2 := newTestTxInfo(baseTime.Add(time.Minute), nodeB, nodeB, InputInfo{
+		TimeStamp: baseTime,
+		Amount:    100,
+		PledgeID:  map[Type]identity.ID{AccessMana: nodeA, ConsensusMana: nodeA},
+	})
+	assert.NoError(t, baseManaVector.Book(tx2))
+
+	// reverting the parent first fails because its mana moved on to the spender and it is not marked as reverted
+	assert.True(t, xerrors.Is(baseManaVector.Revert(tx1), ErrBaseManaNegative))
+
+	assert.NoError(t, baseManaVector.Revert(tx2))
+	assert.NoError(t, baseManaVector.Revert(tx1))
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		assert.Equal(t, 0.0, baseMana.BaseValue())
+		return true
+	})
+
+	var revertedTransactions []ledgerstate.TransactionID
+	baseManaVector.ForEachRevertedTransaction(func(transactionID ledgerstate.TransactionID) bool {
+		revertedTransactions = append(revertedTransactions, transactionID)
+		return true
+	})
+	assert.ElementsMatch(t, []ledgerstate.TransactionID{tx1.TransactionID, tx2.TransactionID}, revertedTransactions)
+
+	// the guard is restored together with the BaseManaVector
+	restoredVector, err := NewBaseManaVector(ConsensusMana)
+	require.NoError(t, err)
+	restoredVector.SetReverted(tx2.TransactionID)
+	assert.True(t, xerrors.Is(restoredVector.Revert(tx2), ErrAlreadyReverted))
+}
+
+func TestBaseManaVector_AccessUnknownInputTime(t *testing.T) {
+	baseManaVector, err := NewBaseManaVector(AccessMana)
+	require.NoError(t, err)
+
+	// Outputs of unknown age (i.e. genesis Outputs) do not pledge their full amount as access mana
+	nodeA := randomNodeID()
+	assert.NoError(t, baseManaVector.Book(newTestTxInfo(time.Now(), nodeA, nodeA, InputInfo{Amount: 100, PledgeID: map[Type]identity.ID{}})))
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		assert.Equal(t, 0.0, baseMana.BaseValue())
+		return true
+	})
+}
+
+func TestBaseManaVector_ConsensusBookFailedRevoke(t *testing.T) {
+	baseManaVector, err := NewBaseManaVector(ConsensusMana)
+	require.NoError(t, err)
+
+	nodeA := randomNodeID()
+	nodeB := randomNodeID()
+	nodeC := randomNodeID()
+	baseTime := time.Now()
+	assert.NoError(t, baseManaVector.SetMana(nodeA, NewConsensusBaseMana(100, 0, baseTime)))
+
+	// the second input can not be revoked, so the first input keeps its mana and nothing is pledged
+	txInfo := newTestTxInfo(baseTime.Add(time.Minute), nodeC, nodeC, InputInfo{
+		TimeStamp: baseTime,
+		Amount:    100,
+		PledgeID:  map[Type]identity.ID{ConsensusMana: nodeA},
+	}, InputInfo{
+		TimeStamp: baseTime,
+		Amount:    50,
+		PledgeID:  map[Type]identity.ID{ConsensusMana: nodeB},
+	})
+	assert.True(t, xerrors.Is(baseManaVector.Book(txInfo), ErrNodeNotFoundInBaseManaVector))
+	assert.False(t, baseManaVector.Has(nodeC))
+	baseManaVector.ForEach(func(nodeID identity.ID, baseMana BaseMana) bool {
+		assert.Equal(t, 100.0, baseMana.BaseValue())
+		return true
+	})
+
+	// a Transaction that was never booked can not be reverted
+	assert.True(t, xerrors.Is(baseManaVector.Revert(txInfo), ErrAlreadyReverted))
+}
+
+func TestBaseManaVector_SetMana(t *testing.T) {
+	baseManaVector, err := NewBaseManaVector(AccessMana)
+	require.NoError(t, err)
+
+	assert.True(t, xerrors.Is(baseManaVector.SetMana(randomNodeID(), NewConsensusBaseMana(1, 1, time.Now())), ErrInvalidTargetManaType))
+
+	nodeID := randomNodeID()
+	assert.NoError(t, baseManaVector.SetMana(nodeID, NewAccessBaseMana(1, 1, time.Now())))
+	assert.True(t, baseManaVector.Has(nodeID))
+}
+
+func TestPersistableBaseMana(t *testing.T) {
+	nodeID := randomNodeID()
+	lastUpdated := time.Unix(0, time.Now().UnixNano())
+	persistableBaseMana := NewPersistableBaseMana(ConsensusMana, nodeID, NewConsensusBaseMana(10, 5, lastUpdated))
+
+	restored, err := PersistableBaseManaFromObjectStorage(persistableBaseMana.ObjectStorageKey(), persistableBaseMana.ObjectStorageValue())
+	require.NoError(t, err)
+	assert.Equal(t, nodeID, restored.(*PersistableBaseMana).NodeID())
+	assert.Equal(t, ConsensusMana, restored.(*PersistableBaseMana).ManaType())
+
+	baseMana, err := restored.(*PersistableBaseMana).BaseMana()
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, baseMana.BaseValue())
+	assert.Equal(t, 5.0, baseMana.EffectiveValue())
+	assert.True(t, lastUpdated.Equal(baseMana.LastUpdate()))
+}
+
+func TestPersistableRevertedTransaction(t *testing.T) {
+	transactionID, err := ledgerstate.TransactionIDFromRandomness()
+	require.NoError(t, err)
+	persistableRevertedTransaction := NewPersistableRevertedTransaction(AccessMana, transactionID)
+
+	restored, err := PersistableRevertedTransactionFromObjectStorage(persistableRevertedTransaction.ObjectStorageKey(), persistableRevertedTransaction.ObjectStorageValue())
+	require.NoError(t, err)
+	assert.Equal(t, AccessMana, restored.(*PersistableRevertedTransaction).ManaType())
+	assert.Equal(t, transactionID, restored.(*PersistableRevertedTransaction).TransactionID())
+}
+
+func TestPersistableTransactionPledge(t *testing.T) {
+	transactionID, err := ledgerstate.TransactionIDFromRandomness()
+	require.NoError(t, err)
+	pledgeID := map[Type]identity.ID{AccessMana: randomNodeID(), ConsensusMana: randomNodeID()}
+	timestamp := time.Unix(1000, 0)
+	persistableTransactionPledge := NewPersistableTransactionPledge(transactionID, timestamp, pledgeID)
+
+	restored, err := PersistableTransactionPledgeFromObjectStorage(persistableTransactionPledge.ObjectStorageKey(), persistableTransactionPledge.ObjectStorageValue())
+	require.NoError(t, err)
+	assert.Equal(t, transactionID, restored.(*PersistableTransactionPledge).TransactionID())
+	assert.True(t, timestamp.Equal(restored.(*PersistableTransactionPledge).Timestamp()))
+	assert.Equal(t, pledgeID, restored.(*PersistableTransactionPledge).PledgeID())
+}
+
+func newTestTxInfo(timestamp time.Time, accessPledgeID, consensusPledgeID identity.ID, inputInfos ...InputInfo) (txInfo *TxInfo) {
+	txInfo = &TxInfo{
+		TimeStamp: timestamp,
+		PledgeID: map[Type]identity.ID{
+			AccessMana:    accessPledgeID,
+			ConsensusMana: consensusPledgeID,
+		},
+		InputInfos: inputInfos,
+	}
+	txInfo.TransactionID, _ = ledgerstate.TransactionIDFromRandomness()
+	for _, inputInfo := range inputInfos {
+		txInfo.TotalBalance += inputInfo.Amount
+	}
+
+	return
+}
+
+func randomNodeID() identity.ID {
+	return identity.GenerateIdentity().ID()
+}
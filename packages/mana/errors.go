@@ -0,0 +1,23 @@
+package mana
+
+import "errors"
+
+var (
+	// ErrUnknownManaType is returned if a mana Type is used that is not supported.
+	ErrUnknownManaType = errors.New("unknown mana type")
+
+	// ErrNodeNotFoundInBaseManaVector is returned if the node is not found in the BaseManaVector.
+	ErrNodeNotFoundInBaseManaVector = errors.New("node not present in base mana vector")
+
+	// ErrAlreadyUpdated is returned if BaseMana is updated to a time that lies before its last update.
+	ErrAlreadyUpdated = errors.New("base mana was already updated")
+
+	// ErrBaseManaNegative is returned if an operation would make the BaseMana of a node negative.
+	ErrBaseManaNegative = errors.New("base mana should never be negative")
+
+	// ErrInvalidTargetManaType is returned if a BaseMana or BaseManaVector of the wrong Type is used.
+	ErrInvalidTargetManaType = errors.New("invalid target mana type")
+
+	// ErrAlreadyReverted is returned if the mana of a Transaction is reverted more than once.
+	ErrAlreadyReverted = errors.New("mana of transaction was already reverted")
+)
@@ -0,0 +1,64 @@
+package mana
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+// region BaseManaVectorEvents /////////////////////////////////////////////////////////////////////////////////////////
+
+// BaseManaVectorEvents is a container for all of the BaseManaVector related events.
+type BaseManaVectorEvents struct {
+	// Pledged gets triggered whenever mana is pledged to a node.
+	Pledged *events.Event
+
+	// Revoked gets triggered whenever mana is revoked from a node.
+	Revoked *events.Event
+}
+
+// newBaseManaVectorEvents creates a container for all of the BaseManaVector related events.
+func newBaseManaVectorEvents() *BaseManaVectorEvents {
+	return &BaseManaVectorEvents{
+		Pledged: events.NewEvent(pledgedEventCaller),
+		Revoked: events.NewEvent(revokedEventCaller),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PledgedEvent /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PledgedEvent is the container for the parameters of the Pledged event.
+type PledgedEvent struct {
+	NodeID        identity.ID
+	Amount        float64
+	Time          time.Time
+	ManaType      Type
+	TransactionID ledgerstate.TransactionID
+}
+
+func pledgedEventCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*PledgedEvent))(params[0].(*PledgedEvent))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RevokedEvent /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// RevokedEvent is the container for the parameters of the Revoked event.
+type RevokedEvent struct {
+	NodeID        identity.ID
+	Amount        float64
+	Time          time.Time
+	ManaType      Type
+	TransactionID ledgerstate.TransactionID
+}
+
+func revokedEventCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*RevokedEvent))(params[0].(*RevokedEvent))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
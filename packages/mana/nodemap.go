@@ -0,0 +1,105 @@
+package mana
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"golang.org/x/xerrors"
+)
+
+// region Node /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Node represents a node and its mana value.
+type Node struct {
+	ID   identity.ID
+	Mana float64
+}
+
+// IDFromBase58 parses the base58 encoded version of a full nodeID.
+func IDFromBase58(base58String string) (nodeID identity.ID, err error) {
+	idBytes, err := base58.Decode(base58String)
+	if err != nil {
+		err = xerrors.Errorf("error while decoding base58 encoded nodeID (%v): %w", err, cerrors.ErrBase58DecodeFailed)
+		return
+	}
+	if len(idBytes) != len(nodeID) {
+		err = xerrors.Errorf("nodeID has the wrong length (%d instead of %d): %w", len(idBytes), len(nodeID), cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(nodeID[:], idBytes)
+
+	return
+}
+
+// IDToBase58 returns the base58 encoded version of the full nodeID.
+func IDToBase58(nodeID identity.ID) string {
+	return base58.Encode(nodeID.Bytes())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NodeMap //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NodeMap is a map of nodeIDs and their mana values.
+type NodeMap map[identity.ID]float64
+
+// SortedNodes returns the nodes of the NodeMap sorted by their mana in descending order. Nodes with the same amount of
+// mana are ordered by their nodeID.
+func (n NodeMap) SortedNodes() (nodes []Node) {
+	nodes = make([]Node, 0, len(n))
+	for nodeID, mana := range n {
+		nodes = append(nodes, Node{ID: nodeID, Mana: mana})
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Mana != nodes[j].Mana {
+			return nodes[i].Mana > nodes[j].Mana
+		}
+
+		return bytes.Compare(nodes[i].ID.Bytes(), nodes[j].ID.Bytes()) < 0
+	})
+
+	return
+}
+
+// HighestNodes returns the given number of nodes with the highest mana in descending order.
+func (n NodeMap) HighestNodes(number uint) (nodes []Node) {
+	nodes = n.SortedNodes()
+	if uint(len(nodes)) > number {
+		nodes = nodes[:number]
+	}
+
+	return
+}
+
+// Percentile returns the percentage of nodes in the NodeMap that have less mana than the given node.
+func (n NodeMap) Percentile(nodeID identity.ID) (percentile float64, err error) {
+	nodeMana, exists := n[nodeID]
+	if !exists {
+		err = xerrors.Errorf("failed to calculate percentile of %s: %w", nodeID, ErrNodeNotFoundInBaseManaVector)
+		return
+	}
+
+	nodesWithLessMana := 0
+	for _, mana := range n {
+		if mana < nodeMana {
+			nodesWithLessMana++
+		}
+	}
+	percentile = float64(nodesWithLessMana) / float64(len(n)) * 100
+
+	return
+}
+
+// TotalMana returns the sum of the mana of all nodes in the NodeMap.
+func (n NodeMap) TotalMana() (totalMana float64) {
+	for _, mana := range n {
+		totalMana += mana
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
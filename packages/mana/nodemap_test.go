@@ -0,0 +1,48 @@
+package mana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeMap_SortedNodes(t *testing.T) {
+	nodeA, nodeB, nodeC := randomNodeID(), randomNodeID(), randomNodeID()
+	nodeMap := NodeMap{nodeA: 10, nodeB: 30, nodeC: 20}
+
+	assert.Equal(t, []Node{{nodeB, 30}, {nodeC, 20}, {nodeA, 10}}, nodeMap.SortedNodes())
+	assert.Equal(t, []Node{{nodeB, 30}, {nodeC, 20}}, nodeMap.HighestNodes(2))
+	assert.Len(t, nodeMap.HighestNodes(5), 3)
+	assert.Equal(t, 60.0, nodeMap.TotalMana())
+}
+
+func TestNodeMap_Percentile(t *testing.T) {
+	nodeA, nodeB, nodeC, nodeD := randomNodeID(), randomNodeID(), randomNodeID(), randomNodeID()
+	nodeMap := NodeMap{nodeA: 10, nodeB: 30, nodeC: 20, nodeD: 20}
+
+	percentile, err := nodeMap.Percentile(nodeB)
+	require.NoError(t, err)
+	assert.Equal(t, 75.0, percentile)
+
+	percentile, err = nodeMap.Percentile(nodeC)
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, percentile)
+
+	_, err = nodeMap.Percentile(randomNodeID())
+	assert.Error(t, err)
+}
+
+func TestIDFromBase58(t *testing.T) {
+	nodeID := randomNodeID()
+
+	parsedNodeID, err := IDFromBase58(IDToBase58(nodeID))
+	require.NoError(t, err)
+	assert.Equal(t, nodeID, parsedNodeID)
+
+	_, err = IDFromBase58(nodeID.String())
+	assert.Error(t, err)
+
+	_, err = IDFromBase58("0OIl")
+	assert.Error(t, err)
+}
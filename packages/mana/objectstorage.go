@@ -0,0 +1,38 @@
+package mana
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/objectstorage"
+)
+
+const (
+	// PrefixBaseManaStorage defines the storage prefix for the PersistableBaseMana object storage.
+	PrefixBaseManaStorage byte = iota
+
+	// PrefixRevertedTransactionStorage defines the storage prefix for the PersistableRevertedTransaction object storage.
+	PrefixRevertedTransactionStorage
+
+	// PrefixTransactionPledgeStorage defines the storage prefix for the PersistableTransactionPledge object storage.
+	PrefixTransactionPledgeStorage
+)
+
+// BaseManaStorageOptions contains a list of default settings for the PersistableBaseMana object storage.
+var BaseManaStorageOptions = []objectstorage.Option{
+	objectstorage.CacheTime(60 * time.Second),
+	objectstorage.LeakDetectionEnabled(false),
+}
+
+// RevertedTransactionStorageOptions contains a list of default settings for the PersistableRevertedTransaction object
+// storage.
+var RevertedTransactionStorageOptions = []objectstorage.Option{
+	objectstorage.CacheTime(0),
+	objectstorage.LeakDetectionEnabled(false),
+}
+
+// TransactionPledgeStorageOptions contains a list of default settings for the PersistableTransactionPledge object
+// storage.
+var TransactionPledgeStorageOptions = []objectstorage.Option{
+	objectstorage.CacheTime(60 * time.Second),
+	objectstorage.LeakDetectionEnabled(false),
+}
@@ -0,0 +1,46 @@
+package mana
+
+import (
+	"math"
+	"sync"
+)
+
+var (
+	// decay is the decay rate of the access base mana per second. The default value corresponds to a half-life of 6
+	// hours.
+	decay = math.Ln2 / (6 * 60 * 60)
+
+	// emaCoefficientAccess is the coefficient (per second) of the exponential moving average that is used to derive
+	// the effective access mana from the access base mana.
+	emaCoefficientAccess = 0.00003209
+
+	// emaCoefficientConsensus is the coefficient (per second) of the exponential moving average that is used to derive
+	// the effective consensus mana from the consensus base mana.
+	emaCoefficientConsensus = 0.00003209
+
+	// parametersMutex is used to make the parameters safe for concurrent use.
+	parametersMutex sync.RWMutex
+)
+
+// SetCoefficients sets the coefficients that are used by the mana calculations. It should be called before any mana
+// is booked.
+func SetCoefficients(emaAccess, emaConsensus, decayRate float64) {
+	if emaAccess <= 0 || emaConsensus <= 0 || decayRate <= 0 {
+		panic("mana coefficients have to be positive")
+	}
+
+	parametersMutex.Lock()
+	defer parametersMutex.Unlock()
+
+	emaCoefficientAccess = emaAccess
+	emaCoefficientConsensus = emaConsensus
+	decay = decayRate
+}
+
+// coefficients returns the current coefficients that are used by the mana calculations.
+func coefficients() (emaAccess, emaConsensus, decayRate float64) {
+	parametersMutex.RLock()
+	defer parametersMutex.RUnlock()
+
+	return emaCoefficientAccess, emaCoefficientConsensus, decay
+}
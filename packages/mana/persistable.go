@@ -0,0 +1,405 @@
+package mana
+
+import (
+	"math"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/stringify"
+	"golang.org/x/xerrors"
+)
+
+// region PersistableBaseMana //////////////////////////////////////////////////////////////////////////////////////////
+
+// PersistableBaseMana represents a BaseMana of a node that can be stored in the ObjectStorage.
+type PersistableBaseMana struct {
+	manaType       Type
+	nodeID         identity.ID
+	baseValue      float64
+	effectiveValue float64
+	lastUpdated    time.Time
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewPersistableBaseMana creates a PersistableBaseMana from the BaseMana of the given node.
+func NewPersistableBaseMana(manaType Type, nodeID identity.ID, baseMana BaseMana) *PersistableBaseMana {
+	return &PersistableBaseMana{
+		manaType:       manaType,
+		nodeID:         nodeID,
+		baseValue:      baseMana.BaseValue(),
+		effectiveValue: baseMana.EffectiveValue(),
+		lastUpdated:    baseMana.LastUpdate(),
+	}
+}
+
+// PersistableBaseManaFromBytes unmarshals a PersistableBaseMana from a sequence of bytes.
+func PersistableBaseManaFromBytes(bytes []byte) (persistableBaseMana *PersistableBaseMana, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if persistableBaseMana, err = PersistableBaseManaFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse PersistableBaseMana from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// PersistableBaseManaFromMarshalUtil unmarshals a PersistableBaseMana using a MarshalUtil (for easier unmarshaling).
+func PersistableBaseManaFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (persistableBaseMana *PersistableBaseMana, err error) {
+	persistableBaseMana = &PersistableBaseMana{}
+	if persistableBaseMana.manaType, err = TypeFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse mana Type from MarshalUtil: %w", err)
+		return
+	}
+	nodeIDBytes, err := marshalUtil.ReadBytes(len(identity.ID{}))
+	if err != nil {
+		err = xerrors.Errorf("failed to parse nodeID (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(persistableBaseMana.nodeID[:], nodeIDBytes)
+	baseValue, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse base value (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	persistableBaseMana.baseValue = math.Float64frombits(baseValue)
+	effectiveValue, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse effective value (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	persistableBaseMana.effectiveValue = math.Float64frombits(effectiveValue)
+	if persistableBaseMana.lastUpdated, err = marshalUtil.ReadTime(); err != nil {
+		err = xerrors.Errorf("failed to parse last update time (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return
+}
+
+// PersistableBaseManaFromObjectStorage is a factory method that creates a new PersistableBaseMana instance from a
+// storage key and data of the object storage. It is used by the object storage, to create new instances of this entity.
+func PersistableBaseManaFromObjectStorage(key []byte, data []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = PersistableBaseManaFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = xerrors.Errorf("failed to parse PersistableBaseMana from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// ManaType returns the mana Type of the PersistableBaseMana.
+func (p *PersistableBaseMana) ManaType() Type {
+	return p.manaType
+}
+
+// NodeID returns the identifier of the node that owns the mana.
+func (p *PersistableBaseMana) NodeID() identity.ID {
+	return p.nodeID
+}
+
+// BaseMana returns the BaseMana that is represented by the PersistableBaseMana.
+func (p *PersistableBaseMana) BaseMana() (baseMana BaseMana, err error) {
+	switch p.manaType {
+	case AccessMana:
+		baseMana = NewAccessBaseMana(p.baseValue, p.effectiveValue, p.lastUpdated)
+	case ConsensusMana:
+		baseMana = NewConsensusBaseMana(p.baseValue, p.effectiveValue, p.lastUpdated)
+	default:
+		err = xerrors.Errorf("failed to create BaseMana of type %s: %w", p.manaType, ErrUnknownManaType)
+	}
+
+	return
+}
+
+// Bytes returns a marshaled version of the PersistableBaseMana.
+func (p *PersistableBaseMana) Bytes() []byte {
+	return byteutils.ConcatBytes(p.ObjectStorageKey(), p.ObjectStorageValue())
+}
+
+// String returns a human readable version of the PersistableBaseMana.
+func (p *PersistableBaseMana) String() string {
+	return stringify.Struct("PersistableBaseMana",
+		stringify.StructField("manaType", p.manaType),
+		stringify.StructField("nodeID", p.nodeID),
+		stringify.StructField("baseValue", p.baseValue),
+		stringify.StructField("effectiveValue", p.effectiveValue),
+		stringify.StructField("lastUpdated", p.lastUpdated),
+	)
+}
+
+// Update replaces the stored values with the values of the given PersistableBaseMana. It is required to match the
+// StorableObject interface and gets called when a newer state of the same node is stored.
+func (p *PersistableBaseMana) Update(other objectstorage.StorableObject) {
+	otherPersistableBaseMana := other.(*PersistableBaseMana)
+	p.baseValue = otherPersistableBaseMana.baseValue
+	p.effectiveValue = otherPersistableBaseMana.effectiveValue
+	p.lastUpdated = otherPersistableBaseMana.lastUpdated
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (p *PersistableBaseMana) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes(p.manaType.Bytes(), p.nodeID.Bytes())
+}
+
+// ObjectStorageValue marshals the PersistableBaseMana into a sequence of bytes that are used as the value part in the
+// object storage.
+func (p *PersistableBaseMana) ObjectStorageValue() []byte {
+	return marshalutil.New(2*marshalutil.Uint64Size + marshalutil.TimeSize).
+		WriteUint64(math.Float64bits(p.baseValue)).
+		WriteUint64(math.Float64bits(p.effectiveValue)).
+		WriteTime(p.lastUpdated).
+		Bytes()
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &PersistableBaseMana{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PersistableRevertedTransaction ///////////////////////////////////////////////////////////////////////////////
+
+// PersistableRevertedTransaction marks a Transaction whose mana was reverted in the BaseManaVector of the given mana Type
+// so that the guard against reverting it twice survives a restart of the node.
+type PersistableRevertedTransaction struct {
+	manaType      Type
+	transactionID ledgerstate.TransactionID
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewPersistableRevertedTransaction creates a PersistableRevertedTransaction for the given mana Type and Transaction.
+func NewPersistableRevertedTransaction(manaType Type, transactionID ledgerstate.TransactionID) *PersistableRevertedTransaction {
+	return &PersistableRevertedTransaction{
+		manaType:      manaType,
+		transactionID: transactionID,
+	}
+}
+
+// PersistableRevertedTransactionFromBytes unmarshals a PersistableRevertedTransaction from a sequence of bytes.
+func PersistableRevertedTransactionFromBytes(bytes []byte) (persistableRevertedTransaction *PersistableRevertedTransaction, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if persistableRevertedTransaction, err = PersistableRevertedTransactionFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse PersistableRevertedTransaction from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// PersistableRevertedTransactionFromMarshalUtil unmarshals a PersistableRevertedTransaction using a MarshalUtil (for
+// easier unmarshaling).
+func PersistableRevertedTransactionFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (persistableRevertedTransaction *PersistableRevertedTransaction, err error) {
+	persistableRevertedTransaction = &PersistableRevertedTransaction{}
+	if persistableRevertedTransaction.manaType, err = TypeFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse mana Type from MarshalUtil: %w", err)
+		return
+	}
+	if persistableRevertedTransaction.transactionID, err = ledgerstate.TransactionIDFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse TransactionID from MarshalUtil: %w", err)
+		return
+	}
+
+	return
+}
+
+// PersistableRevertedTransactionFromObjectStorage is a factory method that creates a new PersistableRevertedTransaction
+// instance from a storage key and data of the object storage. It is used by the object storage, to create new instances
+// of this entity.
+func PersistableRevertedTransactionFromObjectStorage(key []byte, data []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = PersistableRevertedTransactionFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = xerrors.Errorf("failed to parse PersistableRevertedTransaction from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// ManaType returns the mana Type of the BaseManaVector that the Transaction was reverted in.
+func (p *PersistableRevertedTransaction) ManaType() Type {
+	return p.manaType
+}
+
+// TransactionID returns the identifier of the reverted Transaction.
+func (p *PersistableRevertedTransaction) TransactionID() ledgerstate.TransactionID {
+	return p.transactionID
+}
+
+// Bytes returns a marshaled version of the PersistableRevertedTransaction.
+func (p *PersistableRevertedTransaction) Bytes() []byte {
+	return byteutils.ConcatBytes(p.ObjectStorageKey(), p.ObjectStorageValue())
+}
+
+// String returns a human readable version of the PersistableRevertedTransaction.
+func (p *PersistableRevertedTransaction) String() string {
+	return stringify.Struct("PersistableRevertedTransaction",
+		stringify.StructField("manaType", p.manaType),
+		stringify.StructField("transactionID", p.transactionID),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match StorableObject interface.
+func (p *PersistableRevertedTransaction) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (p *PersistableRevertedTransaction) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes(p.manaType.Bytes(), p.transactionID.Bytes())
+}
+
+// ObjectStorageValue marshals the PersistableRevertedTransaction into a sequence of bytes that are used as the value
+// part in the object storage.
+func (p *PersistableRevertedTransaction) ObjectStorageValue() []byte {
+	return nil
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &PersistableRevertedTransaction{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PersistableTransactionPledge //////////////////////////////////////////////////////////////////////////////////
+
+// PersistableTransactionPledge contains the timestamp and the pledge nodes of a Transaction. It is exported as part of a
+// snapshot for the Transactions that created the snapshot Outputs, so that the mana that they pledged can be revoked
+// when the Outputs are spent on a node that does not know these Transactions.
+type PersistableTransactionPledge struct {
+	transactionID     ledgerstate.TransactionID
+	timestamp         time.Time
+	accessPledgeID    identity.ID
+	consensusPledgeID identity.ID
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewPersistableTransactionPledge creates a PersistableTransactionPledge from the timestamp and the pledge nodes of the
+// given Transaction.
+func NewPersistableTransactionPledge(transactionID ledgerstate.TransactionID, timestamp time.Time, pledgeID map[Type]identity.ID) *PersistableTransactionPledge {
+	return &PersistableTransactionPledge{
+		transactionID:     transactionID,
+		timestamp:         timestamp,
+		accessPledgeID:    pledgeID[AccessMana],
+		consensusPledgeID: pledgeID[ConsensusMana],
+	}
+}
+
+// PersistableTransactionPledgeFromBytes unmarshals a PersistableTransactionPledge from a sequence of bytes.
+func PersistableTransactionPledgeFromBytes(bytes []byte) (persistableTransactionPledge *PersistableTransactionPledge, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if persistableTransactionPledge, err = PersistableTransactionPledgeFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse PersistableTransactionPledge from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// PersistableTransactionPledgeFromMarshalUtil unmarshals a PersistableTransactionPledge using a MarshalUtil (for easier
+// unmarshaling).
+func PersistableTransactionPledgeFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (persistableTransactionPledge *PersistableTransactionPledge, err error) {
+	persistableTransactionPledge = &PersistableTransactionPledge{}
+	if persistableTransactionPledge.transactionID, err = ledgerstate.TransactionIDFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse TransactionID from MarshalUtil: %w", err)
+		return
+	}
+	if persistableTransactionPledge.timestamp, err = marshalUtil.ReadTime(); err != nil {
+		err = xerrors.Errorf("failed to parse timestamp (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	accessPledgeIDBytes, err := marshalUtil.ReadBytes(len(identity.ID{}))
+	if err != nil {
+		err = xerrors.Errorf("failed to parse access pledge ID (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(persistableTransactionPledge.accessPledgeID[:], accessPledgeIDBytes)
+	consensusPledgeIDBytes, err := marshalUtil.ReadBytes(len(identity.ID{}))
+	if err != nil {
+		err = xerrors.Errorf("failed to parse consensus pledge ID (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(persistableTransactionPledge.consensusPledgeID[:], consensusPledgeIDBytes)
+
+	return
+}
+
+// PersistableTransactionPledgeFromObjectStorage is a factory method that creates a new PersistableTransactionPledge
+// instance from a storage key and data of the object storage. It is used by the object storage, to create new instances
+// of this entity.
+func PersistableTransactionPledgeFromObjectStorage(key []byte, data []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = PersistableTransactionPledgeFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = xerrors.Errorf("failed to parse PersistableTransactionPledge from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// TransactionID returns the identifier of the Transaction.
+func (p *PersistableTransactionPledge) TransactionID() ledgerstate.TransactionID {
+	return p.transactionID
+}
+
+// Timestamp returns the timestamp of the Transaction.
+func (p *PersistableTransactionPledge) Timestamp() time.Time {
+	return p.timestamp
+}
+
+// PledgeID returns the nodeIDs that the Transaction pledged its mana to.
+func (p *PersistableTransactionPledge) PledgeID() map[Type]identity.ID {
+	return map[Type]identity.ID{
+		AccessMana:    p.accessPledgeID,
+		ConsensusMana: p.consensusPledgeID,
+	}
+}
+
+// Bytes returns a marshaled version of the PersistableTransactionPledge.
+func (p *PersistableTransactionPledge) Bytes() []byte {
+	return byteutils.ConcatBytes(p.ObjectStorageKey(), p.ObjectStorageValue())
+}
+
+// String returns a human readable version of the PersistableTransactionPledge.
+func (p *PersistableTransactionPledge) String() string {
+	return stringify.Struct("PersistableTransactionPledge",
+		stringify.StructField("transactionID", p.transactionID),
+		stringify.StructField("timestamp", p.timestamp),
+		stringify.StructField("accessPledgeID", p.accessPledgeID),
+		stringify.StructField("consensusPledgeID", p.consensusPledgeID),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match StorableObject interface.
+func (p *PersistableTransactionPledge) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (p *PersistableTransactionPledge) ObjectStorageKey() []byte {
+	return p.transactionID.Bytes()
+}
+
+// ObjectStorageValue marshals the PersistableTransactionPledge into a sequence of bytes that are used as the value part
+// in the object storage.
+func (p *PersistableTransactionPledge) ObjectStorageValue() []byte {
+	return marshalutil.New(marshalutil.TimeSize + 2*len(identity.ID{})).
+		WriteTime(p.timestamp).
+		WriteBytes(p.accessPledgeID.Bytes()).
+		WriteBytes(p.consensusPledgeID.Bytes()).
+		Bytes()
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &PersistableTransactionPledge{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,84 @@
+package mana
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+// region TxInfo ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TxInfo contains the information of a Transaction that is required to book (or revert) the mana that it pledges.
+type TxInfo struct {
+	// TimeStamp is the timestamp of the Transaction.
+	TimeStamp time.Time
+
+	// TransactionID is the identifier of the Transaction.
+	TransactionID ledgerstate.TransactionID
+
+	// TotalBalance is the sum of the funds moved by the Transaction.
+	TotalBalance float64
+
+	// PledgeID contains the nodeIDs that the Transaction pledges its mana to.
+	PledgeID map[Type]identity.ID
+
+	// InputInfos contains the information about the consumed Inputs of the Transaction.
+	InputInfos []InputInfo
+}
+
+// NewTxInfo creates a TxInfo from the given Transaction and the Outputs it consumes. The inputInfoProvider is used to
+// retrieve the timestamp and the pledge nodes of the Transaction that created a consumed Output - it returns false if
+// the creating Transaction is not known (i.e. the Output was created by the genesis snapshot).
+func NewTxInfo(transaction *ledgerstate.Transaction, consumedOutputs ledgerstate.Outputs, inputInfoProvider func(transactionID ledgerstate.TransactionID) (timestamp time.Time, pledgeID map[Type]identity.ID, exists bool)) (txInfo *TxInfo) {
+	txInfo = &TxInfo{
+		TimeStamp:     transaction.Essence().Timestamp(),
+		TransactionID: transaction.ID(),
+		PledgeID: map[Type]identity.ID{
+			AccessMana:    transaction.Essence().AccessPledgeID(),
+			ConsensusMana: transaction.Essence().ConsensusPledgeID(),
+		},
+		InputInfos: make([]InputInfo, 0, len(consumedOutputs)),
+	}
+
+	for _, consumedOutput := range consumedOutputs {
+		inputInfo := InputInfo{
+			InputID:  consumedOutput.ID(),
+			PledgeID: make(map[Type]identity.ID),
+		}
+		consumedOutput.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			inputInfo.Amount += float64(balance)
+			return true
+		})
+		if timestamp, pledgeID, exists := inputInfoProvider(consumedOutput.ID().TransactionID()); exists {
+			inputInfo.TimeStamp = timestamp
+			inputInfo.PledgeID = pledgeID
+		}
+
+		txInfo.TotalBalance += inputInfo.Amount
+		txInfo.InputInfos = append(txInfo.InputInfos, inputInfo)
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region InputInfo ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// InputInfo contains the information of a consumed Output that is required to book (or revert) mana.
+type InputInfo struct {
+	// TimeStamp is the timestamp of the Transaction that created the Output (it is zero if the Transaction is unknown).
+	TimeStamp time.Time
+
+	// Amount is the sum of the funds of the Output.
+	Amount float64
+
+	// PledgeID contains the nodeIDs that the Transaction which created the Output pledged its mana to.
+	PledgeID map[Type]identity.ID
+
+	// InputID is the identifier of the consumed Output.
+	InputID ledgerstate.OutputID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,74 @@
+package mana
+
+import (
+	"fmt"
+
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"golang.org/x/xerrors"
+)
+
+// region Type /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// AccessMana is mana associated with access to the network.
+	AccessMana Type = iota
+
+	// ConsensusMana is mana associated with consensus weights in the network.
+	ConsensusMana
+)
+
+// Type is the mana type.
+type Type uint8
+
+// TypeFromString parses a string and returns the corresponding Type.
+func TypeFromString(typeString string) (manaType Type, err error) {
+	switch typeString {
+	case "Access":
+		manaType = AccessMana
+	case "Consensus":
+		manaType = ConsensusMana
+	default:
+		err = xerrors.Errorf("unknown mana type '%s': %w", typeString, ErrUnknownManaType)
+	}
+
+	return
+}
+
+// TypeFromMarshalUtil unmarshals a Type using a MarshalUtil (for easier unmarshaling).
+func TypeFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (manaType Type, err error) {
+	typeByte, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse mana Type (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	switch manaType = Type(typeByte); manaType {
+	case AccessMana:
+	case ConsensusMana:
+	default:
+		err = xerrors.Errorf("unsupported mana Type (%X): %w", typeByte, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return
+}
+
+// Bytes returns a marshaled version of the Type.
+func (t Type) Bytes() []byte {
+	return []byte{byte(t)}
+}
+
+// String returns a human readable version of the Type.
+func (t Type) String() string {
+	switch t {
+	case AccessMana:
+		return "Access"
+	case ConsensusMana:
+		return "Consensus"
+	default:
+		return fmt.Sprintf("Type(%X)", byte(t))
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
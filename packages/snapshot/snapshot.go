@@ -0,0 +1,212 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"golang.org/x/xerrors"
+)
+
+// Header is the sequence of bytes that every Snapshot starts with. It allows to distinguish Snapshots from the
+// genesis snapshots that are written by ledgerstate.Snapshot.
+var Header = []byte("GOSHIMMER_SNAPSHOT_V3")
+
+// headerV1 is the Header of the Snapshots that were exported before solid entry points were added. They can still be
+// read but do not contain any solid entry points.
+var headerV1 = []byte("GOSHIMMER_SNAPSHOT_V1")
+
+// headerV2 is the Header of the Snapshots that were exported before the pledges of the Transactions were added. They
+// can still be read but do not contain any TransactionPledges.
+var headerV2 = []byte("GOSHIMMER_SNAPSHOT_V2")
+
+// region Snapshot /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Snapshot represents the confirmed and unspent Outputs of the ledger state of a running node (and optionally its mana
+// state) together with the solid entry points of its Tangle that can be used to bootstrap a new node.
+type Snapshot struct {
+	// Outputs contains the confirmed unspent Outputs including their OutputIDs.
+	Outputs ledgerstate.Outputs
+
+	// BaseManas contains the access and consensus BaseMana of the known nodes (it is empty if the mana was not
+	// exported).
+	BaseManas []*mana.PersistableBaseMana
+
+	// TransactionPledges contains the timestamps and the pledge nodes of the Transactions that created the Outputs, so
+	// that the mana contained in BaseManas is revoked when the Outputs are spent (it is empty if the mana was not
+	// exported).
+	TransactionPledges []*mana.PersistableTransactionPledge
+
+	// SolidEntryPoints contains the MessageIDs of the latest confirmed Messages, which are treated as solid by the nodes
+	// that are bootstrapped from the Snapshot.
+	SolidEntryPoints tangle.MessageIDs
+}
+
+// FromBytes unmarshals a Snapshot from a sequence of bytes.
+func FromBytes(snapshotBytes []byte) (snapshot *Snapshot, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(snapshotBytes)
+	if snapshot, err = FromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse Snapshot from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// FromMarshalUtil unmarshals a Snapshot using a MarshalUtil (for easier unmarshaling).
+func FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (snapshot *Snapshot, err error) {
+	header, err := marshalUtil.ReadBytes(len(Header))
+	if err != nil {
+		err = xerrors.Errorf("failed to parse header (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if !bytes.Equal(header, Header) && !bytes.Equal(header, headerV1) && !bytes.Equal(header, headerV2) {
+		err = xerrors.Errorf("invalid snapshot header: %w", cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	outputCount, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse output count (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	snapshot = &Snapshot{
+		Outputs: make(ledgerstate.Outputs, 0, outputCount),
+	}
+	for i := uint64(0); i < outputCount; i++ {
+		outputID, outputIDErr := ledgerstate.OutputIDFromMarshalUtil(marshalUtil)
+		if outputIDErr != nil {
+			err = xerrors.Errorf("failed to parse OutputID: %w", outputIDErr)
+			return
+		}
+		output, outputErr := ledgerstate.OutputFromMarshalUtil(marshalUtil)
+		if outputErr != nil {
+			err = xerrors.Errorf("failed to parse Output with %s: %w", outputID, outputErr)
+			return
+		}
+		snapshot.Outputs = append(snapshot.Outputs, output.SetID(outputID))
+	}
+
+	baseManaCount, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse mana count (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	snapshot.BaseManas = make([]*mana.PersistableBaseMana, 0, baseManaCount)
+	for i := uint64(0); i < baseManaCount; i++ {
+		persistableBaseMana, baseManaErr := mana.PersistableBaseManaFromMarshalUtil(marshalUtil)
+		if baseManaErr != nil {
+			err = xerrors.Errorf("failed to parse PersistableBaseMana: %w", baseManaErr)
+			return
+		}
+		snapshot.BaseManas = append(snapshot.BaseManas, persistableBaseMana)
+	}
+
+	snapshot.TransactionPledges = make([]*mana.PersistableTransactionPledge, 0)
+	if bytes.Equal(header, Header) {
+		transactionPledgeCount, transactionPledgeCountErr := marshalUtil.ReadUint64()
+		if transactionPledgeCountErr != nil {
+			err = xerrors.Errorf("failed to parse transaction pledge count (%v): %w", transactionPledgeCountErr, cerrors.ErrParseBytesFailed)
+			return
+		}
+		for i := uint64(0); i < transactionPledgeCount; i++ {
+			transactionPledge, transactionPledgeErr := mana.PersistableTransactionPledgeFromMarshalUtil(marshalUtil)
+			if transactionPledgeErr != nil {
+				err = xerrors.Errorf("failed to parse PersistableTransactionPledge: %w", transactionPledgeErr)
+				return
+			}
+			snapshot.TransactionPledges = append(snapshot.TransactionPledges, transactionPledge)
+		}
+	}
+
+	snapshot.SolidEntryPoints = make(tangle.MessageIDs, 0)
+	if bytes.Equal(header, headerV1) {
+		return
+	}
+
+	solidEntryPointCount, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse solid entry point count (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	for i := uint64(0); i < solidEntryPointCount; i++ {
+		messageID, messageIDErr := tangle.MessageIDFromMarshalUtil(marshalUtil)
+		if messageIDErr != nil {
+			err = xerrors.Errorf("failed to parse solid entry point: %w", messageIDErr)
+			return
+		}
+		snapshot.SolidEntryPoints = append(snapshot.SolidEntryPoints, messageID)
+	}
+
+	return
+}
+
+// IsSnapshot checks if the given reader starts with the Header of a Snapshot without consuming any bytes.
+func IsSnapshot(reader *bufio.Reader) bool {
+	header, err := reader.Peek(len(Header))
+
+	return err == nil && (bytes.Equal(header, Header) || bytes.Equal(header, headerV1) || bytes.Equal(header, headerV2))
+}
+
+// ReadFrom reads the Snapshot from the given reader. It overrides the existing content of the Snapshot.
+func (s *Snapshot) ReadFrom(reader io.Reader) (bytesRead int64, err error) {
+	snapshotBytes, err := ioutil.ReadAll(reader)
+	if err != nil {
+		err = xerrors.Errorf("failed to read snapshot: %w", err)
+		return
+	}
+
+	snapshot, consumedBytes, err := FromBytes(snapshotBytes)
+	if err != nil {
+		return int64(consumedBytes), err
+	}
+	*s = *snapshot
+
+	return int64(consumedBytes), nil
+}
+
+// WriteTo writes the Snapshot to the given writer.
+func (s *Snapshot) WriteTo(writer io.Writer) (bytesWritten int64, err error) {
+	written, err := writer.Write(s.Bytes())
+	if err != nil {
+		err = xerrors.Errorf("failed to write snapshot: %w", err)
+	}
+
+	return int64(written), err
+}
+
+// Bytes returns a marshaled version of the Snapshot.
+func (s *Snapshot) Bytes() []byte {
+	marshalUtil := marshalutil.New().
+		WriteBytes(Header).
+		WriteUint64(uint64(len(s.Outputs)))
+	for _, output := range s.Outputs {
+		marshalUtil.Write(output.ID()).WriteBytes(output.Bytes())
+	}
+
+	marshalUtil.WriteUint64(uint64(len(s.BaseManas)))
+	for _, persistableBaseMana := range s.BaseManas {
+		marshalUtil.WriteBytes(persistableBaseMana.Bytes())
+	}
+
+	marshalUtil.WriteUint64(uint64(len(s.TransactionPledges)))
+	for _, transactionPledge := range s.TransactionPledges {
+		marshalUtil.WriteBytes(transactionPledge.Bytes())
+	}
+
+	marshalUtil.WriteUint64(uint64(len(s.SolidEntryPoints)))
+	for _, solidEntryPoint := range s.SolidEntryPoints {
+		marshalUtil.Write(solidEntryPoint)
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,104 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot_WriteToReadFrom(t *testing.T) {
+	address := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	nodeID := identity.GenerateIdentity().ID()
+	snapshot := &Snapshot{
+		Outputs: ledgerstate.Outputs{
+			ledgerstate.NewSigLockedSingleOutput(100, address).SetID(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 3)),
+			ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{
+				ledgerstate.ColorIOTA: 200,
+				{1}:                   5,
+			}), address).SetID(ledgerstate.NewOutputID(ledgerstate.TransactionID{7}, 1)),
+		},
+		BaseManas: []*mana.PersistableBaseMana{
+			mana.NewPersistableBaseMana(mana.AccessMana, nodeID, mana.NewAccessBaseMana(10, 5, time.Unix(1000, 0))),
+			mana.NewPersistableBaseMana(mana.ConsensusMana, nodeID, mana.NewConsensusBaseMana(20, 15, time.Unix(1000, 0))),
+		},
+		TransactionPledges: []*mana.PersistableTransactionPledge{
+			mana.NewPersistableTransactionPledge(ledgerstate.TransactionID{7}, time.Unix(900, 0), map[mana.Type]identity.ID{
+				mana.AccessMana:    nodeID,
+				mana.ConsensusMana: nodeID,
+			}),
+		},
+		SolidEntryPoints: tangle.MessageIDs{{1}, {2}},
+	}
+
+	var buffer bytes.Buffer
+	written, err := snapshot.WriteTo(&buffer)
+	require.NoError(t, err)
+	assert.True(t, IsSnapshot(bufio.NewReader(bytes.NewReader(buffer.Bytes()))))
+
+	restoredSnapshot := &Snapshot{}
+	read, err := restoredSnapshot.ReadFrom(&buffer)
+	require.NoError(t, err)
+	assert.Equal(t, written, read)
+
+	require.Len(t, restoredSnapshot.Outputs, 2)
+	for i, output := range snapshot.Outputs {
+		assert.Equal(t, output.ID(), restoredSnapshot.Outputs[i].ID())
+		assert.Equal(t, output.Bytes(), restoredSnapshot.Outputs[i].Bytes())
+	}
+	require.Len(t, restoredSnapshot.BaseManas, 2)
+	for i, persistableBaseMana := range snapshot.BaseManas {
+		assert.Equal(t, persistableBaseMana.Bytes(), restoredSnapshot.BaseManas[i].Bytes())
+	}
+	require.Len(t, restoredSnapshot.TransactionPledges, 1)
+	assert.Equal(t, snapshot.TransactionPledges[0].Bytes(), restoredSnapshot.TransactionPledges[0].Bytes())
+	assert.Equal(t, snapshot.SolidEntryPoints, restoredSnapshot.SolidEntryPoints)
+}
+
+func TestSnapshot_FromBytesV1(t *testing.T) {
+	snapshotBytes := (&Snapshot{SolidEntryPoints: tangle.MessageIDs{{1}}}).Bytes()
+	copy(snapshotBytes, headerV1)
+	// V1 snapshots end after the mana section
+	snapshotBytes = snapshotBytes[:len(snapshotBytes)-2*marshalutil.Uint64Size-tangle.MessageIDLength]
+
+	assert.True(t, IsSnapshot(bufio.NewReader(bytes.NewReader(snapshotBytes))))
+	restoredSnapshot, consumedBytes, err := FromBytes(snapshotBytes)
+	require.NoError(t, err)
+	assert.Equal(t, len(snapshotBytes), consumedBytes)
+	assert.Empty(t, restoredSnapshot.SolidEntryPoints)
+}
+
+func TestSnapshot_FromBytesV2(t *testing.T) {
+	snapshotBytes := (&Snapshot{SolidEntryPoints: tangle.MessageIDs{{1}}}).Bytes()
+	copy(snapshotBytes, headerV2)
+	// V2 snapshots do not contain the transaction pledge section
+	transactionPledgesOffset := len(snapshotBytes) - 2*marshalutil.Uint64Size - tangle.MessageIDLength
+	snapshotBytes = append(snapshotBytes[:transactionPledgesOffset], snapshotBytes[transactionPledgesOffset+marshalutil.Uint64Size:]...)
+
+	assert.True(t, IsSnapshot(bufio.NewReader(bytes.NewReader(snapshotBytes))))
+	restoredSnapshot, consumedBytes, err := FromBytes(snapshotBytes)
+	require.NoError(t, err)
+	assert.Equal(t, len(snapshotBytes), consumedBytes)
+	assert.Empty(t, restoredSnapshot.TransactionPledges)
+	assert.Equal(t, tangle.MessageIDs{{1}}, restoredSnapshot.SolidEntryPoints)
+}
+
+func TestIsSnapshot(t *testing.T) {
+	var buffer bytes.Buffer
+	_, err := ledgerstate.Snapshot{}.WriteTo(&buffer)
+	require.NoError(t, err)
+
+	assert.False(t, IsSnapshot(bufio.NewReader(&buffer)))
+
+	_, _, err = FromBytes(buffer.Bytes())
+	assert.Error(t, err)
+}
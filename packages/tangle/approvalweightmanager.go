@@ -0,0 +1,629 @@
+package tangle
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/datastructure/walker"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/stringify"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// DefaultConfirmationThreshold defines the default share of the total consensus mana that has to support a Marker
+	// before it is considered to be confirmed.
+	DefaultConfirmationThreshold = 0.5
+)
+
+// region ApprovalWeightManager ////////////////////////////////////////////////////////////////////////////////////////
+
+// ConsensusManaRetrieveFunc is a function type to retrieve the consensus mana of all nodes.
+type ConsensusManaRetrieveFunc func() map[identity.ID]float64
+
+// ApprovalWeightManager is a Tangle component that keeps track of the consensus mana of the issuers that (directly or
+// indirectly) approve the Markers of the Tangle. A Marker is confirmed as soon as the consensus mana of its supporters
+// exceeds the confirmation threshold - which in turn confirms the past cone of the Marker and the Branches of the
+// confirmed Messages.
+type ApprovalWeightManager struct {
+	// Events is a dictionary for the ApprovalWeightManager related Events.
+	Events *ApprovalWeightManagerEvents
+
+	tangle                    *Tangle
+	consensusManaRetrieveFunc ConsensusManaRetrieveFunc
+	mutex                     sync.RWMutex
+}
+
+// NewApprovalWeightManager is the constructor of the ApprovalWeightManager.
+func NewApprovalWeightManager(tangle *Tangle) (approvalWeightManager *ApprovalWeightManager) {
+	approvalWeightManager = &ApprovalWeightManager{
+		Events: &ApprovalWeightManagerEvents{
+			MarkerConfirmed:  events.NewEvent(markerEventHandler),
+			MessageConfirmed: events.NewEvent(messageIDEventHandler),
+			BranchConfirmed:  events.NewEvent(branchIDEventHandler),
+		},
+		tangle: tangle,
+	}
+
+	return
+}
+
+// Setup sets up the behavior of the component by making it attach to the relevant events of other components.
+func (a *ApprovalWeightManager) Setup() {
+	a.tangle.Booker.Events.MessageBooked.Attach(events.NewClosure(a.ProcessMessage))
+}
+
+// SetConsensusManaRetrieveFunc sets the function that is used to retrieve the consensus mana of the supporters. The
+// ApprovalWeightManager does not confirm anything as long as no function was set.
+func (a *ApprovalWeightManager) SetConsensusManaRetrieveFunc(consensusManaRetrieveFunc ConsensusManaRetrieveFunc) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.consensusManaRetrieveFunc = consensusManaRetrieveFunc
+}
+
+// ProcessMessage adds the issuer of the given booked Message as a supporter of all the Markers in its past cone and
+// confirms the Markers (and their past cone) whose approval weight exceeds the confirmation threshold.
+func (a *ApprovalWeightManager) ProcessMessage(messageID MessageID) {
+	var confirmedMarkers map[*markers.Marker]MessageID
+	a.tangle.Storage.Message(messageID).Consume(func(message *Message) {
+		a.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+			structureDetails := messageMetadata.StructureDetails()
+			if structureDetails == nil {
+				return
+			}
+
+			a.mutex.Lock()
+			defer a.mutex.Unlock()
+
+			if structureDetails.IsPastMarker {
+				a.registerMarkerMessage(structureDetails.PastMarkers.FirstMarker(), messageID)
+			}
+
+			supporter := identity.NewID(message.IssuerPublicKey())
+			updatedSequences := make(map[markers.SequenceID]bool)
+			structureDetails.PastMarkers.ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
+				a.addSupport(markers.NewMarker(sequenceID, index), supporter, updatedSequences)
+
+				return true
+			})
+
+			confirmedMarkers = a.updateConfirmedIndexes(updatedSequences)
+		})
+	})
+
+	for confirmedMarker, markerMessageID := range confirmedMarkers {
+		a.Events.MarkerConfirmed.Trigger(confirmedMarker)
+		a.confirmPastCone(markerMessageID)
+	}
+}
+
+// Weight returns the share of the total consensus mana that supports the given Marker.
+func (a *ApprovalWeightManager) Weight(marker *markers.Marker) (weight float64) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	manaMap, totalMana := a.consensusMana()
+	if totalMana == 0 {
+		return
+	}
+
+	a.tangle.Storage.SequenceSupporters(marker.SequenceID()).Consume(func(sequenceSupporters *SequenceSupporters) {
+		for supporter, index := range sequenceSupporters.Supporters() {
+			if index >= marker.Index() {
+				weight += manaMap[supporter]
+			}
+		}
+	})
+
+	return weight / totalMana
+}
+
+// IsMarkerConfirmed returns true if the given Marker has been confirmed.
+func (a *ApprovalWeightManager) IsMarkerConfirmed(marker *markers.Marker) (confirmed bool) {
+	a.tangle.Storage.SequenceSupporters(marker.SequenceID()).Consume(func(sequenceSupporters *SequenceSupporters) {
+		confirmedIndex, exists := sequenceSupporters.ConfirmedIndex()
+		confirmed = exists && confirmedIndex >= marker.Index()
+	})
+
+	return
+}
+
+// registerMarkerMessage stores the MessageID of a Message that was assigned a Marker so that its past cone can be
+// confirmed once the Marker is confirmed.
+func (a *ApprovalWeightManager) registerMarkerMessage(marker *markers.Marker, messageID MessageID) {
+	a.tangle.Storage.SequenceSupporters(marker.SequenceID(), NewSequenceSupporters).Consume(func(sequenceSupporters *SequenceSupporters) {
+		sequenceSupporters.AddMarkerMessage(marker.Index(), messageID)
+	})
+}
+
+// addSupport registers the supporter for the given Marker and propagates the support to the Markers of the parent
+// Sequences that are referenced by the Marker.
+func (a *ApprovalWeightManager) addSupport(marker *markers.Marker, supporter identity.ID, updatedSequences map[markers.SequenceID]bool) {
+	supportAdded := false
+	a.tangle.Storage.SequenceSupporters(marker.SequenceID(), NewSequenceSupporters).Consume(func(sequenceSupporters *SequenceSupporters) {
+		supportAdded = sequenceSupporters.AddSupport(supporter, marker.Index())
+	})
+	if !supportAdded {
+		return
+	}
+	updatedSequences[marker.SequenceID()] = true
+
+	a.tangle.Booker.MarkersManager.Sequence(marker.SequenceID()).Consume(func(sequence *markers.Sequence) {
+		sequence.HighestReferencedParentMarkers(marker.Index()).ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
+			a.addSupport(markers.NewMarker(sequenceID, index), supporter, updatedSequences)
+
+			return true
+		})
+	})
+}
+
+// updateConfirmedIndexes updates the highest confirmed Index of the given Sequences and returns the Markers that got
+// confirmed together with the MessageIDs of their Messages.
+func (a *ApprovalWeightManager) updateConfirmedIndexes(sequenceIDs map[markers.SequenceID]bool) (confirmedMarkers map[*markers.Marker]MessageID) {
+	confirmedMarkers = make(map[*markers.Marker]MessageID)
+
+	manaMap, totalMana := a.consensusMana()
+	if totalMana == 0 {
+		return
+	}
+
+	for sequenceID := range sequenceIDs {
+		a.tangle.Storage.SequenceSupporters(sequenceID).Consume(func(sequenceSupporters *SequenceSupporters) {
+			confirmedIndex, confirmed := a.highestConfirmedIndex(sequenceSupporters.Supporters(), manaMap, totalMana)
+			if !confirmed || !sequenceSupporters.SetConfirmedIndex(confirmedIndex) {
+				return
+			}
+
+			// the Messages of the confirmed Markers are not needed anymore once their past cone got confirmed
+			for index, markerMessageID := range sequenceSupporters.PruneMarkerMessages(confirmedIndex) {
+				confirmedMarkers[markers.NewMarker(sequenceID, index)] = markerMessageID
+			}
+		})
+	}
+
+	return
+}
+
+// highestConfirmedIndex returns the highest Index of a Sequence whose supporters hold more than the confirmation
+// threshold of the total consensus mana.
+func (a *ApprovalWeightManager) highestConfirmedIndex(supporters map[identity.ID]markers.Index, manaMap map[identity.ID]float64, totalMana float64) (confirmedIndex markers.Index, confirmed bool) {
+	type supportedIndex struct {
+		index markers.Index
+		mana  float64
+	}
+	supported := make([]supportedIndex, 0, len(supporters))
+	for supporter, index := range supporters {
+		supported = append(supported, supportedIndex{index: index, mana: manaMap[supporter]})
+	}
+	sort.Slice(supported, func(i, j int) bool {
+		return supported[i].index > supported[j].index
+	})
+
+	// every supporter of an Index also supports all lower Indexes of the same Sequence
+	approvalWeight := 0.0
+	for _, supportedIndex := range supported {
+		if approvalWeight += supportedIndex.mana; approvalWeight > a.tangle.Options.ConfirmationThreshold*totalMana {
+			return supportedIndex.index, true
+		}
+	}
+
+	return
+}
+
+// confirmPastCone marks the given Message and its strong past cone as confirmed.
+func (a *ApprovalWeightManager) confirmPastCone(messageID MessageID) {
+	a.tangle.Utils.WalkMessageAndMetadata(func(message *Message, messageMetadata *MessageMetadata, walker *walker.Walker) {
+		if !messageMetadata.SetConfirmed(true) {
+			return
+		}
+
+		a.tangle.Storage.updateConfirmedTips(message)
+		a.confirmPayload(message, messageMetadata)
+		a.Events.MessageConfirmed.Trigger(message.ID())
+
+		message.ForEachStrongParent(func(parentMessageID MessageID) {
+			if parentMessageID != EmptyMessageID {
+				walker.Push(parentMessageID)
+			}
+		})
+	}, MessageIDs{messageID})
+}
+
+// confirmPayload finalizes the Transaction contained in the given Message and confirms the Branch of the Message.
+func (a *ApprovalWeightManager) confirmPayload(message *Message, messageMetadata *MessageMetadata) {
+	if payload := message.Payload(); payload != nil && payload.Type() == ledgerstate.TransactionType {
+		transaction := payload.(*ledgerstate.Transaction)
+		a.tangle.LedgerState.TransactionMetadata(transaction.ID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+			transactionMetadata.SetFinalized(true)
+		})
+		for _, output := range transaction.Essence().Outputs() {
+			a.tangle.LedgerState.OutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+				outputMetadata.SetFinalized(true)
+			})
+		}
+	}
+
+	a.confirmBranch(messageMetadata.BranchID())
+}
+
+// confirmBranch marks the given Branch as liked and finalized if it was not finalized already.
+func (a *ApprovalWeightManager) confirmBranch(branchID ledgerstate.BranchID) {
+	switch branchID {
+	case ledgerstate.UndefinedBranchID, ledgerstate.MasterBranchID, ledgerstate.LazyBookedConflictsBranchID, ledgerstate.InvalidBranchID:
+		return
+	}
+
+	finalized := true
+	a.tangle.LedgerState.Branch(branchID).Consume(func(branch ledgerstate.Branch) {
+		finalized = branch.Finalized()
+	})
+	if finalized {
+		return
+	}
+
+	if _, err := a.tangle.LedgerState.BranchDAG.SetBranchLiked(branchID, true); err != nil {
+		a.tangle.Events.Error.Trigger(xerrors.Errorf("failed to like confirmed Branch with %s: %w", branchID, err))
+		return
+	}
+	modified, err := a.tangle.LedgerState.BranchDAG.SetBranchFinalized(branchID, true)
+	if err != nil {
+		a.tangle.Events.Error.Trigger(xerrors.Errorf("failed to finalize confirmed Branch with %s: %w", branchID, err))
+		return
+	}
+	if modified {
+		a.Events.BranchConfirmed.Trigger(branchID)
+	}
+}
+
+// consensusMana returns the current consensus mana of all nodes and its sum.
+func (a *ApprovalWeightManager) consensusMana() (manaMap map[identity.ID]float64, totalMana float64) {
+	if a.consensusManaRetrieveFunc == nil {
+		return
+	}
+
+	manaMap = a.consensusManaRetrieveFunc()
+	for _, mana := range manaMap {
+		totalMana += mana
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SequenceSupporters ///////////////////////////////////////////////////////////////////////////////////////////
+
+// SequenceSupporters is a data structure that keeps track of the approval weight related state of a Sequence: the
+// highest Index that each supporter approved, the highest confirmed Index and the Messages of the Markers that are not
+// confirmed, yet.
+type SequenceSupporters struct {
+	sequenceID     markers.SequenceID
+	supporters     map[identity.ID]markers.Index
+	confirmedIndex markers.Index
+	confirmed      bool
+	markerMessages map[markers.Index]MessageID
+	mutex          sync.RWMutex
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewSequenceSupporters creates a new SequenceSupporters for the given SequenceID.
+func NewSequenceSupporters(sequenceID markers.SequenceID) *SequenceSupporters {
+	return &SequenceSupporters{
+		sequenceID:     sequenceID,
+		supporters:     make(map[identity.ID]markers.Index),
+		markerMessages: make(map[markers.Index]MessageID),
+	}
+}
+
+// SequenceSupportersFromBytes unmarshals a SequenceSupporters from a sequence of bytes.
+func SequenceSupportersFromBytes(bytes []byte) (sequenceSupporters *SequenceSupporters, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if sequenceSupporters, err = SequenceSupportersFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse SequenceSupporters from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// SequenceSupportersFromMarshalUtil unmarshals a SequenceSupporters using a MarshalUtil (for easier unmarshaling).
+func SequenceSupportersFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (sequenceSupporters *SequenceSupporters, err error) {
+	sequenceSupporters = &SequenceSupporters{}
+	if sequenceSupporters.sequenceID, err = markers.SequenceIDFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse SequenceID from MarshalUtil: %w", err)
+		return
+	}
+	if sequenceSupporters.confirmed, err = marshalUtil.ReadBool(); err != nil {
+		err = xerrors.Errorf("failed to parse confirmed flag (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if sequenceSupporters.confirmedIndex, err = markers.IndexFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse confirmed Index from MarshalUtil: %w", err)
+		return
+	}
+
+	supportersCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse supporters count (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	sequenceSupporters.supporters = make(map[identity.ID]markers.Index, supportersCount)
+	for i := uint32(0); i < supportersCount; i++ {
+		supporterBytes, supporterErr := marshalUtil.ReadBytes(len(identity.ID{}))
+		if supporterErr != nil {
+			err = xerrors.Errorf("failed to parse supporter (%v): %w", supporterErr, cerrors.ErrParseBytesFailed)
+			return
+		}
+		var supporter identity.ID
+		copy(supporter[:], supporterBytes)
+
+		if sequenceSupporters.supporters[supporter], err = markers.IndexFromMarshalUtil(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse supported Index from MarshalUtil: %w", err)
+			return
+		}
+	}
+
+	markerMessagesCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		err = xerrors.Errorf("failed to parse marker messages count (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	sequenceSupporters.markerMessages = make(map[markers.Index]MessageID, markerMessagesCount)
+	for i := uint32(0); i < markerMessagesCount; i++ {
+		index, indexErr := markers.IndexFromMarshalUtil(marshalUtil)
+		if indexErr != nil {
+			err = xerrors.Errorf("failed to parse marker Index from MarshalUtil: %w", indexErr)
+			return
+		}
+		if sequenceSupporters.markerMessages[index], err = MessageIDFromMarshalUtil(marshalUtil); err != nil {
+			err = xerrors.Errorf("failed to parse MessageID from MarshalUtil: %w", err)
+			return
+		}
+	}
+
+	return
+}
+
+// SequenceSupportersFromObjectStorage restores a SequenceSupporters that was stored in the object storage.
+func SequenceSupportersFromObjectStorage(key []byte, data []byte) (sequenceSupporters objectstorage.StorableObject, err error) {
+	if sequenceSupporters, _, err = SequenceSupportersFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = xerrors.Errorf("failed to parse SequenceSupporters from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// SequenceID returns the SequenceID of the Sequence that is tracked by the SequenceSupporters.
+func (s *SequenceSupporters) SequenceID() markers.SequenceID {
+	return s.sequenceID
+}
+
+// Supporters returns a copy of the highest Index that each supporter approved.
+func (s *SequenceSupporters) Supporters() (supporters map[identity.ID]markers.Index) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	supporters = make(map[identity.ID]markers.Index, len(s.supporters))
+	for supporter, index := range s.supporters {
+		supporters[supporter] = index
+	}
+
+	return
+}
+
+// AddSupport registers the supporter for the given Index. It returns false if the supporter already approved the same
+// or a higher Index.
+func (s *SequenceSupporters) AddSupport(supporter identity.ID, index markers.Index) (added bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if supportedIndex, supported := s.supporters[supporter]; supported && supportedIndex >= index {
+		return false
+	}
+	s.supporters[supporter] = index
+	s.SetModified()
+
+	return true
+}
+
+// ConfirmedIndex returns the highest confirmed Index of the Sequence and a flag that indicates if any Index was
+// confirmed at all.
+func (s *SequenceSupporters) ConfirmedIndex() (confirmedIndex markers.Index, confirmed bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.confirmedIndex, s.confirmed
+}
+
+// SetConfirmedIndex updates the highest confirmed Index of the Sequence. It returns false if the same or a higher
+// Index was confirmed already.
+func (s *SequenceSupporters) SetConfirmedIndex(confirmedIndex markers.Index) (modified bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.confirmed && s.confirmedIndex >= confirmedIndex {
+		return false
+	}
+	s.confirmedIndex = confirmedIndex
+	s.confirmed = true
+	s.SetModified()
+
+	return true
+}
+
+// AddMarkerMessage stores the MessageID of the Message that was assigned the Marker with the given Index, unless the
+// Index is confirmed already.
+func (s *SequenceSupporters) AddMarkerMessage(index markers.Index, messageID MessageID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.confirmed && index <= s.confirmedIndex {
+		return
+	}
+	s.markerMessages[index] = messageID
+	s.SetModified()
+}
+
+// PruneMarkerMessages removes the MessageIDs of all Markers up to the given Index and returns them.
+func (s *SequenceSupporters) PruneMarkerMessages(maxIndex markers.Index) (prunedMarkerMessages map[markers.Index]MessageID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	prunedMarkerMessages = make(map[markers.Index]MessageID)
+	for index, messageID := range s.markerMessages {
+		if index > maxIndex {
+			continue
+		}
+
+		prunedMarkerMessages[index] = messageID
+		delete(s.markerMessages, index)
+	}
+	if len(prunedMarkerMessages) != 0 {
+		s.SetModified()
+	}
+
+	return
+}
+
+// Bytes returns a marshaled version of the SequenceSupporters.
+func (s *SequenceSupporters) Bytes() []byte {
+	return byteutils.ConcatBytes(s.ObjectStorageKey(), s.ObjectStorageValue())
+}
+
+// String returns a human readable version of the SequenceSupporters.
+func (s *SequenceSupporters) String() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	supporters := stringify.StructBuilder("Supporters")
+	for supporter, index := range s.supporters {
+		supporters.AddField(stringify.StructField(supporter.String(), index))
+	}
+
+	return stringify.Struct("SequenceSupporters",
+		stringify.StructField("sequenceID", s.sequenceID),
+		stringify.StructField("supporters", supporters),
+		stringify.StructField("confirmed", s.confirmed),
+		stringify.StructField("confirmedIndex", s.confirmedIndex),
+		stringify.StructField("markerMessages", len(s.markerMessages)),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (s *SequenceSupporters) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (s *SequenceSupporters) ObjectStorageKey() []byte {
+	return s.sequenceID.Bytes()
+}
+
+// ObjectStorageValue marshals the SequenceSupporters into a sequence of bytes that are used as the value part in the
+// object storage.
+func (s *SequenceSupporters) ObjectStorageValue() []byte {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	marshalUtil := marshalutil.New()
+	marshalUtil.WriteBool(s.confirmed)
+	marshalUtil.Write(s.confirmedIndex)
+	marshalUtil.WriteUint32(uint32(len(s.supporters)))
+	for supporter, index := range s.supporters {
+		marshalUtil.WriteBytes(supporter.Bytes())
+		marshalUtil.Write(index)
+	}
+	marshalUtil.WriteUint32(uint32(len(s.markerMessages)))
+	for index, messageID := range s.markerMessages {
+		marshalUtil.Write(index)
+		marshalUtil.Write(messageID)
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// code contract (make sure the type implements all required methods)
+var _ objectstorage.StorableObject = &SequenceSupporters{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CachedSequenceSupporters /////////////////////////////////////////////////////////////////////////////////////
+
+// CachedSequenceSupporters is a wrapper for the generic CachedObject returned by the object storage that overrides the
+// accessor methods with a type-casted one.
+type CachedSequenceSupporters struct {
+	objectstorage.CachedObject
+}
+
+// Retain marks the CachedObject to still be in use by the program.
+func (c *CachedSequenceSupporters) Retain() *CachedSequenceSupporters {
+	return &CachedSequenceSupporters{c.CachedObject.Retain()}
+}
+
+// Unwrap is the type-casted equivalent of Get. It returns nil if the object does not exist.
+func (c *CachedSequenceSupporters) Unwrap() *SequenceSupporters {
+	untypedObject := c.Get()
+	if untypedObject == nil {
+		return nil
+	}
+
+	typedObject := untypedObject.(*SequenceSupporters)
+	if typedObject == nil || typedObject.IsDeleted() {
+		return nil
+	}
+
+	return typedObject
+}
+
+// Consume unwraps the CachedObject and passes a type-casted version to the consumer (if the object is not empty - it
+// exists). It automatically releases the object when the consumer finishes.
+func (c *CachedSequenceSupporters) Consume(consumer func(sequenceSupporters *SequenceSupporters), forceRelease ...bool) (consumed bool) {
+	return c.CachedObject.Consume(func(object objectstorage.StorableObject) {
+		consumer(object.(*SequenceSupporters))
+	}, forceRelease...)
+}
+
+// String returns a human readable version of the CachedSequenceSupporters.
+func (c *CachedSequenceSupporters) String() string {
+	return stringify.Struct("CachedSequenceSupporters",
+		stringify.StructField("CachedObject", c.Unwrap()),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ApprovalWeightManagerEvents //////////////////////////////////////////////////////////////////////////////////
+
+// ApprovalWeightManagerEvents represents events happening in the ApprovalWeightManager.
+type ApprovalWeightManagerEvents struct {
+	// MarkerConfirmed is triggered when the approval weight of a Marker exceeds the confirmation threshold.
+	MarkerConfirmed *events.Event
+
+	// MessageConfirmed is triggered when a Message is confirmed by a confirmed Marker in its future cone.
+	MessageConfirmed *events.Event
+
+	// BranchConfirmed is triggered when a Branch is confirmed by a confirmed Message.
+	BranchConfirmed *events.Event
+}
+
+func markerEventHandler(handler interface{}, params ...interface{}) {
+	handler.(func(*markers.Marker))(params[0].(*markers.Marker))
+}
+
+func branchIDEventHandler(handler interface{}, params ...interface{}) {
+	handler.(func(ledgerstate.BranchID))(params[0].(ledgerstate.BranchID))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
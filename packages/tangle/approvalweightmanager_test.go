@@ -0,0 +1,129 @@
+package tangle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovalWeightManager_ProcessMessage(t *testing.T) {
+	tangle := New(WithoutOpinionFormer(true))
+	defer tangle.Shutdown()
+	tangle.Booker.Setup()
+	tangle.ApprovalWeightManager.Setup()
+
+	issuers := map[string]ed25519.KeyPair{
+		"A": ed25519.GenerateKeyPair(),
+		"B": ed25519.GenerateKeyPair(),
+		"C": ed25519.GenerateKeyPair(),
+	}
+	tangle.ApprovalWeightManager.SetConsensusManaRetrieveFunc(func() map[identity.ID]float64 {
+		return map[identity.ID]float64{
+			identity.NewID(issuers["A"].PublicKey): 30,
+			identity.NewID(issuers["B"].PublicKey): 30,
+			identity.NewID(issuers["C"].PublicKey): 40,
+		}
+	})
+
+	confirmedMessages := make(map[MessageID]bool)
+	tangle.ApprovalWeightManager.Events.MessageConfirmed.Attach(events.NewClosure(func(messageID MessageID) {
+		confirmedMessages[messageID] = true
+	}))
+	confirmedMarkers := 0
+	tangle.ApprovalWeightManager.Events.MarkerConfirmed.Attach(events.NewClosure(func(marker *markers.Marker) {
+		confirmedMarkers++
+	}))
+
+	messages := make(map[string]*Message)
+	issueMessage := func(alias string, issuer string, parents ...MessageID) {
+		messages[alias] = NewMessage(parents, []MessageID{}, time.Now(), issuers[issuer].PublicKey, 0, payload.NewGenericDataPayload([]byte(alias)), 0, ed25519.Signature{})
+		tangle.Storage.StoreMessage(messages[alias])
+		require.NoError(t, tangle.Booker.Book(messages[alias].ID()))
+	}
+	markerOf := func(alias string) (marker *markers.Marker) {
+		tangle.Storage.MessageMetadata(messages[alias].ID()).Consume(func(messageMetadata *MessageMetadata) {
+			require.True(t, messageMetadata.StructureDetails().IsPastMarker)
+			marker = messageMetadata.StructureDetails().PastMarkers.FirstMarker()
+		})
+		return
+	}
+
+	// 30% of the mana is not enough to confirm a message
+	issueMessage("1", "A", EmptyMessageID)
+	assert.InDelta(t, 0.3, tangle.ApprovalWeightManager.Weight(markerOf("1")), 1e-9)
+	assert.False(t, tangle.ApprovalWeightManager.IsMarkerConfirmed(markerOf("1")))
+	assert.Empty(t, confirmedMessages)
+
+	// the approval of B raises the weight of message 1 to 60%
+	issueMessage("2", "B", messages["1"].ID())
+	assert.InDelta(t, 0.6, tangle.ApprovalWeightManager.Weight(markerOf("1")), 1e-9)
+	assert.True(t, tangle.ApprovalWeightManager.IsMarkerConfirmed(markerOf("1")))
+	assert.Equal(t, map[MessageID]bool{messages["1"].ID(): true}, confirmedMessages)
+	assert.Equal(t, 1, confirmedMarkers)
+
+	// C confirms message 2 (70%) but not its own message (40%)
+	issueMessage("3", "C", messages["2"].ID())
+	assert.True(t, tangle.ApprovalWeightManager.IsMarkerConfirmed(markerOf("2")))
+	assert.False(t, tangle.ApprovalWeightManager.IsMarkerConfirmed(markerOf("3")))
+	assert.Equal(t, map[MessageID]bool{messages["1"].ID(): true, messages["2"].ID(): true}, confirmedMessages)
+	assert.Equal(t, 2, confirmedMarkers)
+
+	for alias, confirmed := range map[string]bool{"1": true, "2": true, "3": false} {
+		tangle.Storage.MessageMetadata(messages[alias].ID()).Consume(func(messageMetadata *MessageMetadata) {
+			assert.Equal(t, confirmed, messageMetadata.IsConfirmed(), "message %s", alias)
+		})
+	}
+
+	// only the Messages of the Markers that are not confirmed, yet, are kept
+	assert.True(t, tangle.Storage.SequenceSupporters(markerOf("3").SequenceID()).Consume(func(sequenceSupporters *SequenceSupporters) {
+		assert.Equal(t, map[markers.Index]MessageID{markerOf("3").Index(): messages["3"].ID()}, sequenceSupporters.markerMessages)
+	}))
+}
+
+func TestSequenceSupporters_Bytes(t *testing.T) {
+	sequenceSupporters := NewSequenceSupporters(markers.SequenceID(7))
+	assert.True(t, sequenceSupporters.AddSupport(identity.GenerateIdentity().ID(), 3))
+	assert.True(t, sequenceSupporters.AddSupport(identity.GenerateIdentity().ID(), 5))
+	sequenceSupporters.AddMarkerMessage(4, randomMessageID())
+	sequenceSupporters.AddMarkerMessage(5, randomMessageID())
+	assert.True(t, sequenceSupporters.SetConfirmedIndex(3))
+	assert.False(t, sequenceSupporters.SetConfirmedIndex(2))
+
+	// the Messages of confirmed Markers are not stored
+	sequenceSupporters.AddMarkerMessage(2, randomMessageID())
+	assert.Len(t, sequenceSupporters.PruneMarkerMessages(4), 1)
+
+	restored, consumedBytes, err := SequenceSupportersFromBytes(sequenceSupporters.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, len(sequenceSupporters.Bytes()), consumedBytes)
+	assert.Equal(t, sequenceSupporters.SequenceID(), restored.SequenceID())
+	assert.Equal(t, sequenceSupporters.Supporters(), restored.Supporters())
+	assert.Equal(t, sequenceSupporters.markerMessages, restored.markerMessages)
+
+	confirmedIndex, confirmed := restored.ConfirmedIndex()
+	assert.True(t, confirmed)
+	assert.Equal(t, markers.Index(3), confirmedIndex)
+}
+
+func TestApprovalWeightManager_WithoutMana(t *testing.T) {
+	tangle := New(WithoutOpinionFormer(true))
+	defer tangle.Shutdown()
+	tangle.Booker.Setup()
+	tangle.ApprovalWeightManager.Setup()
+
+	message := newTestDataMessage("A")
+	tangle.Storage.StoreMessage(message)
+	require.NoError(t, tangle.Booker.Book(message.ID()))
+
+	tangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.False(t, messageMetadata.IsConfirmed())
+		assert.Equal(t, 0.0, tangle.ApprovalWeightManager.Weight(messageMetadata.StructureDetails().PastMarkers.FirstMarker()))
+	})
+}
@@ -60,7 +60,7 @@ func (b *Booker) Setup() {
 			b.tangle.Events.Error.Trigger(err)
 		}
 	}))
-	b.tangle.LedgerState.utxoDAG.Events.TransactionBranchIDUpdated.Attach(events.NewClosure(b.UpdateMessagesBranch))
+	b.tangle.LedgerState.UTXODAG.Events.TransactionBranchIDUpdated.Attach(events.NewClosure(b.UpdateMessagesBranch))
 }
 
 // UpdateMessagesBranch propagates the update of the message's branchID (and its future cone) in case on changes of it contained transction's branchID.
@@ -111,7 +111,7 @@ func (b *Booker) Book(messageID MessageID) (err error) {
 				}
 
 				for _, output := range transaction.Essence().Outputs() {
-					b.tangle.LedgerState.utxoDAG.StoreAddressOutputMapping(output.Address(), output.ID())
+					b.tangle.LedgerState.UTXODAG.StoreAddressOutputMappings(output)
 				}
 
 				attachment, stored := b.tangle.Storage.StoreAttachment(transaction.ID(), messageID)
@@ -144,7 +144,7 @@ func (b *Booker) branchIDOfPayload(message *Message) (branchIDOfPayload ledgerst
 		return
 	}
 	transactionID := payload.(*ledgerstate.Transaction).ID()
-	if !b.tangle.LedgerState.utxoDAG.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+	if !b.tangle.LedgerState.UTXODAG.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
 		branchIDOfPayload = transactionMetadata.BranchID()
 	}) {
 		panic(fmt.Sprintf("failed to load TransactionMetadata of %s: ", transactionID))
@@ -169,14 +169,14 @@ func (b *Booker) branchIDsOfParents(message *Message) (branchIDs ledgerstate.Bra
 	})
 
 	message.ForEachWeakParent(func(parentMessageID MessageID) {
-		if parentMessageID == EmptyMessageID {
+		if b.tangle.Storage.IsSolidEntryPoint(parentMessageID) {
 			return
 		}
 		if !b.tangle.Storage.Message(parentMessageID).Consume(func(message *Message) {
 			if payload := message.Payload(); payload != nil && payload.Type() == ledgerstate.TransactionType {
 				transactionID := payload.(*ledgerstate.Transaction).ID()
 
-				if !b.tangle.LedgerState.utxoDAG.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+				if !b.tangle.LedgerState.UTXODAG.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
 					branchIDs[transactionMetadata.BranchID()] = types.Void
 				}) {
 					panic(fmt.Errorf("failed to load TransactionMetadata with %s", transactionID))
@@ -12,8 +12,8 @@ import (
 // "single point of contact".
 type LedgerState struct {
 	tangle    *Tangle
-	branchDAG *ledgerstate.BranchDAG
-	utxoDAG   *ledgerstate.UTXODAG
+	BranchDAG *ledgerstate.BranchDAG
+	UTXODAG   *ledgerstate.UTXODAG
 }
 
 // NewLedgerState is the constructor of the LedgerState component.
@@ -21,15 +21,15 @@ func NewLedgerState(tangle *Tangle) (ledgerState *LedgerState) {
 	branchDAG := ledgerstate.NewBranchDAG(tangle.Options.Store)
 	return &LedgerState{
 		tangle:    tangle,
-		branchDAG: branchDAG,
-		utxoDAG:   ledgerstate.NewUTXODAG(tangle.Options.Store, branchDAG),
+		BranchDAG: branchDAG,
+		UTXODAG:   ledgerstate.NewUTXODAG(tangle.Options.Store, branchDAG),
 	}
 }
 
 // Shutdown shuts down the LedgerState and persists its state.
 func (l *LedgerState) Shutdown() {
-	l.utxoDAG.Shutdown()
-	l.branchDAG.Shutdown()
+	l.UTXODAG.Shutdown()
+	l.BranchDAG.Shutdown()
 }
 
 // InheritBranch implements the inheritance rules for Branches in the Tangle. It returns a single inherited Branch
@@ -40,12 +40,12 @@ func (l *LedgerState) InheritBranch(referencedBranchIDs ledgerstate.BranchIDs) (
 		return
 	}
 
-	branchIDsContainRejectedBranch, inheritedBranch := l.branchDAG.BranchIDsContainRejectedBranch(referencedBranchIDs)
+	branchIDsContainRejectedBranch, inheritedBranch := l.BranchDAG.BranchIDsContainRejectedBranch(referencedBranchIDs)
 	if branchIDsContainRejectedBranch {
 		return
 	}
 
-	cachedAggregatedBranch, _, err := l.branchDAG.AggregateBranches(referencedBranchIDs)
+	cachedAggregatedBranch, _, err := l.BranchDAG.AggregateBranches(referencedBranchIDs)
 	if err != nil {
 		if xerrors.Is(err, ledgerstate.ErrInvalidStateTransition) {
 			inheritedBranch = ledgerstate.InvalidBranchID
@@ -65,7 +65,7 @@ func (l *LedgerState) InheritBranch(referencedBranchIDs ledgerstate.BranchIDs) (
 // TransactionValid performs some fast checks of the Transaction and triggers a MessageInvalid event if the checks do
 // not pass.
 func (l *LedgerState) TransactionValid(transaction *ledgerstate.Transaction, messageID MessageID) (valid bool, err error) {
-	valid, err = l.utxoDAG.CheckTransaction(transaction)
+	valid, err = l.UTXODAG.CheckTransaction(transaction)
 	if err != nil {
 		l.tangle.Events.MessageInvalid.Trigger(messageID)
 	}
@@ -80,18 +80,18 @@ func (l *LedgerState) TransactionConflicting(transactionID ledgerstate.Transacti
 
 // TransactionMetadata retrieves the TransactionMetadata with the given TransactionID from the object storage.
 func (l *LedgerState) TransactionMetadata(transactionID ledgerstate.TransactionID) (cachedTransactionMetadata *ledgerstate.CachedTransactionMetadata) {
-	return l.utxoDAG.TransactionMetadata(transactionID)
+	return l.UTXODAG.TransactionMetadata(transactionID)
 }
 
 // Transaction retrieves the Transaction with the given TransactionID from the object storage.
 func (l *LedgerState) Transaction(transactionID ledgerstate.TransactionID) *ledgerstate.CachedTransaction {
-	return l.utxoDAG.Transaction(transactionID)
+	return l.UTXODAG.Transaction(transactionID)
 }
 
 // BookTransaction books the given Transaction into the underlying LedgerState and returns the target Branch and an
 // eventual error.
 func (l *LedgerState) BookTransaction(transaction *ledgerstate.Transaction, messageID MessageID) (targetBranch ledgerstate.BranchID, err error) {
-	targetBranch, err = l.utxoDAG.BookTransaction(transaction)
+	targetBranch, err = l.UTXODAG.BookTransaction(transaction)
 	if err != nil {
 		if !xerrors.Is(err, ledgerstate.ErrTransactionInvalid) && !xerrors.Is(err, ledgerstate.ErrTransactionNotSolid) {
 			err = xerrors.Errorf("failed to book Transaction: %w", err)
@@ -113,12 +113,12 @@ func (l *LedgerState) ConflictSet(transactionID ledgerstate.TransactionID) (conf
 	conflictIDs := make(ledgerstate.ConflictIDs)
 	conflictSet = make(ledgerstate.TransactionIDs)
 
-	l.branchDAG.Branch(ledgerstate.NewBranchID(transactionID)).Consume(func(branch ledgerstate.Branch) {
+	l.BranchDAG.Branch(ledgerstate.NewBranchID(transactionID)).Consume(func(branch ledgerstate.Branch) {
 		conflictIDs = branch.(*ledgerstate.ConflictBranch).Conflicts()
 	})
 
 	for conflictID := range conflictIDs {
-		l.branchDAG.ConflictMembers(conflictID).Consume(func(conflictMember *ledgerstate.ConflictMember) {
+		l.BranchDAG.ConflictMembers(conflictID).Consume(func(conflictMember *ledgerstate.ConflictMember) {
 			conflictSet[ledgerstate.TransactionID(conflictMember.BranchID())] = types.Void
 		})
 	}
@@ -129,13 +129,13 @@ func (l *LedgerState) ConflictSet(transactionID ledgerstate.TransactionID) (conf
 // TransactionInclusionState returns the InclusionState of the Transaction with the given TransactionID which can either be
 // Pending, Confirmed or Rejected.
 func (l *LedgerState) TransactionInclusionState(transactionID ledgerstate.TransactionID) (ledgerstate.InclusionState, error) {
-	return l.utxoDAG.InclusionState(transactionID)
+	return l.UTXODAG.InclusionState(transactionID)
 }
 
 // BranchInclusionState returns the InclusionState of the Branch with the given BranchID which can either be
 // Pending, Confirmed or Rejected.
 func (l *LedgerState) BranchInclusionState(branchID ledgerstate.BranchID) (inclusionState ledgerstate.InclusionState) {
-	l.branchDAG.Branch(branchID).Consume(func(branch ledgerstate.Branch) {
+	l.BranchDAG.Branch(branchID).Consume(func(branch ledgerstate.Branch) {
 		inclusionState = branch.InclusionState()
 	})
 	return
@@ -143,7 +143,7 @@ func (l *LedgerState) BranchInclusionState(branchID ledgerstate.BranchID) (inclu
 
 // BranchID returns the branchID of the given transactionID.
 func (l *LedgerState) BranchID(transactionID ledgerstate.TransactionID) (branchID ledgerstate.BranchID) {
-	l.utxoDAG.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+	l.UTXODAG.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
 		branchID = transactionMetadata.BranchID()
 	})
 	return
@@ -151,39 +151,72 @@ func (l *LedgerState) BranchID(transactionID ledgerstate.TransactionID) (branchI
 
 // Branch returns the branch with the given ID.
 func (l *LedgerState) Branch(branchID ledgerstate.BranchID) *ledgerstate.CachedBranch {
-	return l.branchDAG.Branch(branchID)
+	return l.BranchDAG.Branch(branchID)
 }
 
 // LoadSnapshot creates a set of outputs in the UTXO-DAG, that are forming the genesis for future transactions.
 func (l *LedgerState) LoadSnapshot(snapshot map[ledgerstate.TransactionID]map[ledgerstate.Address]*ledgerstate.ColoredBalances) {
-	l.utxoDAG.LoadSnapshot(snapshot)
+	l.UTXODAG.LoadSnapshot(snapshot)
 	attachment, _ := l.tangle.Storage.StoreAttachment(ledgerstate.GenesisTransactionID, EmptyMessageID)
 	if attachment != nil {
 		attachment.Release()
 	}
 }
 
+// LoadOutputs creates the given Outputs of a snapshot as confirmed Outputs in the UTXO-DAG. The Transactions that
+// created them are attached to the genesis, so that they are approved by every Message that spends their Outputs.
+func (l *LedgerState) LoadOutputs(outputs ledgerstate.Outputs) {
+	l.UTXODAG.LoadOutputs(outputs)
+
+	transactionIDs := make(ledgerstate.TransactionIDs)
+	for _, output := range outputs {
+		transactionIDs[output.ID().TransactionID()] = types.Void
+	}
+	for transactionID := range transactionIDs {
+		attachment, _ := l.tangle.Storage.StoreAttachment(transactionID, EmptyMessageID)
+		if attachment != nil {
+			attachment.Release()
+		}
+	}
+}
+
 // Output returns the Output with the given ID.
 func (l *LedgerState) Output(outputID ledgerstate.OutputID) *ledgerstate.CachedOutput {
-	return l.utxoDAG.Output(outputID)
+	return l.UTXODAG.Output(outputID)
 }
 
 // OutputMetadata returns the OutputMetadata with the given ID.
 func (l *LedgerState) OutputMetadata(outputID ledgerstate.OutputID) *ledgerstate.CachedOutputMetadata {
-	return l.utxoDAG.OutputMetadata(outputID)
+	return l.UTXODAG.OutputMetadata(outputID)
+}
+
+// Consumers returns the (cached) Consumers of the Output with the given ID.
+func (l *LedgerState) Consumers(outputID ledgerstate.OutputID) ledgerstate.CachedConsumers {
+	return l.UTXODAG.Consumers(outputID)
 }
 
 // OutputsOnAddress retrieves all the Outputs that are associated with an address.
 func (l *LedgerState) OutputsOnAddress(address ledgerstate.Address) (cachedOutputs ledgerstate.CachedOutputs) {
-	l.utxoDAG.AddressOutputMapping(address).Consume(func(addressOutputMapping *ledgerstate.AddressOutputMapping) {
+	l.UTXODAG.AddressOutputMapping(address).Consume(func(addressOutputMapping *ledgerstate.AddressOutputMapping) {
 		cachedOutputs = append(cachedOutputs, l.Output(addressOutputMapping.OutputID()))
 	})
 	return
 }
 
+// AddressHistory calls the consumer with the IDs of all Outputs that were ever created on the given Address ordered by
+// their creation time, starting after the given cursor (or at the beginning if the cursor is nil).
+func (l *LedgerState) AddressHistory(address ledgerstate.Address, cursor *ledgerstate.OutputID, consumer func(outputID ledgerstate.OutputID) bool) {
+	l.UTXODAG.AddressHistory(address, cursor, consumer)
+}
+
+// ColorSupply retrieves the ColorSupply of the given Color which keeps track of the minted and destroyed tokens.
+func (l *LedgerState) ColorSupply(color ledgerstate.Color) *ledgerstate.CachedColorSupply {
+	return l.UTXODAG.ColorSupply(color)
+}
+
 // CheckTransaction contains fast checks that have to be performed before booking a Transaction.
 func (l *LedgerState) CheckTransaction(transaction *ledgerstate.Transaction) (valid bool, err error) {
-	return l.utxoDAG.CheckTransaction(transaction)
+	return l.UTXODAG.CheckTransaction(transaction)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
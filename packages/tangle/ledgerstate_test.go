@@ -29,3 +29,31 @@ func TestLoadSnapshot(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, ledgerstate.Confirmed, inclusionState)
 }
+
+func TestLoadOutputs(t *testing.T) {
+	tangle := New()
+	defer tangle.Shutdown()
+
+	wallets := createWallets(2)
+	snapshotOutput := ledgerstate.NewSigLockedSingleOutput(100, wallets[0].address)
+	snapshotOutput.SetID(ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0))
+	tangle.LedgerState.LoadOutputs(ledgerstate.NewOutputs(snapshotOutput))
+
+	// a Message that spends an Output of the snapshot can be booked
+	transaction := makeTransaction(
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(snapshotOutput.ID())),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(100, wallets[1].address)),
+		nil, nil, wallets[0],
+	)
+	message := newTestParentsPayloadMessage(transaction, []MessageID{EmptyMessageID}, []MessageID{})
+	tangle.Storage.StoreMessage(message)
+	require.NoError(t, tangle.Booker.Book(message.ID()))
+
+	tangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.Equal(t, true, messageMetadata.IsBooked())
+		assert.Equal(t, false, messageMetadata.IsInvalid())
+	})
+	branchID, err := transactionBranchID(tangle, transaction.ID())
+	require.NoError(t, err)
+	assert.Equal(t, ledgerstate.MasterBranchID, branchID)
+}
@@ -631,9 +631,13 @@ type MessageMetadata struct {
 	branchID           ledgerstate.BranchID
 	timestampOpinion   TimestampOpinion
 	scheduled          bool
+	scheduledTime      time.Time
+	discarded          bool
 	booked             bool
 	eligible           bool
 	invalid            bool
+	confirmed          bool
+	confirmationTime   time.Time
 
 	solidMutex              sync.RWMutex
 	solidificationTimeMutex sync.RWMutex
@@ -641,9 +645,11 @@ type MessageMetadata struct {
 	branchIDMutex           sync.RWMutex
 	timestampOpinionMutex   sync.RWMutex
 	scheduledMutex          sync.RWMutex
+	discardedMutex          sync.RWMutex
 	bookedMutex             sync.RWMutex
 	eligibleMutex           sync.RWMutex
 	invalidMutex            sync.RWMutex
+	confirmedMutex          sync.RWMutex
 }
 
 // NewMessageMetadata creates a new MessageMetadata from the specified messageID.
@@ -699,6 +705,14 @@ func MessageMetadataFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (resul
 		err = fmt.Errorf("failed to parse scheduled flag of message metadata: %w", err)
 		return
 	}
+	if result.scheduledTime, err = marshalUtil.ReadTime(); err != nil {
+		err = fmt.Errorf("failed to parse scheduled time of message metadata: %w", err)
+		return
+	}
+	if result.discarded, err = marshalUtil.ReadBool(); err != nil {
+		err = fmt.Errorf("failed to parse discarded flag of message metadata: %w", err)
+		return
+	}
 	if result.booked, err = marshalUtil.ReadBool(); err != nil {
 		err = fmt.Errorf("failed to parse booked flag of message metadata: %w", err)
 		return
@@ -711,6 +725,14 @@ func MessageMetadataFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (resul
 		err = fmt.Errorf("failed to parse invalid flag of message metadata: %w", err)
 		return
 	}
+	if result.confirmed, err = marshalUtil.ReadBool(); err != nil {
+		err = fmt.Errorf("failed to parse confirmed flag of message metadata: %w", err)
+		return
+	}
+	if result.confirmationTime, err = marshalUtil.ReadTime(); err != nil {
+		err = fmt.Errorf("failed to parse confirmation time of message metadata: %w", err)
+		return
+	}
 
 	return
 }
@@ -844,6 +866,9 @@ func (m *MessageMetadata) SetScheduled(scheduled bool) (modified bool) {
 	}
 
 	m.scheduled = scheduled
+	if scheduled {
+		m.scheduledTime = clock.SyncedTime()
+	}
 	m.SetModified()
 	modified = true
 
@@ -858,6 +883,39 @@ func (m *MessageMetadata) Scheduled() (result bool) {
 	return m.scheduled
 }
 
+// ScheduledTime returns the time when the message represented by this metadata was scheduled.
+func (m *MessageMetadata) ScheduledTime() time.Time {
+	m.scheduledMutex.RLock()
+	defer m.scheduledMutex.RUnlock()
+
+	return m.scheduledTime
+}
+
+// SetDiscarded sets the message associated with this metadata as discarded by the Scheduler.
+// It returns true if the discarded status is modified. False otherwise.
+func (m *MessageMetadata) SetDiscarded(discarded bool) (modified bool) {
+	m.discardedMutex.Lock()
+	defer m.discardedMutex.Unlock()
+
+	if m.discarded == discarded {
+		return false
+	}
+
+	m.discarded = discarded
+	m.SetModified()
+	modified = true
+
+	return
+}
+
+// IsDiscarded returns true if the message represented by this metadata was discarded by the Scheduler. False otherwise.
+func (m *MessageMetadata) IsDiscarded() (result bool) {
+	m.discardedMutex.RLock()
+	defer m.discardedMutex.RUnlock()
+
+	return m.discarded
+}
+
 // SetBooked sets the message associated with this metadata as booked.
 // It returns true if the booked status is modified. False otherwise.
 func (m *MessageMetadata) SetBooked(booked bool) (modified bool) {
@@ -949,6 +1007,42 @@ func (m *MessageMetadata) SetInvalid(invalid bool) (modified bool) {
 	return
 }
 
+// IsConfirmed returns true if the message represented by this metadata is confirmed. False otherwise.
+func (m *MessageMetadata) IsConfirmed() (result bool) {
+	m.confirmedMutex.RLock()
+	defer m.confirmedMutex.RUnlock()
+
+	return m.confirmed
+}
+
+// SetConfirmed sets the message associated with this metadata as confirmed.
+// It returns true if the confirmed status is modified. False otherwise.
+func (m *MessageMetadata) SetConfirmed(confirmed bool) (modified bool) {
+	m.confirmedMutex.Lock()
+	defer m.confirmedMutex.Unlock()
+
+	if m.confirmed == confirmed {
+		return false
+	}
+
+	m.confirmed = confirmed
+	if confirmed {
+		m.confirmationTime = clock.SyncedTime()
+	}
+	m.SetModified()
+	modified = true
+
+	return
+}
+
+// ConfirmationTime returns the time when the message was marked to be confirmed.
+func (m *MessageMetadata) ConfirmationTime() time.Time {
+	m.confirmedMutex.RLock()
+	defer m.confirmedMutex.RUnlock()
+
+	return m.confirmationTime
+}
+
 // Bytes returns a marshaled version of the whole MessageMetadata object.
 func (m *MessageMetadata) Bytes() []byte {
 	return byteutils.ConcatBytes(m.ObjectStorageKey(), m.ObjectStorageValue())
@@ -971,9 +1065,13 @@ func (m *MessageMetadata) ObjectStorageValue() []byte {
 		Write(m.BranchID()).
 		WriteBytes(m.TimestampOpinion().Bytes()).
 		WriteBool(m.Scheduled()).
+		WriteTime(m.ScheduledTime()).
+		WriteBool(m.IsDiscarded()).
 		WriteBool(m.IsBooked()).
 		WriteBool(m.IsEligible()).
 		WriteBool(m.IsInvalid()).
+		WriteBool(m.IsConfirmed()).
+		WriteTime(m.ConfirmationTime()).
 		Bytes()
 }
 
@@ -994,9 +1092,13 @@ func (m *MessageMetadata) String() string {
 		stringify.StructField("branchID", m.BranchID()),
 		stringify.StructField("timestampOpinion", m.TimestampOpinion()),
 		stringify.StructField("scheduled", m.Scheduled()),
+		stringify.StructField("scheduledTime", m.ScheduledTime()),
+		stringify.StructField("discarded", m.IsDiscarded()),
 		stringify.StructField("booked", m.IsBooked()),
 		stringify.StructField("eligible", m.IsEligible()),
 		stringify.StructField("invalid", m.IsInvalid()),
+		stringify.StructField("confirmed", m.IsConfirmed()),
+		stringify.StructField("confirmationTime", m.ConfirmationTime()),
 	)
 }
 
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/markers"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 	"github.com/iotaledger/hive.go/bitmask"
 	"github.com/iotaledger/hive.go/cerrors"
@@ -1091,3 +1092,22 @@ func (w wl) publicKey() ed25519.PublicKey {
 func (w wl) sign(txEssence *ledgerstate.TransactionEssence) *ledgerstate.ED25519Signature {
 	return ledgerstate.NewED25519Signature(w.publicKey(), ed25519.Signature(w.privateKey().Sign(txEssence.Bytes())))
 }
+
+func TestMessageMetadata_Scheduled(t *testing.T) {
+	messageMetadata := NewMessageMetadata(EmptyMessageID)
+	messageMetadata.SetStructureDetails(&markers.StructureDetails{
+		PastMarkers:   markers.NewMarkers(),
+		FutureMarkers: markers.NewMarkers(),
+	})
+	assert.True(t, messageMetadata.ScheduledTime().IsZero())
+
+	assert.True(t, messageMetadata.SetScheduled(true))
+	assert.False(t, messageMetadata.SetScheduled(true))
+	assert.True(t, messageMetadata.Scheduled())
+	assert.False(t, messageMetadata.ScheduledTime().IsZero())
+
+	restoredMessageMetadata, _, err := MessageMetadataFromBytes(messageMetadata.Bytes())
+	require.NoError(t, err)
+	assert.True(t, restoredMessageMetadata.Scheduled())
+	assert.True(t, messageMetadata.ScheduledTime().Equal(restoredMessageMetadata.ScheduledTime()))
+}
@@ -1,10 +1,7 @@
 package tangle
 
 import (
-	"container/list"
-
 	"github.com/iotaledger/hive.go/kvstore"
-	"github.com/iotaledger/hive.go/types"
 )
 
 // OldTangle represents the base layer of messages.
@@ -24,32 +21,6 @@ func Old(store kvstore.KVStore) (result *OldTangle) {
 	return
 }
 
-// deletes a message and its future cone of messages/approvers.
-// nolint
-func (t *OldTangle) deleteFutureCone(messageID MessageID) {
-	cleanupStack := list.New()
-	cleanupStack.PushBack(messageID)
-
-	processedMessages := make(map[MessageID]types.Empty)
-	processedMessages[messageID] = types.Void
-
-	for cleanupStack.Len() >= 1 {
-		currentStackEntry := cleanupStack.Front()
-		currentMessageID := currentStackEntry.Value.(MessageID)
-		cleanupStack.Remove(currentStackEntry)
-
-		t.DeleteMessage(currentMessageID)
-
-		t.Approvers(currentMessageID).Consume(func(approver *Approver) {
-			approverID := approver.ApproverMessageID()
-			if _, messageProcessed := processedMessages[approverID]; !messageProcessed {
-				cleanupStack.PushBack(approverID)
-				processedMessages[approverID] = types.Void
-			}
-		})
-	}
-}
-
 // CheckParentsEligibility checks if the parents are eligible, then set the eligible flag of the message.
 // TODO: Eligibility related functions will be moved elsewhere.
 func (t *OldTangle) CheckParentsEligibility(cachedMessage *CachedMessage, cachedMsgMetadata *CachedMessageMetadata) {
@@ -0,0 +1,225 @@
+package tangle
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/types"
+)
+
+const (
+	// DefaultPruningInterval defines the default time between two consecutive pruning runs of the Pruner.
+	DefaultPruningInterval = 10 * time.Minute
+
+	// MinPruningRetention defines the smallest allowed retention of the Pruner. Messages within this window can still be
+	// referenced as parents by new Messages, so they must not be pruned.
+	MinPruningRetention = maxParentsTimeDifference
+)
+
+// region Pruner ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Pruner is a Tangle component that periodically removes old confirmed Messages from the database. A Message is only
+// pruned if it was confirmed, if it was issued before the pruning horizon and if all of its approvers are prunable as
+// well. The youngest candidates that are still referenced by unprunable Messages are kept and the pruned Messages they
+// reference become solid entry points, so that the remaining Tangle stays solid. Objects of the ledger state are never
+// pruned.
+type Pruner struct {
+	// Events contains the Pruner related events.
+	Events *PrunerEvents
+
+	tangle              *Tangle
+	retention           time.Duration
+	interval            time.Duration
+	horizon             time.Time
+	prunedMessagesCount uint64
+	mutex               sync.RWMutex
+	shutdownSignal      chan struct{}
+	shutdown            sync.WaitGroup
+	shutdownOnce        sync.Once
+}
+
+// NewPruner is the constructor of the Pruner. It starts the periodic pruning if a retention window was configured and
+// panics if the retention is shorter than the MinPruningRetention.
+func NewPruner(tangle *Tangle) (pruner *Pruner) {
+	if retention := tangle.Options.PrunerParams.Retention; retention > 0 && retention < MinPruningRetention {
+		panic(fmt.Sprintf("pruning retention (%s) must not be shorter than %s", retention, MinPruningRetention))
+	}
+
+	pruner = &Pruner{
+		Events: &PrunerEvents{
+			MessagePruned: events.NewEvent(messageIDEventHandler),
+		},
+
+		tangle:         tangle,
+		retention:      tangle.Options.PrunerParams.Retention,
+		interval:       tangle.Options.PrunerParams.Interval,
+		shutdownSignal: make(chan struct{}),
+	}
+
+	if pruner.interval <= 0 {
+		pruner.interval = DefaultPruningInterval
+	}
+
+	if pruner.retention > 0 {
+		pruner.run()
+	}
+
+	return
+}
+
+// Prune removes all confirmed Messages that were issued before the given horizon and that are only approved by other
+// prunable Messages. It returns the number of pruned Messages.
+func (p *Pruner) Prune(horizon time.Time) (prunedMessages int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	candidates := p.candidates(horizon)
+	prunableMessages := make(map[MessageID]types.Empty)
+	for messageID := range candidates {
+		if p.isPrunable(messageID, candidates) {
+			prunableMessages[messageID] = types.Void
+		}
+	}
+
+	// the pruned Messages that are still referenced by the remaining Messages become the new solid entry points
+	solidEntryPoints := make(MessageIDs, 0)
+	for messageID := range prunableMessages {
+		if !p.isPrunable(messageID, prunableMessages) {
+			solidEntryPoints = append(solidEntryPoints, messageID)
+		}
+	}
+
+	for messageID := range prunableMessages {
+		p.tangle.Storage.PruneMessage(messageID)
+		p.Events.MessagePruned.Trigger(messageID)
+	}
+	for _, solidEntryPoint := range solidEntryPoints {
+		p.tangle.Storage.StoreSolidEntryPoint(solidEntryPoint)
+	}
+
+	if horizon.After(p.horizon) {
+		p.horizon = horizon
+	}
+	p.prunedMessagesCount += uint64(len(prunableMessages))
+
+	return len(prunableMessages)
+}
+
+// Horizon returns the horizon of the latest pruning run (the zero time if the Pruner did not run, yet).
+func (p *Pruner) Horizon() time.Time {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.horizon
+}
+
+// PrunedMessagesCount returns the number of Messages that were pruned since the start of the node.
+func (p *Pruner) PrunedMessagesCount() uint64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.prunedMessagesCount
+}
+
+// Shutdown stops the periodic pruning and waits for a running pruning run to finish.
+func (p *Pruner) Shutdown() {
+	p.shutdownOnce.Do(func() {
+		close(p.shutdownSignal)
+	})
+
+	p.shutdown.Wait()
+}
+
+// run starts the background worker that prunes the Tangle in the configured interval.
+func (p *Pruner) run() {
+	p.shutdown.Add(1)
+	go func() {
+		defer p.shutdown.Done()
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.Prune(time.Now().Add(-p.retention))
+			case <-p.shutdownSignal:
+				return
+			}
+		}
+	}()
+}
+
+// candidates returns the MessageIDs of all confirmed Messages that were issued before the given horizon. The issuing
+// time is used as it is what new Messages are checked against when they reference their parents.
+func (p *Pruner) candidates(horizon time.Time) (candidates map[MessageID]types.Empty) {
+	candidates = make(map[MessageID]types.Empty)
+	p.tangle.Storage.messageMetadataStorage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		(&CachedMessageMetadata{CachedObject: cachedObject}).Consume(func(messageMetadata *MessageMetadata) {
+			if messageMetadata.ID() == EmptyMessageID || !messageMetadata.IsConfirmed() {
+				return
+			}
+
+			p.tangle.Storage.Message(messageMetadata.ID()).Consume(func(message *Message) {
+				if message.IssuingTime().Before(horizon) {
+					candidates[messageMetadata.ID()] = types.Void
+				}
+			})
+		})
+
+		return true
+	})
+
+	return
+}
+
+// isPrunable checks if the given Message is approved by other Messages and if all of them are candidates themselves.
+// Tips and the Messages at the pruning horizon are kept.
+func (p *Pruner) isPrunable(messageID MessageID, candidates map[MessageID]types.Empty) (prunable bool) {
+	cachedApprovers := p.tangle.Storage.Approvers(messageID)
+	defer cachedApprovers.Release()
+
+	if len(cachedApprovers) == 0 {
+		return false
+	}
+
+	for _, approver := range cachedApprovers.Unwrap() {
+		if approver == nil {
+			continue
+		}
+		if _, candidate := candidates[approver.ApproverMessageID()]; !candidate {
+			return false
+		}
+	}
+
+	return true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PrunerParams /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PrunerParams represents the parameters for the Pruner.
+type PrunerParams struct {
+	// Retention defines how long confirmed messages are kept in the database. A retention of 0 disables the pruning and
+	// it must otherwise not be shorter than the MinPruningRetention.
+	Retention time.Duration
+
+	// Interval defines the time between two consecutive pruning runs.
+	Interval time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PrunerEvents /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PrunerEvents represents events happening in the Pruner.
+type PrunerEvents struct {
+	// MessagePruned is triggered when a Message was removed from the database by the Pruner.
+	MessagePruned *events.Event
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,114 @@
+package tangle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruner_Prune(t *testing.T) {
+	tangle := New(WithoutOpinionFormer(true))
+	defer tangle.Shutdown()
+
+	prunedMessages := make(map[MessageID]bool)
+	tangle.Pruner.Events.MessagePruned.Attach(events.NewClosure(func(messageID MessageID) {
+		prunedMessages[messageID] = true
+	}))
+
+	// create a chain of messages where all but the last one are confirmed
+	messages := make([]*Message, 0)
+	parent := EmptyMessageID
+	for _, alias := range []string{"1", "2", "3", "4"} {
+		message := newTestParentsDataMessage(alias, []MessageID{parent}, []MessageID{})
+		tangle.Storage.StoreMessage(message)
+		messages = append(messages, message)
+		parent = message.ID()
+	}
+	for _, message := range messages[:3] {
+		tangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *MessageMetadata) {
+			messageMetadata.SetConfirmed(true)
+		})
+	}
+
+	// nothing was issued before the horizon
+	assert.Equal(t, 0, tangle.Pruner.Prune(time.Now().Add(-time.Hour)))
+
+	// message 3 is approved by the unconfirmed message 4 and needs to be kept
+	assert.Equal(t, 2, tangle.Pruner.Prune(time.Now().Add(time.Minute)))
+	assert.Equal(t, map[MessageID]bool{messages[0].ID(): true, messages[1].ID(): true}, prunedMessages)
+	assert.Equal(t, uint64(2), tangle.Pruner.PrunedMessagesCount())
+
+	for i, message := range messages {
+		assert.Equal(t, i >= 2, tangle.Storage.Message(message.ID()).Consume(func(*Message) {}))
+	}
+	assert.False(t, tangle.Storage.Approvers(EmptyMessageID).Consume(func(*Approver) {}))
+	assert.False(t, tangle.Storage.Approvers(messages[0].ID()).Consume(func(*Approver) {}))
+	assert.True(t, tangle.Storage.Approvers(messages[2].ID()).Consume(func(*Approver) {}))
+
+	// message 2 is still referenced by message 3 and becomes a solid entry point
+	assert.Equal(t, MessageIDs{messages[1].ID()}, tangle.Storage.SolidEntryPoints())
+	assert.True(t, tangle.Storage.MessageMetadata(messages[1].ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.True(t, messageMetadata.IsSolid())
+	}))
+
+	// the genesis is never pruned
+	assert.True(t, tangle.Storage.MessageMetadata(EmptyMessageID).Consume(func(*MessageMetadata) {}))
+}
+
+func TestPruner_MinRetention(t *testing.T) {
+	assert.Panics(t, func() {
+		New(PrunerConfig(PrunerParams{Retention: MinPruningRetention - time.Minute}))
+	})
+
+	tangle := New(PrunerConfig(PrunerParams{Retention: MinPruningRetention}))
+	tangle.Shutdown()
+}
+
+func TestPruner_PruneTransaction(t *testing.T) {
+	tangle := New(WithoutOpinionFormer(true))
+	defer tangle.Shutdown()
+
+	wallets := createWallets(3)
+	tangle.LedgerState.LoadSnapshot(map[ledgerstate.TransactionID]map[ledgerstate.Address]*ledgerstate.ColoredBalances{
+		ledgerstate.GenesisTransactionID: {
+			wallets[0].address: ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 100}),
+		},
+	})
+
+	// book a Message with a Transaction whose Output stays unspent
+	output := ledgerstate.NewSigLockedSingleOutput(100, wallets[1].address)
+	transaction := makeTransaction(ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))), ledgerstate.NewOutputs(output), nil, nil, wallets[0])
+	message := newTestParentsPayloadMessage(transaction, []MessageID{EmptyMessageID}, []MessageID{})
+	tangle.Storage.StoreMessage(message)
+	require.NoError(t, tangle.Booker.Book(message.ID()))
+
+	// the Message is approved by a confirmed and an unconfirmed Message, so that it is not a tip
+	confirmedApprover := newTestParentsDataMessage("confirmed", []MessageID{message.ID()}, []MessageID{})
+	tip := newTestParentsDataMessage("tip", []MessageID{confirmedApprover.ID()}, []MessageID{})
+	for _, approver := range []*Message{confirmedApprover, tip} {
+		tangle.Storage.StoreMessage(approver)
+		require.NoError(t, tangle.Booker.Book(approver.ID()))
+	}
+	for _, messageID := range []MessageID{message.ID(), confirmedApprover.ID()} {
+		tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+			messageMetadata.SetConfirmed(true)
+		})
+	}
+
+	assert.Equal(t, 1, tangle.Pruner.Prune(time.Now().Add(time.Minute)))
+	assert.Equal(t, MessageIDs{EmptyMessageID}, tangle.Storage.AttachmentMessageIDs(transaction.ID()))
+
+	// the Output of the pruned Transaction can still be spent
+	spendingTransaction := makeTransaction(ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(transaction.ID(), 0))), ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(100, wallets[2].address)), nil, nil, wallets[1])
+	spendingMessage := newTestParentsPayloadMessage(spendingTransaction, []MessageID{tip.ID()}, []MessageID{})
+	tangle.Storage.StoreMessage(spendingMessage)
+	require.NoError(t, tangle.Booker.Book(spendingMessage.ID()))
+	tangle.Storage.MessageMetadata(spendingMessage.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.True(t, messageMetadata.IsBooked())
+		assert.False(t, messageMetadata.IsInvalid())
+	})
+}
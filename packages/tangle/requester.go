@@ -9,20 +9,24 @@ import (
 
 const (
 	// DefaultRetryInterval defines the Default Retry Interval of the message requester.
-	DefaultRetryInterval = 10 * time.Second
+	DefaultRetryInterval = 2 * time.Second
+	// DefaultMaxRetryInterval defines the default upper bound of the exponentially growing retry interval.
+	DefaultMaxRetryInterval = 1 * time.Minute
 
 	// the maximum amount of requests before we abort
-	maxRequestThreshold = 500
+	maxRequestThreshold = 100
 )
 
 // RequesterOptions holds options for a message requester.
 type RequesterOptions struct {
-	retryInterval time.Duration
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
 }
 
 func newRequesterOptions(optionalOptions []RequesterOption) *RequesterOptions {
 	result := &RequesterOptions{
-		retryInterval: 10 * time.Second,
+		retryInterval:    DefaultRetryInterval,
+		maxRetryInterval: DefaultMaxRetryInterval,
 	}
 
 	for _, optionalOption := range optionalOptions {
@@ -42,9 +46,17 @@ func RetryInterval(interval time.Duration) RequesterOption {
 	}
 }
 
+// MaxRetryInterval creates an option which sets the upper bound of the exponentially growing retry interval.
+func MaxRetryInterval(interval time.Duration) RequesterOption {
+	return func(args *RequesterOptions) {
+		args.maxRetryInterval = interval
+	}
+}
+
 // region Requester /////////////////////////////////////////////////////////////////////////////////////////////
 
-// Requester takes care of requesting messages.
+// Requester takes care of requesting messages. Unanswered requests are repeated with an exponentially growing interval
+// and the number of the attempt is passed along, so that the network layer can ask a different neighbor every time.
 type Requester struct {
 	tangle            *Tangle
 	scheduledRequests map[MessageID]*time.Timer
@@ -64,7 +76,8 @@ func NewRequester(tangle *Tangle, optionalOptions ...RequesterOption) *Requester
 		scheduledRequests: make(map[MessageID]*time.Timer),
 		options:           newRequesterOptions(optionalOptions),
 		Events: &MessageRequesterEvents{
-			SendRequest: events.NewEvent(sendRequestEventHandler),
+			SendRequest:   events.NewEvent(sendRequestEventHandler),
+			RequestFailed: events.NewEvent(messageIDEventHandler),
 		},
 	}
 
@@ -73,7 +86,10 @@ func NewRequester(tangle *Tangle, optionalOptions ...RequesterOption) *Requester
 	defer requester.scheduledRequestsMutex.Unlock()
 
 	for _, id := range tangle.Storage.MissingMessages() {
-		requester.scheduledRequests[id] = time.AfterFunc(requester.options.retryInterval, requester.createReRequest(id, 0))
+		if tangle.Storage.IsSolidEntryPoint(id) {
+			continue
+		}
+		requester.scheduledRequests[id] = time.AfterFunc(requester.retryInterval(0), requester.createReRequest(id, 0))
 	}
 
 	return requester
@@ -87,6 +103,11 @@ func (r *Requester) Setup() {
 
 // StartRequest initiates a regular triggering of the StartRequest event until it has been stopped using StopRequest.
 func (r *Requester) StartRequest(id MessageID) {
+	// solid entry points are never requested as their past cone is not supposed to be known
+	if r.tangle.Storage.IsSolidEntryPoint(id) {
+		return
+	}
+
 	r.scheduledRequestsMutex.Lock()
 
 	// ignore already scheduled requests
@@ -96,9 +117,9 @@ func (r *Requester) StartRequest(id MessageID) {
 	}
 
 	// schedule the next request and trigger the event
-	r.scheduledRequests[id] = time.AfterFunc(r.options.retryInterval, r.createReRequest(id, 0))
+	r.scheduledRequests[id] = time.AfterFunc(r.retryInterval(0), r.createReRequest(id, 0))
 	r.scheduledRequestsMutex.Unlock()
-	r.Events.SendRequest.Trigger(&SendRequestEvent{ID: id})
+	r.Events.SendRequest.Trigger(&SendRequestEvent{ID: id, Count: 0})
 }
 
 // StopRequest stops requests for the given message to further happen.
@@ -113,27 +134,45 @@ func (r *Requester) StopRequest(id MessageID) {
 }
 
 func (r *Requester) reRequest(id MessageID, count int) {
-	r.Events.SendRequest.Trigger(&SendRequestEvent{ID: id})
+	// increase the request counter
+	count++
 
-	// as we schedule a request at most once per id we do not need to make the trigger and the re-schedule atomic
 	r.scheduledRequestsMutex.Lock()
-	defer r.scheduledRequestsMutex.Unlock()
 
-	// reschedule, if the request has not been stopped in the meantime
-	if _, exists := r.scheduledRequests[id]; exists {
-		// increase the request counter
-		count++
-
-		// if we have requested too often => stop the requests
-		if count > maxRequestThreshold {
-			delete(r.scheduledRequests, id)
+	// ignore requests that have been stopped in the meantime
+	if _, exists := r.scheduledRequests[id]; !exists {
+		r.scheduledRequestsMutex.Unlock()
+		return
+	}
 
-			return
-		}
+	// if we have requested too often => stop the requests
+	if count >= maxRequestThreshold {
+		delete(r.scheduledRequests, id)
+		r.scheduledRequestsMutex.Unlock()
 
-		r.scheduledRequests[id] = time.AfterFunc(r.options.retryInterval, r.createReRequest(id, count))
+		r.Events.RequestFailed.Trigger(id)
 		return
 	}
+
+	// as we schedule a request at most once per id we do not need to make the trigger and the re-schedule atomic
+	r.scheduledRequests[id] = time.AfterFunc(r.retryInterval(count), r.createReRequest(id, count))
+	r.scheduledRequestsMutex.Unlock()
+
+	r.Events.SendRequest.Trigger(&SendRequestEvent{ID: id, Count: count})
+}
+
+// retryInterval returns the time to wait for an answer to the request with the given count. The interval doubles with
+// every attempt until it reaches the configured maximum.
+func (r *Requester) retryInterval(count int) time.Duration {
+	interval := r.options.retryInterval
+	for i := 0; i < count && interval < r.options.maxRetryInterval; i++ {
+		interval *= 2
+	}
+	if interval > r.options.maxRetryInterval {
+		return r.options.maxRetryInterval
+	}
+
+	return interval
 }
 
 // RequestQueueSize returns the number of scheduled message requests.
@@ -155,6 +194,9 @@ func (r *Requester) createReRequest(msgID MessageID, count int) func() {
 type MessageRequesterEvents struct {
 	// Fired when a request for a given message should be sent.
 	SendRequest *events.Event
+
+	// Fired when a message could not be retrieved and the requests for it were given up.
+	RequestFailed *events.Event
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -163,7 +205,10 @@ type MessageRequesterEvents struct {
 
 // SendRequestEvent represents the parameters of sendRequestEventHandler
 type SendRequestEvent struct {
+	// ID is the ID of the requested message.
 	ID MessageID
+	// Count is the number of previous requests for the same message that have not been answered.
+	Count int
 }
 
 func sendRequestEventHandler(handler interface{}, params ...interface{}) {
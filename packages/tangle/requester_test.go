@@ -0,0 +1,90 @@
+package tangle
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequester_RetryInterval(t *testing.T) {
+	tangle := New()
+	defer tangle.Shutdown()
+
+	requester := NewRequester(tangle, RetryInterval(time.Second), MaxRetryInterval(10*time.Second))
+	assert.Equal(t, 1*time.Second, requester.retryInterval(0))
+	assert.Equal(t, 2*time.Second, requester.retryInterval(1))
+	assert.Equal(t, 8*time.Second, requester.retryInterval(3))
+	assert.Equal(t, 10*time.Second, requester.retryInterval(4))
+	assert.Equal(t, 10*time.Second, requester.retryInterval(maxRequestThreshold))
+}
+
+func TestRequester_StopRequest(t *testing.T) {
+	tangle := New()
+	defer tangle.Shutdown()
+
+	requester := NewRequester(tangle, RetryInterval(10*time.Millisecond), MaxRetryInterval(10*time.Millisecond))
+
+	var mutex sync.Mutex
+	var counts []int
+	requester.Events.SendRequest.Attach(events.NewClosure(func(event *SendRequestEvent) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		counts = append(counts, event.Count)
+	}))
+
+	id := randomMessageID()
+	requester.StartRequest(id)
+	requester.StartRequest(id)
+	assert.Equal(t, 1, requester.RequestQueueSize())
+
+	assert.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(counts) >= 3
+	}, time.Second, time.Millisecond)
+
+	requester.StopRequest(id)
+	assert.Zero(t, requester.RequestQueueSize())
+
+	// every request carries the number of the previous attempts
+	mutex.Lock()
+	defer mutex.Unlock()
+	for i, count := range counts {
+		assert.Equal(t, i, count)
+	}
+}
+
+func TestRequester_RequestFailed(t *testing.T) {
+	tangle := New()
+	defer tangle.Shutdown()
+
+	requester := NewRequester(tangle, RetryInterval(time.Millisecond), MaxRetryInterval(time.Millisecond))
+
+	var mutex sync.Mutex
+	var requestCount int
+	requester.Events.SendRequest.Attach(events.NewClosure(func(*SendRequestEvent) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requestCount++
+	}))
+	failed := make(chan MessageID, 1)
+	requester.Events.RequestFailed.Attach(events.NewClosure(func(id MessageID) { failed <- id }))
+
+	id := randomMessageID()
+	requester.StartRequest(id)
+
+	select {
+	case failedID := <-failed:
+		assert.Equal(t, id, failedID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("request did not fail")
+	}
+
+	assert.Zero(t, requester.RequestQueueSize())
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, maxRequestThreshold, requestCount)
+}
@@ -1,24 +1,43 @@
 package tangle
 
 import (
+	"container/list"
+	"math"
 	"sync"
+	"time"
 
 	"github.com/iotaledger/hive.go/datastructure/set"
 	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
 )
 
 const (
-	inboxCapacity = 64
+	// DefaultMaxBufferSize defines the default maximum number of messages that are buffered by the Scheduler.
+	DefaultMaxBufferSize = 10000
+
+	// minIssuerWeight defines the weight that is used for issuers without any access mana, so that they are not
+	// starved completely if the network is not congested.
+	minIssuerWeight = 1.0
 )
 
 // region Scheduler ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// Scheduler is a Tangle component that takes care of scheduling the messages that shall be booked.
+// AccessManaRetrieveFunc is a function type to retrieve the access mana of a node.
+type AccessManaRetrieveFunc func(nodeID identity.ID) float64
+
+// Scheduler is a Tangle component that takes care of scheduling the messages that shall be booked. It keeps a queue per
+// issuer and selects the next message in a deficit round robin fashion, where the quantum of each issuer is proportional
+// to its access mana.
 type Scheduler struct {
 	Events *SchedulerEvents
 
 	tangle                 *Tangle
-	inbox                  chan MessageID
+	rate                   time.Duration
+	maxBufferSize          int
+	accessManaRetrieveFunc AccessManaRetrieveFunc
+	buffer                 *schedulerBuffer
+	bufferMutex            sync.Mutex
+	wakeup                 chan struct{}
 	scheduledMessages      set.Set
 	allMessagesScheduledWG sync.WaitGroup
 	shutdownSignal         chan struct{}
@@ -31,12 +50,17 @@ func NewScheduler(tangle *Tangle) (scheduler *Scheduler) {
 	scheduler = &Scheduler{
 		Events: &SchedulerEvents{
 			MessageScheduled: events.NewEvent(messageIDEventHandler),
+			MessageDiscarded: events.NewEvent(messageIDEventHandler),
 		},
 
-		tangle:            tangle,
-		inbox:             make(chan MessageID, inboxCapacity),
-		shutdownSignal:    make(chan struct{}),
-		scheduledMessages: set.New(true),
+		tangle:                 tangle,
+		rate:                   tangle.Options.SchedulerParams.Rate,
+		maxBufferSize:          tangle.Options.SchedulerParams.MaxBufferSize,
+		accessManaRetrieveFunc: tangle.Options.SchedulerParams.AccessManaRetrieveFunc,
+		buffer:                 newSchedulerBuffer(),
+		wakeup:                 make(chan struct{}, 1),
+		shutdownSignal:         make(chan struct{}),
+		scheduledMessages:      set.New(true),
 	}
 	scheduler.run()
 
@@ -60,9 +84,52 @@ func (s *Scheduler) Setup() {
 	}))
 }
 
-// Schedule schedules the given messageID.
+// SetAccessManaRetrieveFunc sets the function that is used to retrieve the access mana of the issuers. Without it, all
+// issuers are weighted equally.
+func (s *Scheduler) SetAccessManaRetrieveFunc(accessManaRetrieveFunc AccessManaRetrieveFunc) {
+	s.bufferMutex.Lock()
+	defer s.bufferMutex.Unlock()
+
+	s.accessManaRetrieveFunc = accessManaRetrieveFunc
+}
+
+// Schedule adds the given messageID to the queue of its issuer. If the buffer is full, the oldest message of the issuer
+// with the largest mana-scaled queue gets discarded.
 func (s *Scheduler) Schedule(messageID MessageID) {
-	s.inbox <- messageID
+	var issuerID identity.ID
+	var size int
+	if !s.tangle.Storage.Message(messageID).Consume(func(message *Message) {
+		issuerID = identity.NewID(message.IssuerPublicKey())
+		size = len(message.Bytes())
+	}) {
+		return
+	}
+
+	s.bufferMutex.Lock()
+	s.buffer.push(issuerID, messageID, size)
+	var discardedMessageID MessageID
+	discarded := false
+	if s.maxBufferSize > 0 && s.buffer.size() > s.maxBufferSize {
+		discardedMessageID, discarded = s.buffer.dropHeaviest(s.issuerWeight)
+	}
+	s.bufferMutex.Unlock()
+
+	if discarded {
+		s.discard(discardedMessageID)
+	}
+
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// BufferSize returns the number of messages that are waiting to be scheduled.
+func (s *Scheduler) BufferSize() int {
+	s.bufferMutex.Lock()
+	defer s.bufferMutex.Unlock()
+
+	return s.buffer.size()
 }
 
 // Shutdown shuts down the Scheduler and persists its state.
@@ -80,24 +147,51 @@ func (s *Scheduler) run() {
 	go func() {
 		defer s.shutdown.Done()
 
+		var ticker <-chan time.Time
+		if s.rate > 0 {
+			rateTicker := time.NewTicker(s.rate)
+			defer rateTicker.Stop()
+			ticker = rateTicker.C
+		}
+
 		for {
 			select {
-			case messageID := <-s.inbox:
-				s.scheduleMessage(messageID)
+			case <-ticker:
+				s.scheduleNext()
+			case <-s.wakeup:
+				// without a rate limit, everything that is ready gets scheduled right away
+				if s.rate == 0 {
+					for s.scheduleNext() {
+					}
+				}
 			case <-s.shutdownSignal:
-				if len(s.inbox) == 0 {
-					return
+				// schedule the remaining messages that are ready before shutting down
+				for s.scheduleNext() {
 				}
+				return
 			}
 		}
 	}()
 }
 
-func (s *Scheduler) scheduleMessage(messageID MessageID) {
-	if !s.parentsBooked(messageID) {
-		return
+// scheduleNext selects the next message and schedules it. It returns false if no message was ready to be scheduled.
+func (s *Scheduler) scheduleNext() bool {
+	s.bufferMutex.Lock()
+	messageID, selected, discardedMessageIDs := s.buffer.selectNext(s.issuerWeight, s.messageReadiness)
+	s.bufferMutex.Unlock()
+
+	for _, discardedMessageID := range discardedMessageIDs {
+		s.discard(discardedMessageID)
+	}
+
+	if selected {
+		s.scheduleMessage(messageID)
 	}
 
+	return selected || len(discardedMessageIDs) > 0
+}
+
+func (s *Scheduler) scheduleMessage(messageID MessageID) {
 	s.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
 		if messageMetadata.SetScheduled(true) {
 			if s.scheduledMessages.Add(messageID) {
@@ -108,21 +202,49 @@ func (s *Scheduler) scheduleMessage(messageID MessageID) {
 	})
 }
 
-func (s *Scheduler) parentsBooked(messageID MessageID) (parentsBooked bool) {
+// discard drops the given message from the Scheduler and triggers the MessageDiscarded event. The message is not
+// marked as invalid, as it was only dropped because of congestion, but its children get discarded as well as they can
+// never be scheduled. The discarded flag is persisted in the MessageMetadata, so that the children are discarded no
+// matter how long they wait in the buffer.
+func (s *Scheduler) discard(messageID MessageID) {
+	s.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		messageMetadata.SetDiscarded(true)
+	})
+
+	s.Events.MessageDiscarded.Trigger(messageID)
+}
+
+// issuerWeight returns the weight of the given issuer, which is its access mana but at least minIssuerWeight.
+func (s *Scheduler) issuerWeight(issuerID identity.ID) float64 {
+	if s.accessManaRetrieveFunc == nil {
+		return minIssuerWeight
+	}
+
+	return math.Max(s.accessManaRetrieveFunc(issuerID), minIssuerWeight)
+}
+
+// messageReadiness checks whether the given message can be scheduled, i.e. all of its parents are booked. A message
+// whose parents are invalid, discarded or were scheduled without being booked can never be scheduled.
+func (s *Scheduler) messageReadiness(messageID MessageID) (readiness messageReadiness) {
+	readiness = messageReady
 	s.tangle.Storage.Message(messageID).Consume(func(message *Message) {
-		parentsBooked = true
 		message.ForEachParent(func(parent Parent) {
-			if !parentsBooked || parent.ID == EmptyMessageID {
+			if readiness == messageUnschedulable || parent.ID == EmptyMessageID {
 				return
 			}
 
 			if !s.tangle.Storage.MessageMetadata(parent.ID).Consume(func(messageMetadata *MessageMetadata) {
-				parentsBooked = messageMetadata.IsBooked()
+				switch {
+				case messageMetadata.IsBooked():
+				case messageMetadata.IsInvalid() || messageMetadata.IsDiscarded() || messageMetadata.Scheduled():
+					readiness = messageUnschedulable
+				default:
+					readiness = messageWaiting
+				}
 			}) {
-				parentsBooked = false
+				readiness = messageWaiting
 			}
 		})
-
 	})
 
 	return
@@ -130,12 +252,229 @@ func (s *Scheduler) parentsBooked(messageID MessageID) (parentsBooked bool) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region SchedulerParams //////////////////////////////////////////////////////////////////////////////////////////////
+
+// SchedulerParams defines the parameters of the Scheduler.
+type SchedulerParams struct {
+	// Rate defines the minimum time between two scheduled messages. A rate of 0 disables the rate limit.
+	Rate time.Duration
+
+	// MaxBufferSize defines the maximum number of messages that are buffered. A size of 0 disables the limit.
+	MaxBufferSize int
+
+	// AccessManaRetrieveFunc is used to weight the issuers by their access mana.
+	AccessManaRetrieveFunc AccessManaRetrieveFunc
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region schedulerBuffer //////////////////////////////////////////////////////////////////////////////////////////////
+
+// messageReadiness represents whether a buffered message can be scheduled.
+type messageReadiness uint8
+
+const (
+	// messageReady is used for messages whose parents are all booked.
+	messageReady messageReadiness = iota
+
+	// messageWaiting is used for messages whose parents are not booked yet.
+	messageWaiting
+
+	// messageUnschedulable is used for messages that have a parent that will never be booked.
+	messageUnschedulable
+)
+
+// bufferedMessage is a message that is waiting in the queue of its issuer.
+type bufferedMessage struct {
+	id   MessageID
+	size int
+}
+
+// issuerQueue is the queue of the buffered messages of a single issuer.
+type issuerQueue struct {
+	issuerID identity.ID
+	messages *list.List
+	bytes    int
+	deficit  float64
+}
+
+// schedulerBuffer holds the issuerQueues of the Scheduler in the order in which they are visited by the deficit round
+// robin.
+type schedulerBuffer struct {
+	queues       *list.List
+	queuesByID   map[identity.ID]*list.Element
+	current      *list.Element
+	messageCount int
+}
+
+// newSchedulerBuffer creates an empty schedulerBuffer.
+func newSchedulerBuffer() *schedulerBuffer {
+	return &schedulerBuffer{
+		queues:     list.New(),
+		queuesByID: make(map[identity.ID]*list.Element),
+	}
+}
+
+// size returns the number of buffered messages.
+func (b *schedulerBuffer) size() int {
+	return b.messageCount
+}
+
+// push adds a message to the queue of its issuer.
+func (b *schedulerBuffer) push(issuerID identity.ID, messageID MessageID, size int) {
+	element, exists := b.queuesByID[issuerID]
+	if !exists {
+		element = b.queues.PushBack(&issuerQueue{issuerID: issuerID, messages: list.New()})
+		b.queuesByID[issuerID] = element
+		if b.current == nil {
+			b.current = element
+		}
+	}
+
+	queue := element.Value.(*issuerQueue)
+	queue.messages.PushBack(&bufferedMessage{id: messageID, size: size})
+	queue.bytes += size
+	b.messageCount++
+}
+
+// popFront removes the oldest message of the given queue and removes the queue if it becomes empty.
+func (b *schedulerBuffer) popFront(element *list.Element) *bufferedMessage {
+	queue := element.Value.(*issuerQueue)
+	message := queue.messages.Remove(queue.messages.Front()).(*bufferedMessage)
+	queue.bytes -= message.size
+	b.messageCount--
+
+	if queue.messages.Len() == 0 {
+		if b.current == element {
+			b.current = b.next(element)
+		}
+		b.queues.Remove(element)
+		delete(b.queuesByID, queue.issuerID)
+	}
+
+	return message
+}
+
+// next returns the queue after the given one in the round robin order or nil if it is the only one.
+func (b *schedulerBuffer) next(element *list.Element) *list.Element {
+	next := element.Next()
+	if next == nil {
+		next = b.queues.Front()
+	}
+	if next == element {
+		return nil
+	}
+
+	return next
+}
+
+// dropHeaviest removes the oldest message of the issuer with the largest queue relative to its weight.
+func (b *schedulerBuffer) dropHeaviest(issuerWeight func(identity.ID) float64) (messageID MessageID, dropped bool) {
+	var heaviest *list.Element
+	var heaviestLoad float64
+	for element := b.queues.Front(); element != nil; element = element.Next() {
+		queue := element.Value.(*issuerQueue)
+		if load := float64(queue.bytes) / issuerWeight(queue.issuerID); heaviest == nil || load > heaviestLoad {
+			heaviest = element
+			heaviestLoad = load
+		}
+	}
+	if heaviest == nil {
+		return
+	}
+
+	return b.popFront(heaviest).id, true
+}
+
+// selectNext selects the next message that shall be scheduled according to the deficit round robin. Instead of
+// visiting the queues round after round until the deficit of one of them is large enough, it directly advances the
+// deficits by the number of rounds that are required by the first queue to send its oldest message. Messages that can
+// never be scheduled are removed from the buffer and returned as discarded.
+func (b *schedulerBuffer) selectNext(issuerWeight func(identity.ID) float64, readiness func(MessageID) messageReadiness) (messageID MessageID, selected bool, discarded []MessageID) {
+	if b.current == nil {
+		return
+	}
+
+	// visit the queues in round robin order starting with the current one
+	visitOrder := make([]*list.Element, 0, b.queues.Len())
+	for element := b.current; element != nil && len(visitOrder) < b.queues.Len(); element = element.Next() {
+		visitOrder = append(visitOrder, element)
+	}
+	for element := b.queues.Front(); element != b.current; element = element.Next() {
+		visitOrder = append(visitOrder, element)
+	}
+
+	readyQueues := make([]*list.Element, 0, len(visitOrder))
+	quanta := make([]float64, 0, len(visitOrder))
+	maxWeight := 0.0
+	for _, element := range visitOrder {
+		queue := element.Value.(*issuerQueue)
+		for queue.messages.Len() > 0 {
+			switch readiness(queue.messages.Front().Value.(*bufferedMessage).id) {
+			case messageUnschedulable:
+				discarded = append(discarded, b.popFront(element).id)
+				continue
+			case messageReady:
+				weight := issuerWeight(queue.issuerID)
+				maxWeight = math.Max(maxWeight, weight)
+				readyQueues = append(readyQueues, element)
+				quanta = append(quanta, weight)
+			}
+			break
+		}
+	}
+
+	if len(readyQueues) == 0 {
+		return
+	}
+
+	// the issuer with the highest weight receives a quantum of one maximum sized message per round
+	rounds := math.Inf(1)
+	winner := 0
+	for i, element := range readyQueues {
+		quanta[i] = quanta[i] / maxWeight * MaxMessageSize
+		queue := element.Value.(*issuerQueue)
+		missing := float64(queue.messages.Front().Value.(*bufferedMessage).size) - queue.deficit
+		if requiredRounds := math.Max(0, math.Ceil(missing/quanta[i])); requiredRounds < rounds {
+			rounds = requiredRounds
+			winner = i
+		}
+	}
+
+	for i, element := range readyQueues {
+		element.Value.(*issuerQueue).deficit += rounds * quanta[i]
+	}
+
+	winnerElement := readyQueues[winner]
+	winnerQueue := winnerElement.Value.(*issuerQueue)
+	b.current = winnerElement
+	message := b.popFront(winnerElement)
+	winnerQueue.deficit -= float64(message.size)
+
+	// move on to the next issuer if the deficit does not allow to send the next message
+	if winnerQueue.messages.Len() == 0 {
+		winnerQueue.deficit = 0
+	} else if winnerQueue.deficit < float64(winnerQueue.messages.Front().Value.(*bufferedMessage).size) {
+		b.current = b.next(winnerElement)
+		if b.current == nil {
+			b.current = winnerElement
+		}
+	}
+
+	return message.id, true, discarded
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region SchedulerEvents /////////////////////////////////////////////////////////////////////////////////////////////
 
 // SchedulerEvents represents events happening in the Scheduler.
 type SchedulerEvents struct {
 	// MessageScheduled is triggered when a message is ready to be scheduled.
 	MessageScheduled *events.Event
+
+	// MessageDiscarded is triggered when a message is removed from the buffer without being scheduled.
+	MessageDiscarded *events.Event
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestScheduler(t *testing.T) {
@@ -56,3 +58,125 @@ func TestScheduler(t *testing.T) {
 		return allMessagedScheduled
 	}, 10*time.Second, 100*time.Millisecond)
 }
+
+func TestScheduler_Discard(t *testing.T) {
+	tangle := New()
+	defer tangle.Shutdown()
+
+	parent := newTestDataMessage("parent")
+	child := newTestParentsDataMessage("child", []MessageID{parent.ID()}, []MessageID{})
+	tangle.Storage.StoreMessage(parent)
+	tangle.Storage.StoreMessage(child)
+
+	var discarded []MessageID
+	tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(func(messageID MessageID) {
+		discarded = append(discarded, messageID)
+	}))
+	assert.Equal(t, messageWaiting, tangle.Scheduler.messageReadiness(child.ID()))
+
+	tangle.Scheduler.discard(parent.ID())
+	assert.Equal(t, []MessageID{parent.ID()}, discarded)
+
+	// messages dropped because of congestion are not invalid, but their children can never be scheduled
+	tangle.Storage.MessageMetadata(parent.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.False(t, messageMetadata.IsInvalid())
+		assert.True(t, messageMetadata.IsDiscarded())
+	})
+	assert.Equal(t, messageUnschedulable, tangle.Scheduler.messageReadiness(child.ID()))
+}
+
+func TestSchedulerBuffer_SelectNext(t *testing.T) {
+	issuerA := identity.GenerateIdentity().ID()
+	issuerB := identity.GenerateIdentity().ID()
+	weights := map[identity.ID]float64{issuerA: 300, issuerB: 100}
+	issuerWeight := func(issuerID identity.ID) float64 {
+		return weights[issuerID]
+	}
+	ready := func(MessageID) messageReadiness {
+		return messageReady
+	}
+
+	buffer := newSchedulerBuffer()
+	issuers := make(map[MessageID]identity.ID)
+	for i := 0; i < 8; i++ {
+		for _, issuerID := range []identity.ID{issuerA, issuerB} {
+			messageID := randomMessageID()
+			issuers[messageID] = issuerID
+			buffer.push(issuerID, messageID, MaxMessageSize/2)
+		}
+	}
+	assert.Equal(t, 16, buffer.size())
+
+	scheduled := make(map[identity.ID]int)
+	for i := 0; i < 8; i++ {
+		messageID, selected, discarded := buffer.selectNext(issuerWeight, ready)
+		require.True(t, selected)
+		assert.Empty(t, discarded)
+		scheduled[issuers[messageID]]++
+	}
+	assert.Equal(t, map[identity.ID]int{issuerA: 6, issuerB: 2}, scheduled)
+
+	for buffer.size() > 0 {
+		_, selected, _ := buffer.selectNext(issuerWeight, ready)
+		require.True(t, selected)
+	}
+	_, selected, _ := buffer.selectNext(issuerWeight, ready)
+	assert.False(t, selected)
+}
+
+func TestSchedulerBuffer_Readiness(t *testing.T) {
+	issuerA := identity.GenerateIdentity().ID()
+	issuerB := identity.GenerateIdentity().ID()
+	issuerWeight := func(identity.ID) float64 {
+		return 1
+	}
+
+	waiting, unschedulable, ready := randomMessageID(), randomMessageID(), randomMessageID()
+	readiness := func(messageID MessageID) messageReadiness {
+		switch messageID {
+		case waiting:
+			return messageWaiting
+		case unschedulable:
+			return messageUnschedulable
+		default:
+			return messageReady
+		}
+	}
+
+	buffer := newSchedulerBuffer()
+	buffer.push(issuerA, waiting, 100)
+	buffer.push(issuerB, unschedulable, 100)
+	buffer.push(issuerB, ready, 100)
+
+	messageID, selected, discarded := buffer.selectNext(issuerWeight, readiness)
+	require.True(t, selected)
+	assert.Equal(t, ready, messageID)
+	assert.Equal(t, []MessageID{unschedulable}, discarded)
+
+	_, selected, discarded = buffer.selectNext(issuerWeight, readiness)
+	assert.False(t, selected)
+	assert.Empty(t, discarded)
+	assert.Equal(t, 1, buffer.size())
+}
+
+func TestSchedulerBuffer_DropHeaviest(t *testing.T) {
+	issuerA := identity.GenerateIdentity().ID()
+	issuerB := identity.GenerateIdentity().ID()
+	weights := map[identity.ID]float64{issuerA: 1000, issuerB: 1}
+	issuerWeight := func(issuerID identity.ID) float64 {
+		return weights[issuerID]
+	}
+
+	buffer := newSchedulerBuffer()
+	oldestOfA, oldestOfB := randomMessageID(), randomMessageID()
+	buffer.push(issuerA, oldestOfA, 100)
+	buffer.push(issuerA, randomMessageID(), 100)
+	buffer.push(issuerB, oldestOfB, 100)
+	buffer.push(issuerB, randomMessageID(), 100)
+
+	// issuer B has the larger queue relative to its mana
+	droppedMessageID, dropped := buffer.dropHeaviest(issuerWeight)
+	require.True(t, dropped)
+	assert.Equal(t, oldestOfB, droppedMessageID)
+	assert.Equal(t, 3, buffer.size())
+}
@@ -40,6 +40,15 @@ const (
 	// PrefixFCoB defines the storage prefix for FCoB.
 	PrefixFCoB
 
+	// PrefixSolidEntryPoints defines the storage prefix for solid entry points.
+	PrefixSolidEntryPoints
+
+	// PrefixSequenceSupporters defines the storage prefix for the SequenceSupporters.
+	PrefixSequenceSupporters
+
+	// PrefixConfirmedTips defines the storage prefix for the confirmed tips.
+	PrefixConfirmedTips
+
 	cacheTime = 2 * time.Second
 
 	// DBSequenceNumber defines the db sequence number.
@@ -57,6 +66,9 @@ type Storage struct {
 	missingMessageStorage             *objectstorage.ObjectStorage
 	attachmentStorage                 *objectstorage.ObjectStorage
 	markerIndexBranchIDMappingStorage *objectstorage.ObjectStorage
+	solidEntryPointStorage            *objectstorage.ObjectStorage
+	sequenceSupportersStorage         *objectstorage.ObjectStorage
+	confirmedTipStorage               *objectstorage.ObjectStorage
 
 	Events   *StorageEvents
 	shutdown chan struct{}
@@ -75,6 +87,9 @@ func NewStorage(tangle *Tangle) (storage *Storage) {
 		missingMessageStorage:             osFactory.New(PrefixMissingMessage, MissingMessageFromObjectStorage, objectstorage.CacheTime(cacheTime), objectstorage.LeakDetectionEnabled(false)),
 		attachmentStorage:                 osFactory.New(PrefixAttachments, AttachmentFromObjectStorage, objectstorage.CacheTime(cacheTime), objectstorage.PartitionKey(ledgerstate.TransactionIDLength, MessageIDLength), objectstorage.LeakDetectionEnabled(false)),
 		markerIndexBranchIDMappingStorage: osFactory.New(PrefixMarkerBranchIDMapping, MarkerIndexBranchIDMappingFromObjectStorage, objectstorage.CacheTime(cacheTime), objectstorage.LeakDetectionEnabled(false)),
+		solidEntryPointStorage:            osFactory.New(PrefixSolidEntryPoints, SolidEntryPointFromObjectStorage, objectstorage.CacheTime(cacheTime), objectstorage.LeakDetectionEnabled(false)),
+		sequenceSupportersStorage:         osFactory.New(PrefixSequenceSupporters, SequenceSupportersFromObjectStorage, objectstorage.CacheTime(cacheTime), objectstorage.LeakDetectionEnabled(false)),
+		confirmedTipStorage:               osFactory.New(PrefixConfirmedTips, ConfirmedTipFromObjectStorage, objectstorage.CacheTime(cacheTime), objectstorage.LeakDetectionEnabled(false)),
 
 		Events: &StorageEvents{
 			MessageStored:        events.NewEvent(messageIDEventHandler),
@@ -233,11 +248,38 @@ func (s *Storage) DeleteMessage(messageID MessageID) {
 
 		s.messageMetadataStorage.Delete(messageID[:])
 		s.messageStorage.Delete(messageID[:])
+		s.confirmedTipStorage.Delete(messageID[:])
 
 		s.Events.MessageRemoved.Trigger(messageID)
 	})
 }
 
+// PruneMessage deletes a Message together with its Metadata, its Approvers and the Attachment of its Transaction. In
+// contrast to DeleteMessage, it also removes the references to the Message's own approvers so that no dangling
+// entries remain in the database. If the Transaction has Outputs that are not spent by confirmed Transactions, yet, it
+// is attached to the genesis instead, so that its Outputs can still be spent by future Messages.
+func (s *Storage) PruneMessage(messageID MessageID) {
+	s.Message(messageID).Consume(func(message *Message) {
+		if message.Payload().Type() != ledgerstate.TransactionType {
+			return
+		}
+
+		transactionID := message.Payload().(*ledgerstate.Transaction).ID()
+		if !s.tangle.LedgerState.UTXODAG.OutputsSpentByConfirmedTransactions(transactionID) {
+			if cachedAttachment, stored := s.StoreAttachment(transactionID, EmptyMessageID); stored {
+				cachedAttachment.Release()
+			}
+		}
+		s.attachmentStorage.Delete(NewAttachment(transactionID, messageID).ObjectStorageKey())
+	})
+
+	s.Approvers(messageID).Consume(func(approver *Approver) {
+		approver.Delete()
+	})
+
+	s.DeleteMessage(messageID)
+}
+
 // DeleteMissingMessage deletes a message from the missingMessageStorage.
 func (s *Storage) DeleteMissingMessage(messageID MessageID) {
 	s.missingMessageStorage.Delete(messageID[:])
@@ -256,10 +298,69 @@ func (s *Storage) MarkerIndexBranchIDMapping(sequenceID markers.SequenceID, comp
 	return &CachedMarkerIndexBranchIDMapping{CachedObject: s.markerIndexBranchIDMappingStorage.Load(sequenceID.Bytes())}
 }
 
+// SequenceSupporters retrieves the SequenceSupporters of the given Sequence. The optional computeIfAbsentCallback can
+// be used to create the object if it does not exist, yet.
+func (s *Storage) SequenceSupporters(sequenceID markers.SequenceID, computeIfAbsentCallback ...func(sequenceID markers.SequenceID) *SequenceSupporters) *CachedSequenceSupporters {
+	if len(computeIfAbsentCallback) >= 1 {
+		return &CachedSequenceSupporters{s.sequenceSupportersStorage.ComputeIfAbsent(sequenceID.Bytes(), func(key []byte) objectstorage.StorableObject {
+			sequenceSupporters := computeIfAbsentCallback[0](sequenceID)
+			sequenceSupporters.Persist()
+			sequenceSupporters.SetModified()
+
+			return sequenceSupporters
+		})}
+	}
+
+	return &CachedSequenceSupporters{CachedObject: s.sequenceSupportersStorage.Load(sequenceID.Bytes())}
+}
+
+// StoreSolidEntryPoint marks the given Message as a solid entry point. Solid entry points are treated like the genesis:
+// they are considered to be solid, valid and booked even though the Message itself is not known (e.g. because the node
+// was started from a snapshot or because the Message was pruned).
+func (s *Storage) StoreSolidEntryPoint(messageID MessageID) (stored bool) {
+	cachedSolidEntryPoint, stored := s.solidEntryPointStorage.StoreIfAbsent(NewSolidEntryPoint(messageID))
+	if !stored {
+		return
+	}
+	cachedSolidEntryPoint.Release()
+
+	s.storeSolidEntryPointMetadata(messageID)
+
+	if s.missingMessageStorage.DeleteIfPresent(messageID[:]) {
+		s.Events.MissingMessageStored.Trigger(messageID)
+	}
+
+	return
+}
+
+// IsSolidEntryPoint returns true if the given Message is the genesis or one of the stored solid entry points.
+func (s *Storage) IsSolidEntryPoint(messageID MessageID) bool {
+	return messageID == EmptyMessageID || s.solidEntryPointStorage.Contains(messageID[:])
+}
+
+// SolidEntryPoints returns the MessageIDs of all stored solid entry points (the genesis is not included).
+func (s *Storage) SolidEntryPoints() (solidEntryPoints MessageIDs) {
+	solidEntryPoints = make(MessageIDs, 0)
+	s.solidEntryPointStorage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		(&CachedSolidEntryPoint{CachedObject: cachedObject}).Consume(func(solidEntryPoint *SolidEntryPoint) {
+			solidEntryPoints = append(solidEntryPoints, solidEntryPoint.MessageID())
+		})
+
+		return true
+	})
+
+	return
+}
+
 func (s *Storage) storeGenesis() {
-	s.MessageMetadata(EmptyMessageID, func() *MessageMetadata {
-		genesisMetadata := &MessageMetadata{
-			messageID: EmptyMessageID,
+	s.storeSolidEntryPointMetadata(EmptyMessageID)
+}
+
+// storeSolidEntryPointMetadata stores the MessageMetadata of a solid entry point (if it does not exist, yet).
+func (s *Storage) storeSolidEntryPointMetadata(messageID MessageID) {
+	s.MessageMetadata(messageID, func() *MessageMetadata {
+		solidEntryPointMetadata := &MessageMetadata{
+			messageID: messageID,
 			solid:     true,
 			branchID:  ledgerstate.MasterBranchID,
 			structureDetails: &markers.StructureDetails{
@@ -276,12 +377,11 @@ func (s *Storage) storeGenesis() {
 			eligible: true,
 		}
 
-		genesisMetadata.Persist()
-		genesisMetadata.SetModified()
+		solidEntryPointMetadata.Persist()
+		solidEntryPointMetadata.SetModified()
 
-		return genesisMetadata
+		return solidEntryPointMetadata
 	}).Release()
-
 }
 
 // deleteStrongApprover deletes an Approver from the object storage that was created by a strong parent.
@@ -302,6 +402,9 @@ func (s *Storage) Shutdown() {
 	s.missingMessageStorage.Shutdown()
 	s.attachmentStorage.Shutdown()
 	s.markerIndexBranchIDMappingStorage.Shutdown()
+	s.solidEntryPointStorage.Shutdown()
+	s.sequenceSupportersStorage.Shutdown()
+	s.confirmedTipStorage.Shutdown()
 
 	close(s.shutdown)
 }
@@ -315,6 +418,9 @@ func (s *Storage) Prune() error {
 		s.missingMessageStorage,
 		s.attachmentStorage,
 		s.markerIndexBranchIDMappingStorage,
+		s.solidEntryPointStorage,
+		s.sequenceSupportersStorage,
+		s.confirmedTipStorage,
 	} {
 		if err := storage.Prune(); err != nil {
 			err = fmt.Errorf("failed to prune storage: %w", err)
@@ -376,6 +482,43 @@ func (s *Storage) RetrieveAllTips() (tips []MessageID) {
 	return tips
 }
 
+// RetrieveConfirmedTips returns the confirmed messages that are not approved by any other confirmed message. They form
+// the solid entry points for nodes that are bootstrapped from a snapshot of the current ledger state.
+func (s *Storage) RetrieveConfirmedTips() (confirmedTips MessageIDs) {
+	confirmedTips = make(MessageIDs, 0)
+	s.confirmedTipStorage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		(&CachedConfirmedTip{CachedObject: cachedObject}).Consume(func(confirmedTip *ConfirmedTip) {
+			confirmedTips = append(confirmedTips, confirmedTip.MessageID())
+		})
+
+		return true
+	})
+
+	return
+}
+
+// updateConfirmedTips updates the confirmed tips after the given Message was confirmed: the Message becomes a confirmed
+// tip (unless it is approved by a confirmed Message already) and its parents stop being confirmed tips.
+func (s *Storage) updateConfirmedTips(message *Message) {
+	message.ForEachParent(func(parent Parent) {
+		s.confirmedTipStorage.Delete(parent.ID.Bytes())
+	})
+
+	approvedByConfirmedMessage := false
+	s.Approvers(message.ID()).Consume(func(approver *Approver) {
+		s.MessageMetadata(approver.ApproverMessageID()).Consume(func(approverMetadata *MessageMetadata) {
+			approvedByConfirmedMessage = approvedByConfirmedMessage || approverMetadata.IsConfirmed()
+		})
+	})
+	if approvedByConfirmedMessage {
+		return
+	}
+
+	if cachedConfirmedTip, stored := s.confirmedTipStorage.StoreIfAbsent(NewConfirmedTip(message.ID())); stored {
+		cachedConfirmedTip.Release()
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region StorageEvents ////////////////////////////////////////////////////////////////////////////////////////////////
@@ -968,3 +1111,265 @@ func (c *CachedMissingMessage) String() string {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SolidEntryPoint //////////////////////////////////////////////////////////////////////////////////////////////
+
+// SolidEntryPoint represents a Message that is treated as solid even though its past cone is not known.
+type SolidEntryPoint struct {
+	objectstorage.StorableObjectFlags
+
+	messageID MessageID
+}
+
+// NewSolidEntryPoint creates a new SolidEntryPoint for the Message with the given MessageID.
+func NewSolidEntryPoint(messageID MessageID) *SolidEntryPoint {
+	return &SolidEntryPoint{
+		messageID: messageID,
+	}
+}
+
+// SolidEntryPointFromBytes parses the given bytes into a SolidEntryPoint.
+func SolidEntryPointFromBytes(bytes []byte) (result *SolidEntryPoint, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	result, err = SolidEntryPointFromMarshalUtil(marshalUtil)
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// SolidEntryPointFromMarshalUtil parses a SolidEntryPoint from the given MarshalUtil.
+func SolidEntryPointFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (result *SolidEntryPoint, err error) {
+	result = &SolidEntryPoint{}
+
+	if result.messageID, err = MessageIDFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse MessageID of SolidEntryPoint: %w", err)
+		return
+	}
+
+	return
+}
+
+// SolidEntryPointFromObjectStorage restores a SolidEntryPoint from the ObjectStorage.
+func SolidEntryPointFromObjectStorage(key []byte, data []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = SolidEntryPointFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = xerrors.Errorf("failed to parse SolidEntryPoint from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// MessageID returns the MessageID of the SolidEntryPoint.
+func (s *SolidEntryPoint) MessageID() MessageID {
+	return s.messageID
+}
+
+// Bytes returns a marshaled version of the SolidEntryPoint.
+func (s *SolidEntryPoint) Bytes() []byte {
+	return byteutils.ConcatBytes(s.ObjectStorageKey(), s.ObjectStorageValue())
+}
+
+// String returns a human readable version of the SolidEntryPoint.
+func (s *SolidEntryPoint) String() string {
+	return stringify.Struct("SolidEntryPoint",
+		stringify.StructField("messageID", s.messageID),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (s *SolidEntryPoint) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (s *SolidEntryPoint) ObjectStorageKey() []byte {
+	return s.messageID.Bytes()
+}
+
+// ObjectStorageValue marshals the SolidEntryPoint into a sequence of bytes. The SolidEntryPoint does not contain any
+// data besides its key, so an empty byte slice is returned.
+func (s *SolidEntryPoint) ObjectStorageValue() []byte {
+	return make([]byte, 0)
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &SolidEntryPoint{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CachedSolidEntryPoint ////////////////////////////////////////////////////////////////////////////////////////
+
+// CachedSolidEntryPoint is a wrapper for the generic CachedObject returned by the object storage that overrides the
+// accessor methods with a type-casted one.
+type CachedSolidEntryPoint struct {
+	objectstorage.CachedObject
+}
+
+// Retain marks the CachedObject to still be in use by the program.
+func (c *CachedSolidEntryPoint) Retain() *CachedSolidEntryPoint {
+	return &CachedSolidEntryPoint{c.CachedObject.Retain()}
+}
+
+// Unwrap is the type-casted equivalent of Get. It returns nil if the object does not exist.
+func (c *CachedSolidEntryPoint) Unwrap() *SolidEntryPoint {
+	untypedObject := c.Get()
+	if untypedObject == nil {
+		return nil
+	}
+
+	typedObject := untypedObject.(*SolidEntryPoint)
+	if typedObject == nil || typedObject.IsDeleted() {
+		return nil
+	}
+
+	return typedObject
+}
+
+// Consume unwraps the CachedObject and passes a type-casted version to the consumer (if the object is not empty - it
+// exists). It automatically releases the object when the consumer finishes.
+func (c *CachedSolidEntryPoint) Consume(consumer func(solidEntryPoint *SolidEntryPoint), forceRelease ...bool) (consumed bool) {
+	return c.CachedObject.Consume(func(object objectstorage.StorableObject) {
+		consumer(object.(*SolidEntryPoint))
+	}, forceRelease...)
+}
+
+// String returns a human readable version of the CachedSolidEntryPoint.
+func (c *CachedSolidEntryPoint) String() string {
+	return stringify.Struct("CachedSolidEntryPoint",
+		stringify.StructField("CachedObject", c.Unwrap()),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConfirmedTip /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ConfirmedTip represents a confirmed Message that is not approved by any other confirmed Message.
+type ConfirmedTip struct {
+	objectstorage.StorableObjectFlags
+
+	messageID MessageID
+}
+
+// NewConfirmedTip creates a new ConfirmedTip for the Message with the given MessageID.
+func NewConfirmedTip(messageID MessageID) *ConfirmedTip {
+	return &ConfirmedTip{
+		messageID: messageID,
+	}
+}
+
+// ConfirmedTipFromBytes parses the given bytes into a ConfirmedTip.
+func ConfirmedTipFromBytes(bytes []byte) (result *ConfirmedTip, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	result, err = ConfirmedTipFromMarshalUtil(marshalUtil)
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// ConfirmedTipFromMarshalUtil parses a ConfirmedTip from the given MarshalUtil.
+func ConfirmedTipFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (result *ConfirmedTip, err error) {
+	result = &ConfirmedTip{}
+
+	if result.messageID, err = MessageIDFromMarshalUtil(marshalUtil); err != nil {
+		err = xerrors.Errorf("failed to parse MessageID of ConfirmedTip: %w", err)
+		return
+	}
+
+	return
+}
+
+// ConfirmedTipFromObjectStorage restores a ConfirmedTip from the ObjectStorage.
+func ConfirmedTipFromObjectStorage(key []byte, data []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = ConfirmedTipFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = xerrors.Errorf("failed to parse ConfirmedTip from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// MessageID returns the MessageID of the ConfirmedTip.
+func (c *ConfirmedTip) MessageID() MessageID {
+	return c.messageID
+}
+
+// Bytes returns a marshaled version of the ConfirmedTip.
+func (c *ConfirmedTip) Bytes() []byte {
+	return byteutils.ConcatBytes(c.ObjectStorageKey(), c.ObjectStorageValue())
+}
+
+// String returns a human readable version of the ConfirmedTip.
+func (c *ConfirmedTip) String() string {
+	return stringify.Struct("ConfirmedTip",
+		stringify.StructField("messageID", c.messageID),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (c *ConfirmedTip) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (c *ConfirmedTip) ObjectStorageKey() []byte {
+	return c.messageID.Bytes()
+}
+
+// ObjectStorageValue marshals the ConfirmedTip into a sequence of bytes. The ConfirmedTip does not contain any data
+// besides its key, so an empty byte slice is returned.
+func (c *ConfirmedTip) ObjectStorageValue() []byte {
+	return make([]byte, 0)
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &ConfirmedTip{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CachedConfirmedTip ///////////////////////////////////////////////////////////////////////////////////////////
+
+// CachedConfirmedTip is a wrapper for the generic CachedObject returned by the object storage that overrides the
+// accessor methods with a type-casted one.
+type CachedConfirmedTip struct {
+	objectstorage.CachedObject
+}
+
+// Retain marks the CachedObject to still be in use by the program.
+func (c *CachedConfirmedTip) Retain() *CachedConfirmedTip {
+	return &CachedConfirmedTip{c.CachedObject.Retain()}
+}
+
+// Unwrap is the type-casted equivalent of Get. It returns nil if the object does not exist.
+func (c *CachedConfirmedTip) Unwrap() *ConfirmedTip {
+	untypedObject := c.Get()
+	if untypedObject == nil {
+		return nil
+	}
+
+	typedObject := untypedObject.(*ConfirmedTip)
+	if typedObject == nil || typedObject.IsDeleted() {
+		return nil
+	}
+
+	return typedObject
+}
+
+// Consume unwraps the CachedObject and passes a type-casted version to the consumer (if the object is not empty - it
+// exists). It automatically releases the object when the consumer finishes.
+func (c *CachedConfirmedTip) Consume(consumer func(confirmedTip *ConfirmedTip), forceRelease ...bool) (consumed bool) {
+	return c.CachedObject.Consume(func(object objectstorage.StorableObject) {
+		consumer(object.(*ConfirmedTip))
+	}, forceRelease...)
+}
+
+// String returns a human readable version of the CachedConfirmedTip.
+func (c *CachedConfirmedTip) String() string {
+	return stringify.Struct("CachedConfirmedTip",
+		stringify.StructField("CachedObject", c.Unwrap()),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/events"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,3 +52,56 @@ func TestStorage_Attachments(t *testing.T) {
 	}
 
 }
+
+func TestStorage_SolidEntryPoints(t *testing.T) {
+	tangle := New(WithoutOpinionFormer(true))
+	defer tangle.Shutdown()
+	tangle.Solidifier.Setup()
+	tangle.Requester.Setup()
+
+	tangle.Solidifier.Events.MessageMissing.Attach(events.NewClosure(func(messageID MessageID) {
+		t.Errorf("solid entry point %s should never be missing", messageID)
+	}))
+
+	solidEntryPoint := randomMessageID()
+	assert.True(t, tangle.Storage.StoreSolidEntryPoint(solidEntryPoint))
+	assert.False(t, tangle.Storage.StoreSolidEntryPoint(solidEntryPoint))
+	assert.True(t, tangle.Storage.IsSolidEntryPoint(solidEntryPoint))
+	assert.True(t, tangle.Storage.IsSolidEntryPoint(EmptyMessageID))
+	assert.False(t, tangle.Storage.IsSolidEntryPoint(randomMessageID()))
+	assert.Equal(t, MessageIDs{solidEntryPoint}, tangle.Storage.SolidEntryPoints())
+
+	// messages that reference a solid entry point become solid without requesting their parents
+	message := newTestParentsDataMessage("child", []MessageID{solidEntryPoint}, []MessageID{})
+	tangle.Storage.StoreMessage(message)
+	assert.True(t, tangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.True(t, messageMetadata.IsSolid())
+	}))
+	assert.Equal(t, 0, tangle.Requester.RequestQueueSize())
+}
+
+func TestStorage_ConfirmedTips(t *testing.T) {
+	tangle := New(WithoutOpinionFormer(true))
+	defer tangle.Shutdown()
+
+	messages := make(map[string]*Message)
+	messages["1"] = newTestParentsDataMessage("1", []MessageID{EmptyMessageID}, []MessageID{})
+	messages["2"] = newTestParentsDataMessage("2", []MessageID{messages["1"].ID()}, []MessageID{})
+	messages["3"] = newTestParentsDataMessage("3", []MessageID{messages["2"].ID()}, []MessageID{})
+	messages["4"] = newTestParentsDataMessage("4", []MessageID{messages["1"].ID()}, []MessageID{messages["2"].ID()})
+	for _, message := range messages {
+		tangle.Storage.StoreMessage(message)
+	}
+	assert.Empty(t, tangle.Storage.RetrieveConfirmedTips())
+
+	// confirming a Message confirms its past cone, of which only the Message itself is a confirmed tip
+	tangle.ApprovalWeightManager.confirmPastCone(messages["2"].ID())
+	assert.Equal(t, MessageIDs{messages["2"].ID()}, tangle.Storage.RetrieveConfirmedTips())
+
+	tangle.ApprovalWeightManager.confirmPastCone(messages["3"].ID())
+	tangle.ApprovalWeightManager.confirmPastCone(messages["4"].ID())
+	assert.ElementsMatch(t, MessageIDs{messages["3"].ID(), messages["4"].ID()}, tangle.Storage.RetrieveConfirmedTips())
+
+	tangle.Storage.DeleteMessage(messages["4"].ID())
+	assert.Equal(t, MessageIDs{messages["3"].ID()}, tangle.Storage.RetrieveConfirmedTips())
+}
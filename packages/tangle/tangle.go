@@ -16,22 +16,24 @@ import (
 
 // Tangle is the central data structure of the IOTA protocol.
 type Tangle struct {
-	Parser         *Parser
-	Storage        *Storage
-	Solidifier     *Solidifier
-	Scheduler      *Scheduler
-	Booker         *Booker
-	TipManager     *TipManager
-	Requester      *Requester
-	MessageFactory *MessageFactory
-	LedgerState    *LedgerState
-	Utils          *Utils
-	Options        *Options
-	Events         *Events
+	Parser                *Parser
+	Storage               *Storage
+	Solidifier            *Solidifier
+	Scheduler             *Scheduler
+	Booker                *Booker
+	ApprovalWeightManager *ApprovalWeightManager
+	Pruner                *Pruner
+	TipManager            *TipManager
+	Requester             *Requester
+	MessageFactory        *MessageFactory
+	LedgerState           *LedgerState
+	Utils                 *Utils
+	Options               *Options
+	Events                *Events
 
 	OpinionFormer            *OpinionFormer
 	PayloadOpinionProvider   OpinionVoterProvider
-	TimestampOpinionProvider OpinionProvider
+	TimestampOpinionProvider *TimestampOpinionProvider
 
 	setupParserOnce sync.Once
 }
@@ -53,6 +55,8 @@ func New(options ...Option) (tangle *Tangle) {
 	tangle.Scheduler = NewScheduler(tangle)
 	tangle.LedgerState = NewLedgerState(tangle)
 	tangle.Booker = NewBooker(tangle)
+	tangle.ApprovalWeightManager = NewApprovalWeightManager(tangle)
+	tangle.Pruner = NewPruner(tangle)
 	tangle.Requester = NewRequester(tangle)
 	tangle.TipManager = NewTipManager(tangle)
 	tangle.MessageFactory = NewMessageFactory(tangle, tangle.TipManager)
@@ -60,7 +64,7 @@ func New(options ...Option) (tangle *Tangle) {
 
 	if !tangle.Options.WithoutOpinionFormer {
 		tangle.PayloadOpinionProvider = NewFCoB(tangle.Options.Store, tangle)
-		tangle.TimestampOpinionProvider = NewTimestampLikedByDefault(tangle)
+		tangle.TimestampOpinionProvider = NewTimestampOpinionProvider(tangle)
 		tangle.OpinionFormer = NewOpinionFormer(tangle, tangle.PayloadOpinionProvider, tangle.TimestampOpinionProvider)
 	}
 	return
@@ -73,6 +77,7 @@ func (t *Tangle) Setup() {
 	t.Requester.Setup()
 	t.Scheduler.Setup()
 	t.Booker.Setup()
+	t.ApprovalWeightManager.Setup()
 
 	// Booker and LedgerState setup is left out until the old value tangle is in use.
 	if !t.Options.WithoutOpinionFormer {
@@ -93,7 +98,8 @@ func (t *Tangle) ProcessGossipMessage(messageBytes []byte, peer *peer.Peer) {
 	t.Parser.Parse(messageBytes, peer)
 }
 
-// Prune resets the database and deletes all stored objects (good for testing or "node resets").
+// Prune resets the database and deletes all stored objects (good for testing or "node resets"). Use the Pruner to only
+// remove old confirmed Messages.
 func (t *Tangle) Prune() (err error) {
 	return t.Storage.Prune()
 }
@@ -101,6 +107,7 @@ func (t *Tangle) Prune() (err error) {
 // Shutdown marks the tangle as stopped, so it will not accept any new messages (waits for all backgroundTasks to finish).
 func (t *Tangle) Shutdown() {
 	t.MessageFactory.Shutdown()
+	t.Pruner.Shutdown()
 	if !t.Options.WithoutOpinionFormer {
 		t.OpinionFormer.Shutdown()
 	}
@@ -146,6 +153,9 @@ type Options struct {
 	WithoutOpinionFormer         bool
 	IncreaseMarkersIndexCallback markers.IncreaseIndexCallback
 	TangleWidth                  int
+	SchedulerParams              SchedulerParams
+	ConfirmationThreshold        float64
+	PrunerParams                 PrunerParams
 }
 
 // buildOptions generates the Options object use by the Tangle.
@@ -154,6 +164,13 @@ func buildOptions(options ...Option) (builtOptions *Options) {
 		Store:                        mapdb.NewMapDB(),
 		Identity:                     identity.GenerateLocalIdentity(),
 		IncreaseMarkersIndexCallback: increaseMarkersIndexCallbackStrategy,
+		SchedulerParams: SchedulerParams{
+			MaxBufferSize: DefaultMaxBufferSize,
+		},
+		ConfirmationThreshold: DefaultConfirmationThreshold,
+		PrunerParams: PrunerParams{
+			Interval: DefaultPruningInterval,
+		},
 	}
 
 	for _, option := range options {
@@ -199,4 +216,26 @@ func TangleWidth(width int) Option {
 	}
 }
 
+// SchedulerConfig is an Option for the Tangle that allows to set the parameters of the Scheduler.
+func SchedulerConfig(params SchedulerParams) Option {
+	return func(options *Options) {
+		options.SchedulerParams = params
+	}
+}
+
+// ConfirmationThreshold is an Option for the Tangle that allows to set the share of the total consensus mana that has
+// to support a Marker before it is confirmed by the ApprovalWeightManager.
+func ConfirmationThreshold(threshold float64) Option {
+	return func(options *Options) {
+		options.ConfirmationThreshold = threshold
+	}
+}
+
+// PrunerConfig is an Option for the Tangle that allows to set the parameters of the Pruner.
+func PrunerConfig(params PrunerParams) Option {
+	return func(options *Options) {
+		options.PrunerParams = params
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -12,9 +12,9 @@ import (
 
 var (
 	// TimestampWindow defines the time window for assessing the timestamp quality.
-	TimestampWindow time.Duration
+	TimestampWindow = 1 * time.Minute
 	// GratuitousNetworkDelay defines the time after which we assume all messages are delivered.
-	GratuitousNetworkDelay time.Duration
+	GratuitousNetworkDelay = 15 * time.Second
 )
 
 const (
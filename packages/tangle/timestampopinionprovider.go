@@ -0,0 +1,117 @@
+package tangle
+
+import (
+	"github.com/iotaledger/goshimmer/packages/vote"
+	voter "github.com/iotaledger/goshimmer/packages/vote/opinion"
+	"github.com/iotaledger/hive.go/events"
+	"golang.org/x/xerrors"
+)
+
+// region TimestampOpinionProvider /////////////////////////////////////////////////////////////////////////////////////
+
+// TimestampOpinionProvider is the opinion provider that forms an opinion about the timestamp of a message by comparing
+// its issuing time with the time it was received. Timestamps close to the edge of the TimestampWindow (LoK One) are
+// handed to the voter.
+type TimestampOpinionProvider struct {
+	Events *TimestampOpinionProviderEvents
+
+	tangle *Tangle
+}
+
+// NewTimestampOpinionProvider returns a new instance of the TimestampOpinionProvider.
+func NewTimestampOpinionProvider(tangle *Tangle) (timestampOpinionProvider *TimestampOpinionProvider) {
+	timestampOpinionProvider = &TimestampOpinionProvider{
+		tangle: tangle,
+		Events: &TimestampOpinionProviderEvents{
+			Error: events.NewEvent(events.ErrorCaller),
+			Vote:  events.NewEvent(voteEvent),
+		},
+	}
+
+	return
+}
+
+// Setup sets up the behavior of the component by making it attach to the relevant events of the other components.
+// It is required to satisfy the OpinionProvider interface.
+func (t *TimestampOpinionProvider) Setup(timestampEvent *events.Event) {
+	t.Events.TimestampOpinionFormed = timestampEvent
+}
+
+// Shutdown shuts down component and persists its state. It is required to satisfy the OpinionProvider interface.
+func (t *TimestampOpinionProvider) Shutdown() {}
+
+// Vote trigger a voting request.
+func (t *TimestampOpinionProvider) Vote() *events.Event {
+	return t.Events.Vote
+}
+
+// VoteError notify an error coming from the result of voting.
+func (t *TimestampOpinionProvider) VoteError() *events.Event {
+	return t.Events.Error
+}
+
+// Opinion returns the liked status of the timestamp of the given messageID.
+func (t *TimestampOpinionProvider) Opinion(messageID MessageID) (opinion bool) {
+	t.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		opinion = messageMetadata.TimestampOpinion().Value == voter.Like
+	})
+
+	return
+}
+
+// Evaluate evaluates the opinion of the timestamp of the given messageID. If the level of knowledge of the formed
+// opinion is One, a vote is triggered and the TimestampOpinionFormed event is only fired after the vote is processed.
+func (t *TimestampOpinionProvider) Evaluate(messageID MessageID) {
+	t.tangle.Storage.Message(messageID).Consume(func(message *Message) {
+		t.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+			timestampOpinion := TimestampQuality(message.IssuingTime(), messageMetadata.ReceivedTime())
+			messageMetadata.SetTimestampOpinion(timestampOpinion)
+
+			if timestampOpinion.LoK == One {
+				t.Events.Vote.Trigger(messageID.String(), timestampOpinion.Value)
+				return
+			}
+
+			t.Events.TimestampOpinionFormed.Trigger(messageID)
+		})
+	})
+}
+
+// ProcessVote allows an external voter to hand in the results of the voting process.
+func (t *TimestampOpinionProvider) ProcessVote(ev *vote.OpinionEvent) {
+	if ev.Ctx.Type != vote.TimestampType {
+		return
+	}
+
+	messageID, err := NewMessageID(ev.ID)
+	if err != nil {
+		t.Events.Error.Trigger(xerrors.Errorf("failed to process timestamp vote: %w", err))
+		return
+	}
+
+	t.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		messageMetadata.SetTimestampOpinion(TimestampOpinion{
+			Value: ev.Opinion,
+			LoK:   Two,
+		})
+		t.Events.TimestampOpinionFormed.Trigger(messageID)
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TimestampOpinionProviderEvents ///////////////////////////////////////////////////////////////////////////////
+
+// TimestampOpinionProviderEvents defines all the events related to the TimestampOpinionProvider.
+type TimestampOpinionProviderEvents struct {
+	// Fired when an opinion of a timestamp is formed.
+	TimestampOpinionFormed *events.Event
+
+	// Error gets called when the TimestampOpinionProvider faces an error.
+	Error *events.Event
+
+	// Vote gets called when the TimestampOpinionProvider needs to vote.
+	Vote *events.Event
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
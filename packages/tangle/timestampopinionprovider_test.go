@@ -0,0 +1,69 @@
+package tangle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/vote"
+	"github.com/iotaledger/goshimmer/packages/vote/opinion"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestampOpinionProvider(t *testing.T) {
+	timestampWindow, gratuitousNetworkDelay := TimestampWindow, GratuitousNetworkDelay
+	t.Cleanup(func() {
+		TimestampWindow, GratuitousNetworkDelay = timestampWindow, gratuitousNetworkDelay
+	})
+	TimestampWindow = 1 * time.Minute
+	GratuitousNetworkDelay = 15 * time.Second
+
+	tangle := New()
+	defer tangle.Shutdown()
+
+	formedMessages := make(map[MessageID]int)
+	timestampOpinionFormed := events.NewEvent(messageIDEventHandler)
+	timestampOpinionFormed.Attach(events.NewClosure(func(messageID MessageID) {
+		formedMessages[messageID]++
+	}))
+	votes := make(map[string]opinion.Opinion)
+	tangle.TimestampOpinionProvider.Setup(timestampOpinionFormed)
+	tangle.TimestampOpinionProvider.Vote().Attach(events.NewClosure(func(id string, initOpn opinion.Opinion) {
+		votes[id] = initOpn
+	}))
+
+	// a timestamp close to the current time is liked without voting
+	messageA := newTestParentsDataWithTimestamp("A", []MessageID{EmptyMessageID}, []MessageID{}, time.Now())
+	tangle.Storage.StoreMessage(messageA)
+	tangle.TimestampOpinionProvider.Evaluate(messageA.ID())
+	assert.Equal(t, 1, formedMessages[messageA.ID()])
+	assert.True(t, tangle.TimestampOpinionProvider.Opinion(messageA.ID()))
+	assert.Empty(t, votes)
+
+	// a timestamp close to the edge of the window is handed to the voter
+	messageB := newTestParentsDataWithTimestamp("B", []MessageID{EmptyMessageID}, []MessageID{}, time.Now().Add(-TimestampWindow+GratuitousNetworkDelay))
+	tangle.Storage.StoreMessage(messageB)
+	tangle.TimestampOpinionProvider.Evaluate(messageB.ID())
+	assert.Equal(t, 0, formedMessages[messageB.ID()])
+	assert.Equal(t, opinion.Like, votes[messageB.ID().String()])
+
+	// the result of the vote overrides the initial opinion
+	tangle.TimestampOpinionProvider.ProcessVote(&vote.OpinionEvent{
+		ID:      messageB.ID().String(),
+		Opinion: opinion.Dislike,
+		Ctx:     vote.Context{Type: vote.TimestampType},
+	})
+	assert.Equal(t, 1, formedMessages[messageB.ID()])
+	assert.False(t, tangle.TimestampOpinionProvider.Opinion(messageB.ID()))
+	tangle.Storage.MessageMetadata(messageB.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.Equal(t, TimestampOpinion{Value: opinion.Dislike, LoK: Two}, messageMetadata.TimestampOpinion())
+	})
+
+	// votes on conflicts are ignored
+	tangle.TimestampOpinionProvider.ProcessVote(&vote.OpinionEvent{
+		ID:      messageB.ID().String(),
+		Opinion: opinion.Like,
+		Ctx:     vote.Context{Type: vote.ConflictType},
+	})
+	assert.Equal(t, 1, formedMessages[messageB.ID()])
+}
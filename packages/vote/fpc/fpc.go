@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -22,15 +23,17 @@ var (
 	ErrNoOpinionGiversAvailable = errors.New("can't perform round as no opinion givers are available")
 )
 
-// New creates a new FPC instance.
-func New(opinionGiverFunc opinion.OpinionGiverFunc, paras ...*Parameters) *FPC {
+// New creates a new FPC instance. If a ManaRetrieverFunc is given, the opinion givers are sampled proportionally to their
+// mana, otherwise they are sampled uniformly.
+func New(opinionGiverFunc opinion.OpinionGiverFunc, manaRetrieverFunc opinion.ManaRetrieverFunc, paras ...*Parameters) *FPC {
 	f := &FPC{
-		opinionGiverFunc: opinionGiverFunc,
-		paras:            DefaultParameters(),
-		opinionGiverRng:  rand.New(rand.NewSource(clock.SyncedTime().UnixNano())),
-		ctxs:             make(map[string]*vote.Context),
-		queue:            list.New(),
-		queueSet:         make(map[string]struct{}),
+		opinionGiverFunc:  opinionGiverFunc,
+		manaRetrieverFunc: manaRetrieverFunc,
+		paras:             DefaultParameters(),
+		opinionGiverRng:   rand.New(rand.NewSource(clock.SyncedTime().UnixNano())),
+		ctxs:              make(map[string]*vote.Context),
+		queue:             list.New(),
+		queueSet:          make(map[string]struct{}),
 		events: vote.Events{
 			Finalized:     events.NewEvent(vote.OpinionCaller),
 			Failed:        events.NewEvent(vote.OpinionCaller),
@@ -49,6 +52,8 @@ func New(opinionGiverFunc opinion.OpinionGiverFunc, paras ...*Parameters) *FPC {
 type FPC struct {
 	events           vote.Events
 	opinionGiverFunc opinion.OpinionGiverFunc
+	// used to weight the sampling of opinion givers by their mana.
+	manaRetrieverFunc opinion.ManaRetrieverFunc
 	// the lifo queue of newly enqueued items to vote on.
 	queue *list.List
 	// contains a set of currently queued items.
@@ -114,15 +119,16 @@ func (f *FPC) Round(rand float64) error {
 		f.finalizeOpinions()
 	}
 	// query for opinions on the current vote contexts
-	queriedOpinions, err := f.queryOpinions()
+	queriedOpinions, opinionGiverWeights, err := f.queryOpinions()
 	if err == nil {
 		f.lastRoundCompletedSuccessfully = true
 		// execute a round executed event
 		roundStats := &vote.RoundStats{
-			Duration:           time.Since(start),
-			RandUsed:           rand,
-			ActiveVoteContexts: f.ctxs,
-			QueriedOpinions:    queriedOpinions,
+			Duration:            time.Since(start),
+			RandUsed:            rand,
+			ActiveVoteContexts:  f.ctxs,
+			QueriedOpinions:     queriedOpinions,
+			OpinionGiverWeights: opinionGiverWeights,
 		}
 		// TODO: add possibility to check whether an event handler is registered
 		// in order to prevent the collection of the round stats data if not needed
@@ -190,31 +196,25 @@ func (f *FPC) finalizeOpinions() {
 }
 
 // queries the opinions of QuerySampleSize amount of OpinionGivers.
-func (f *FPC) queryOpinions() ([]opinion.QueriedOpinions, error) {
+func (f *FPC) queryOpinions() ([]opinion.QueriedOpinions, map[string]float64, error) {
 	conflictIDs, timestampIDs := f.voteContextIDs()
 
 	// nothing to vote on
 	if len(conflictIDs) == 0 && len(timestampIDs) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	opinionGivers, err := f.opinionGiverFunc()
 	if err != nil {
-		return nil, err
-	}
-
-	// nobody to query
-	if len(opinionGivers) == 0 {
-		return nil, ErrNoOpinionGiversAvailable
+		return nil, nil, err
 	}
 
 	// select a random subset of opinion givers to query.
 	// if the same opinion giver is selected multiple times, we query it only once
 	// but use its opinion N selected times.
-	opinionGiversToQuery := map[opinion.OpinionGiver]int{}
-	for i := 0; i < f.paras.QuerySampleSize; i++ {
-		selected := opinionGivers[f.opinionGiverRng.Intn(len(opinionGivers))]
-		opinionGiversToQuery[selected]++
+	opinionGiversToQuery, opinionGiverWeights, err := f.sampleOpinionGivers(opinionGivers)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// votes per id
@@ -271,9 +271,9 @@ func (f *FPC) queryOpinions() ([]opinion.QueriedOpinions, error) {
 				// reuse the opinion N times selected.
 				// note this is always at least 1.
 				for j := 0; j < selectedCount; j++ {
-					votes = append(votes, opinions[i])
+					votes = append(votes, opinions[len(conflictIDs)+i])
 				}
-				queriedOpinions.Opinions[id] = opinions[i]
+				queriedOpinions.Opinions[id] = opinions[len(conflictIDs)+i]
 				voteMap[id] = votes
 			}
 			allQueriedOpinions = append(allQueriedOpinions, queriedOpinions)
@@ -305,7 +305,115 @@ func (f *FPC) queryOpinions() ([]opinion.QueriedOpinions, error) {
 		}
 		f.ctxs[id].Liked = likedSum / votedCount
 	}
-	return allQueriedOpinions, nil
+	return allQueriedOpinions, opinionGiverWeights, nil
+}
+
+// sampleOpinionGivers selects QuerySampleSize amount of OpinionGivers, either uniformly or proportionally to their mana,
+// and returns how many times each of them was selected together with the selection probability of every eligible
+// OpinionGiver.
+func (f *FPC) sampleOpinionGivers(opinionGivers []opinion.OpinionGiver) (map[opinion.OpinionGiver]int, map[string]float64, error) {
+	eligible, weights, err := f.opinionGiverWeights(opinionGivers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// nobody to query
+	if len(eligible) == 0 {
+		return nil, nil, ErrNoOpinionGiversAvailable
+	}
+
+	var totalWeight float64
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+
+	opinionGiverWeights := make(map[string]float64, len(eligible))
+	for i, opinionGiver := range eligible {
+		opinionGiverWeights[opinionGiver.ID().String()] += weights[i] / totalWeight
+	}
+
+	selected := make(map[opinion.OpinionGiver]int)
+	for i := 0; i < f.paras.QuerySampleSize && len(eligible) > 0 && totalWeight > 0; i++ {
+		index := f.drawWeighted(weights, totalWeight)
+		selected[eligible[index]]++
+
+		if f.paras.SampleWithReplacement {
+			continue
+		}
+
+		// remove the selected opinion giver so that it can not be drawn again
+		totalWeight -= weights[index]
+		eligible = append(eligible[:index:index], eligible[index+1:]...)
+		weights = append(weights[:index:index], weights[index+1:]...)
+	}
+
+	return selected, opinionGiverWeights, nil
+}
+
+// opinionGiverWeights returns the OpinionGivers that are eligible to be sampled together with their weights. If no
+// ManaRetrieverFunc is set or none of the OpinionGivers has any mana (e.g. in a freshly started network), all of them
+// are weighted equally and the MinOpinionGiverMana is ignored.
+func (f *FPC) opinionGiverWeights(opinionGivers []opinion.OpinionGiver) (eligible []opinion.OpinionGiver, weights []float64, err error) {
+	if f.manaRetrieverFunc == nil {
+		return f.uniformWeights(opinionGivers)
+	}
+
+	manaMap, err := f.manaRetrieverFunc()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var totalMana, eligibleMana float64
+	for _, opinionGiver := range opinionGivers {
+		mana := manaMap[opinionGiver.ID()]
+		totalMana += mana
+		if mana < f.paras.MinOpinionGiverMana {
+			continue
+		}
+
+		eligible = append(eligible, opinionGiver)
+		weights = append(weights, mana)
+		eligibleMana += mana
+	}
+
+	switch {
+	case totalMana == 0:
+		return f.uniformWeights(opinionGivers)
+	case eligibleMana == 0:
+		return f.uniformWeights(eligible)
+	}
+
+	return eligible, weights, nil
+}
+
+// uniformWeights assigns the same weight to all given OpinionGivers.
+func (f *FPC) uniformWeights(opinionGivers []opinion.OpinionGiver) ([]opinion.OpinionGiver, []float64, error) {
+	weights := make([]float64, len(opinionGivers))
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return opinionGivers, weights, nil
+}
+
+// drawWeighted randomly selects an index of the given weights with a probability proportional to its weight.
+func (f *FPC) drawWeighted(weights []float64, totalWeight float64) int {
+	cumulativeWeights := make([]float64, len(weights))
+	var cumulativeWeight float64
+	for i, weight := range weights {
+		cumulativeWeight += weight
+		cumulativeWeights[i] = cumulativeWeight
+	}
+
+	target := f.opinionGiverRng.Float64() * totalWeight
+	index := sort.Search(len(cumulativeWeights), func(i int) bool {
+		return cumulativeWeights[i] > target
+	})
+	if index == len(cumulativeWeights) {
+		index--
+	}
+
+	return index
 }
 
 func (f *FPC) voteContextIDs() (conflictIDs []string, timestampIDs []string) {
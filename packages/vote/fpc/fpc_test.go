@@ -55,7 +55,7 @@ func TestVoteContext_LastOpinion(t *testing.T) {
 }
 
 func TestFPCPreventSameIDMultipleTimes(t *testing.T) {
-	voter := fpc.New(nil)
+	voter := fpc.New(nil, nil)
 	assert.NoError(t, voter.Vote("a", vote.ConflictType, opinion.Like))
 	// can't add the same item twice
 	assert.True(t, errors.Is(voter.Vote("a", vote.ConflictType, opinion.Like), fpc.ErrVoteAlreadyOngoing))
@@ -96,7 +96,7 @@ func TestFPCFinalizedEvent(t *testing.T) {
 	paras.FinalizationThreshold = 2
 	paras.CoolingOffPeriod = 2
 	paras.QuerySampleSize = 1
-	voter := fpc.New(opinionGiverFunc, paras)
+	voter := fpc.New(opinionGiverFunc, nil, paras)
 	var finalizedOpinion *opinion.Opinion
 	voter.Events().Finalized.Attach(events.NewClosure(func(ev *vote.OpinionEvent) {
 		finalizedOpinion = &ev.Opinion
@@ -129,7 +129,7 @@ func TestFPCFailedEvent(t *testing.T) {
 	// since the finalization threshold is over max rounds it will
 	// always fail finalizing an opinion
 	paras.FinalizationThreshold = 4
-	voter := fpc.New(opinionGiverFunc, paras)
+	voter := fpc.New(opinionGiverFunc, nil, paras)
 	var failedOpinion *opinion.Opinion
 	voter.Events().Failed.Attach(events.NewClosure(func(ev *vote.OpinionEvent) {
 		failedOpinion = &ev.Opinion
@@ -170,7 +170,7 @@ func TestFPCVotingMultipleOpinionGivers(t *testing.T) {
 		paras := fpc.DefaultParameters()
 		paras.FinalizationThreshold = 2
 		paras.CoolingOffPeriod = 2
-		voter := fpc.New(opinionGiverFunc, paras)
+		voter := fpc.New(opinionGiverFunc, nil, paras)
 		var finalOpinion *opinion.Opinion
 		voter.Events().Finalized.Attach(events.NewClosure(func(ev *vote.OpinionEvent) {
 			finalOpinion = &ev.Opinion
@@ -189,3 +189,147 @@ func TestFPCVotingMultipleOpinionGivers(t *testing.T) {
 		assert.Equal(t, test.expectedOpinion, *finalOpinion)
 	}
 }
+
+type weightedopiniongivermock struct {
+	id      identity.ID
+	opinion opinion.Opinion
+}
+
+func (w *weightedopiniongivermock) ID() identity.ID {
+	return w.id
+}
+
+func (w *weightedopiniongivermock) Query(_ context.Context, _ []string, _ []string) (opinion.Opinions, error) {
+	return opinion.Opinions{w.opinion}, nil
+}
+
+// typedopiniongivermock answers with one opinion for all conflicts and another one for all timestamps.
+type typedopiniongivermock struct {
+	id               identity.ID
+	conflictOpinion  opinion.Opinion
+	timestampOpinion opinion.Opinion
+}
+
+func (o *typedopiniongivermock) ID() identity.ID {
+	return o.id
+}
+
+func (o *typedopiniongivermock) Query(_ context.Context, conflictIDs []string, timestampIDs []string) (opinions opinion.Opinions, err error) {
+	for range conflictIDs {
+		opinions = append(opinions, o.conflictOpinion)
+	}
+	for range timestampIDs {
+		opinions = append(opinions, o.timestampOpinion)
+	}
+	return opinions, nil
+}
+
+func TestFPCConflictsAndTimestamps(t *testing.T) {
+	opinionGiver := &typedopiniongivermock{id: identity.GenerateIdentity().ID(), conflictOpinion: opinion.Like, timestampOpinion: opinion.Dislike}
+	opinionGiverFunc := func() (givers []opinion.OpinionGiver, err error) {
+		return []opinion.OpinionGiver{opinionGiver}, nil
+	}
+
+	voter := fpc.New(opinionGiverFunc, nil)
+	var roundStats *vote.RoundStats
+	voter.Events().RoundExecuted.Attach(events.NewClosure(func(stats *vote.RoundStats) {
+		roundStats = stats
+	}))
+	require.NoError(t, voter.Vote("conflict", vote.ConflictType, opinion.Like))
+	require.NoError(t, voter.Vote("timestamp", vote.TimestampType, opinion.Like))
+	require.NoError(t, voter.Round(0.5))
+
+	// the opinions on the timestamps follow the opinions on the conflicts in the answer of a query
+	require.NotNil(t, roundStats)
+	require.Len(t, roundStats.QueriedOpinions, 1)
+	assert.Equal(t, map[string]opinion.Opinion{"conflict": opinion.Like, "timestamp": opinion.Dislike}, roundStats.QueriedOpinions[0].Opinions)
+	assert.Equal(t, 1., roundStats.ActiveVoteContexts["conflict"].Liked)
+	assert.Equal(t, 0., roundStats.ActiveVoteContexts["timestamp"].Liked)
+}
+
+func TestFPCManaBasedSampling(t *testing.T) {
+	rich := &weightedopiniongivermock{id: identity.GenerateIdentity().ID(), opinion: opinion.Like}
+	poor := &weightedopiniongivermock{id: identity.GenerateIdentity().ID(), opinion: opinion.Like}
+	belowThreshold := &weightedopiniongivermock{id: identity.GenerateIdentity().ID(), opinion: opinion.Dislike}
+
+	opinionGiverFunc := func() (givers []opinion.OpinionGiver, err error) {
+		return []opinion.OpinionGiver{rich, poor, belowThreshold}, nil
+	}
+	manaRetrieverFunc := func() (map[identity.ID]float64, error) {
+		return map[identity.ID]float64{rich.id: 300, poor.id: 100, belowThreshold.id: 5}, nil
+	}
+
+	t.Run("WithReplacement", func(t *testing.T) {
+		paras := fpc.DefaultParameters()
+		paras.MinOpinionGiverMana = 10
+		voter := fpc.New(opinionGiverFunc, manaRetrieverFunc, paras)
+
+		var roundStats *vote.RoundStats
+		voter.Events().RoundExecuted.Attach(events.NewClosure(func(stats *vote.RoundStats) {
+			roundStats = stats
+		}))
+		require.NoError(t, voter.Vote("a", vote.ConflictType, opinion.Like))
+		require.NoError(t, voter.Round(0.5))
+
+		require.NotNil(t, roundStats)
+		assert.Equal(t, map[string]float64{rich.id.String(): 0.75, poor.id.String(): 0.25}, roundStats.OpinionGiverWeights)
+
+		timesCounted := 0
+		for _, queriedOpinions := range roundStats.QueriedOpinions {
+			assert.NotEqual(t, belowThreshold.id.String(), queriedOpinions.OpinionGiverID)
+			timesCounted += queriedOpinions.TimesCounted
+		}
+		assert.Equal(t, paras.QuerySampleSize, timesCounted)
+	})
+
+	t.Run("WithoutReplacement", func(t *testing.T) {
+		paras := fpc.DefaultParameters()
+		paras.SampleWithReplacement = false
+		voter := fpc.New(opinionGiverFunc, manaRetrieverFunc, paras)
+
+		var roundStats *vote.RoundStats
+		voter.Events().RoundExecuted.Attach(events.NewClosure(func(stats *vote.RoundStats) {
+			roundStats = stats
+		}))
+		require.NoError(t, voter.Vote("a", vote.ConflictType, opinion.Like))
+		require.NoError(t, voter.Round(0.5))
+
+		require.NotNil(t, roundStats)
+		assert.Len(t, roundStats.QueriedOpinions, 3)
+		for _, queriedOpinions := range roundStats.QueriedOpinions {
+			assert.Equal(t, 1, queriedOpinions.TimesCounted)
+		}
+	})
+
+	t.Run("NoEligibleOpinionGivers", func(t *testing.T) {
+		paras := fpc.DefaultParameters()
+		paras.MinOpinionGiverMana = 1000
+		voter := fpc.New(opinionGiverFunc, manaRetrieverFunc, paras)
+
+		require.NoError(t, voter.Vote("a", vote.ConflictType, opinion.Like))
+		assert.True(t, errors.Is(voter.Round(0.5), fpc.ErrNoOpinionGiversAvailable))
+	})
+
+	t.Run("NoManaInNetwork", func(t *testing.T) {
+		paras := fpc.DefaultParameters()
+		paras.MinOpinionGiverMana = 1
+		noManaRetrieverFunc := func() (map[identity.ID]float64, error) {
+			return map[identity.ID]float64{}, nil
+		}
+		voter := fpc.New(opinionGiverFunc, noManaRetrieverFunc, paras)
+
+		var roundStats *vote.RoundStats
+		voter.Events().RoundExecuted.Attach(events.NewClosure(func(stats *vote.RoundStats) {
+			roundStats = stats
+		}))
+		require.NoError(t, voter.Vote("a", vote.ConflictType, opinion.Like))
+		require.NoError(t, voter.Round(0.5))
+
+		// all opinion givers are sampled uniformly as long as nobody has any mana
+		require.NotNil(t, roundStats)
+		assert.Len(t, roundStats.OpinionGiverWeights, 3)
+		for _, weight := range roundStats.OpinionGiverWeights {
+			assert.InDelta(t, 1./3, weight, 1e-9)
+		}
+	})
+}
@@ -22,6 +22,11 @@ type Parameters struct {
 	MaxRoundsPerVoteContext int
 	// The max amount of time a query is allowed to take.
 	QueryTimeout time.Duration
+	// Whether the same opinion giver can be selected multiple times within the same round.
+	SampleWithReplacement bool
+	// The minimum amount of mana an opinion giver needs to be selected when sampling proportionally to mana. It is
+	// ignored as long as none of the opinion givers has any mana.
+	MinOpinionGiverMana float64
 }
 
 // DefaultParameters returns the default parameters used in FPC.
@@ -36,6 +41,8 @@ func DefaultParameters() *Parameters {
 		CoolingOffPeriod:                    0,
 		MaxRoundsPerVoteContext:             100,
 		QueryTimeout:                        6500 * time.Millisecond,
+		SampleWithReplacement:               true,
+		MinOpinionGiverMana:                 0,
 	}
 }
 
@@ -30,6 +30,10 @@ type QueriedOpinions struct {
 // OpinionGiverFunc is a function which gives a slice of OpinionGivers or an error.
 type OpinionGiverFunc func() ([]OpinionGiver, error)
 
+// ManaRetrieverFunc is a function which gives the mana of the nodes used to weight the sampling of OpinionGivers or an
+// error.
+type ManaRetrieverFunc func() (map[identity.ID]float64, error)
+
 // Opinions is a slice of Opinion.
 type Opinions []Opinion
 
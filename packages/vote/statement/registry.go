@@ -3,6 +3,7 @@ package statement
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
@@ -18,12 +19,51 @@ import (
 type Registry struct {
 	nodesView map[identity.ID]*View
 	mu        sync.RWMutex
+	options   *RegistryOptions
+
+	acceptedStatements uint64
+	ignoredStatements  uint64
 }
 
 // NewRegistry returns a new registry.
-func NewRegistry() *Registry {
+func NewRegistry(optionalOptions ...RegistryOption) *Registry {
 	return &Registry{
 		nodesView: make(map[identity.ID]*View),
+		options:   newRegistryOptions(optionalOptions),
+	}
+}
+
+// AddStatement adds the conflicts and timestamps of the given Statement to the view of its issuer if the issuer has
+// enough mana. It returns true if the Statement was accepted.
+func (r *Registry) AddStatement(issuerID identity.ID, statement *Statement) (accepted bool) {
+	if !r.HasEnoughMana(issuerID) {
+		atomic.AddUint64(&r.ignoredStatements, 1)
+		return false
+	}
+	atomic.AddUint64(&r.acceptedStatements, 1)
+
+	issuerView := r.NodeView(issuerID)
+	issuerView.AddConflicts(statement.Conflicts)
+	issuerView.AddTimestamps(statement.Timestamps)
+
+	return true
+}
+
+// HasEnoughMana returns true if the given node has at least the mana required to accept or write statements. Every node
+// has enough mana if no mana threshold is configured.
+func (r *Registry) HasEnoughMana(nodeID identity.ID) bool {
+	if r.options.issuerManaFunc == nil {
+		return true
+	}
+
+	return r.options.issuerManaFunc(nodeID) >= r.options.manaThreshold
+}
+
+// Stats returns the number of statements that were accepted and ignored by the Registry.
+func (r *Registry) Stats() RegistryStats {
+	return RegistryStats{
+		AcceptedStatements: atomic.LoadUint64(&r.acceptedStatements),
+		IgnoredStatements:  atomic.LoadUint64(&r.ignoredStatements),
 	}
 }
 
@@ -84,6 +124,51 @@ func (r *Registry) Clean(d time.Duration) {
 
 // endregion /////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region RegistryOptions //////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerManaFunc is a function which returns the consensus mana of the given node.
+type IssuerManaFunc func(nodeID identity.ID) float64
+
+// RegistryOptions is a container for all configurable parameters of the Registry.
+type RegistryOptions struct {
+	issuerManaFunc IssuerManaFunc
+	manaThreshold  float64
+}
+
+// RegistryOption is a function which inits an option.
+type RegistryOption func(*RegistryOptions)
+
+// ManaThreshold creates an option which only accepts statements of issuers whose mana, as given by the IssuerManaFunc,
+// is at least the given threshold.
+func ManaThreshold(threshold float64, issuerManaFunc IssuerManaFunc) RegistryOption {
+	return func(args *RegistryOptions) {
+		args.manaThreshold = threshold
+		args.issuerManaFunc = issuerManaFunc
+	}
+}
+
+func newRegistryOptions(optionalOptions []RegistryOption) *RegistryOptions {
+	result := &RegistryOptions{}
+
+	for _, optionalOption := range optionalOptions {
+		optionalOption(result)
+	}
+
+	return result
+}
+
+// endregion /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RegistryStats ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// RegistryStats contains the number of statements that were accepted and ignored by the Registry.
+type RegistryStats struct {
+	AcceptedStatements uint64
+	IgnoredStatements  uint64
+}
+
+// endregion /////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region Entry /////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Entry defines the entry of a registry.
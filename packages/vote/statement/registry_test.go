@@ -37,3 +37,32 @@ func TestRegistry(t *testing.T) {
 	assert.Equal(t, 1, len(o))
 	assert.Equal(t, false, o.Finalized(2))
 }
+
+func TestRegistry_AddStatement(t *testing.T) {
+	richNode := identity.GenerateIdentity().ID()
+	poorNode := identity.GenerateIdentity().ID()
+	issuerMana := func(nodeID identity.ID) float64 {
+		if nodeID == richNode {
+			return 100
+		}
+		return 1
+	}
+	r := NewRegistry(ManaThreshold(10, issuerMana))
+
+	txA, err := ledgerstate.TransactionIDFromRandomness()
+	require.NoError(t, err)
+	statement := New(Conflicts{{txA, Opinion{opinion.Like, 1}}}, Timestamps{{tangle.EmptyMessageID, Opinion{opinion.Dislike, 1}}})
+
+	assert.True(t, r.HasEnoughMana(richNode))
+	assert.False(t, r.HasEnoughMana(poorNode))
+
+	assert.True(t, r.AddStatement(richNode, statement))
+	assert.False(t, r.AddStatement(poorNode, statement))
+
+	assert.Len(t, r.NodesView(), 1)
+	assert.Equal(t, Opinion{opinion.Like, 1}, r.NodeView(richNode).ConflictOpinion(txA).Last())
+	assert.Equal(t, Opinion{opinion.Dislike, 1}, r.NodeView(richNode).TimestampOpinion(tangle.EmptyMessageID).Last())
+	assert.Equal(t, RegistryStats{AcceptedStatements: 1, IgnoredStatements: 1}, r.Stats())
+
+	assert.True(t, NewRegistry().AddStatement(poorNode, statement))
+}
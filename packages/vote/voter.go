@@ -56,6 +56,9 @@ type RoundStats struct {
 	ActiveVoteContexts map[string]*Context `json:"active_vote_contexts"`
 	// The opinions which were queried during the round per opinion giver.
 	QueriedOpinions []opinion.QueriedOpinions `json:"queried_opinions"`
+	// The probability of each eligible opinion giver to be selected in a single draw of the round.
+	// The probabilities are proportional to the mana of the opinion givers if mana based sampling is used.
+	OpinionGiverWeights map[string]float64 `json:"opinion_giver_weights"`
 }
 
 // OpinionEvent is the struct containing data to be passed around with Finalized and Failed events.
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/iotaledger/goshimmer/plugins/autopeering/local"
 	"github.com/iotaledger/goshimmer/plugins/config"
@@ -50,6 +51,10 @@ var (
 	}{c: make(chan *server.Server, 1)}
 
 	networkVersion uint32
+
+	// peers that must not be selected or accepted as neighbors until the given time
+	blacklist      = make(map[identity.ID]time.Time)
+	blacklistMutex sync.Mutex
 )
 
 // Discovery returns the peer discovery instance.
@@ -109,8 +114,33 @@ func createPeerSel() {
 	)
 }
 
+// BlacklistPeer prevents the neighbor selection from choosing or accepting the given peer for the given duration.
+func BlacklistPeer(id identity.ID, duration time.Duration) {
+	blacklistMutex.Lock()
+	defer blacklistMutex.Unlock()
+
+	blacklist[id] = time.Now().Add(duration)
+}
+
+// isBlacklisted checks whether a peer is currently blacklisted and removes expired entries.
+func isBlacklisted(id identity.ID) bool {
+	blacklistMutex.Lock()
+	defer blacklistMutex.Unlock()
+
+	blacklistedUntil, blacklisted := blacklist[id]
+	if blacklisted && time.Now().After(blacklistedUntil) {
+		delete(blacklist, id)
+		return false
+	}
+	return blacklisted
+}
+
 // isValidNeighbor checks whether a peer is a valid neighbor.
 func isValidNeighbor(p *peer.Peer) bool {
+	// blacklisted peers are never valid
+	if isBlacklisted(p.ID()) {
+		return false
+	}
 	// gossip must be supported
 	gossipService := p.Services().Get(service.GossipKey)
 	if gossipService == nil {
@@ -7,11 +7,13 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/metrics"
 	votenet "github.com/iotaledger/goshimmer/packages/vote/net"
 	"github.com/iotaledger/goshimmer/packages/vote/opinion"
 	"github.com/iotaledger/goshimmer/packages/vote/statement"
 	"github.com/iotaledger/goshimmer/plugins/autopeering"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
 	"github.com/iotaledger/hive.go/identity"
@@ -84,6 +86,12 @@ func OpinionGiverFunc() (givers []opinion.OpinionGiver, err error) {
 	return opinionGivers, nil
 }
 
+// ManaRetrieverFunc returns the consensus mana of all nodes, which is used to weight the sampling of opinion givers.
+func ManaRetrieverFunc() (map[identity.ID]float64, error) {
+	manaMap, _, err := manaPlugin.GetManaMap(mana.ConsensusMana)
+	return manaMap, err
+}
+
 // endregion /////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region PeerOpinionGiver /////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -12,8 +12,22 @@ import (
 func OpinionRetriever(id string, objectType vote.ObjectType) opinion.Opinion {
 	switch objectType {
 	case vote.TimestampType:
-		// TODO: implement
-		return opinion.Like
+		messageID, err := tangle.NewMessageID(id)
+		if err != nil {
+			log.Errorf("received invalid vote request for timestamp '%s'", id)
+
+			return opinion.Unknown
+		}
+
+		timestampOpinion := opinion.Unknown
+		messagelayer.Tangle().Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+			if messageMetadata.TimestampOpinion().LoK == tangle.Pending {
+				return
+			}
+			timestampOpinion = messageMetadata.TimestampOpinion().Value
+		})
+
+		return timestampOpinion
 	default: // conflict type
 		transactionID, err := ledgerstate.TransactionIDFromBase58(id)
 		if err != nil {
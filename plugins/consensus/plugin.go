@@ -16,10 +16,12 @@ import (
 	"github.com/iotaledger/goshimmer/packages/vote/statement"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/local"
 	"github.com/iotaledger/goshimmer/plugins/config"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
 	flag "github.com/spf13/pflag"
@@ -41,6 +43,15 @@ const (
 	// CfgFPCBindAddress defines on which address the FPC service should listen.
 	CfgFPCBindAddress = "fpc.bindAddress"
 
+	// CfgFPCManaBasedSampling defines if the nodes to query are sampled proportionally to their consensus mana.
+	CfgFPCManaBasedSampling = "fpc.manaBasedSampling"
+
+	// CfgFPCSampleWithReplacement defines if the same node can be queried multiple times within a round.
+	CfgFPCSampleWithReplacement = "fpc.sampleWithReplacement"
+
+	// CfgFPCMinOpinionGiverMana defines the minimum consensus mana a node needs to be sampled as an opinion giver.
+	CfgFPCMinOpinionGiverMana = "fpc.minOpinionGiverMana"
+
 	// CfgWaitForStatement is the time in seconds for which the node wait for receiveing the new statement.
 	CfgWaitForStatement = "statement.waitForStatement"
 
@@ -62,6 +73,9 @@ func init() {
 	flag.Int(CfgFPCQuerySampleSize, 21, "Size of the voting quorum (k)")
 	flag.Int64(CfgFPCRoundInterval, 10, "FPC round interval [s]")
 	flag.String(CfgFPCBindAddress, "0.0.0.0:10895", "the bind address on which the FPC vote server binds to")
+	flag.Bool(CfgFPCManaBasedSampling, true, "if the nodes to query are sampled proportionally to their consensus mana")
+	flag.Bool(CfgFPCSampleWithReplacement, true, "if the same node can be queried multiple times within a round")
+	flag.Float64(CfgFPCMinOpinionGiverMana, 1., "the minimum consensus mana a node needs to be sampled as an opinion giver")
 	flag.Int(CfgWaitForStatement, 5, "the time in seconds for which the node wait for receiveing the new statement")
 	flag.Float64(CfgManaThreshold, 1., "Mana threshold to accept/write a statement")
 	flag.Int(CfgCleanInterval, 5, "the time in minutes after which the node cleans the statement registry")
@@ -118,6 +132,16 @@ func configure(_ *node.Plugin) {
 		log.Errorf("FCOB error: %s", err)
 	}))
 
+	// subscribe to timestamp opinion provider events
+	messagelayer.Tangle().TimestampOpinionProvider.Vote().Attach(events.NewClosure(func(id string, initOpn opinion.Opinion) {
+		if err := Voter().Vote(id, vote.TimestampType, initOpn); err != nil {
+			log.Warnf("FPC vote: %s", err)
+		}
+	}))
+	messagelayer.Tangle().TimestampOpinionProvider.VoteError().Attach(events.NewClosure(func(err error) {
+		log.Errorf("timestamp opinion provider error: %s", err)
+	}))
+
 	// subscribe to message-layer
 	messagelayer.Tangle().OpinionFormer.Events.MessageOpinionFormed.Attach(events.NewClosure(readStatement))
 }
@@ -129,7 +153,17 @@ func run(_ *node.Plugin) {
 // Voter returns the DRNGRoundBasedVoter instance used by the FPC plugin.
 func Voter() vote.DRNGRoundBasedVoter {
 	voterOnce.Do(func() {
-		voter = fpc.New(OpinionGiverFunc)
+		paras := fpc.DefaultParameters()
+		paras.QuerySampleSize = config.Node().Int(CfgFPCQuerySampleSize)
+		paras.SampleWithReplacement = config.Node().Bool(CfgFPCSampleWithReplacement)
+		paras.MinOpinionGiverMana = config.Node().Float64(CfgFPCMinOpinionGiverMana)
+
+		var manaRetrieverFunc opinion.ManaRetrieverFunc
+		if config.Node().Bool(CfgFPCManaBasedSampling) && !node.IsSkipped(manaPlugin.Plugin()) {
+			manaRetrieverFunc = ManaRetrieverFunc
+		}
+
+		voter = fpc.New(OpinionGiverFunc, manaRetrieverFunc, paras)
 	})
 	return voter
 }
@@ -137,11 +171,26 @@ func Voter() vote.DRNGRoundBasedVoter {
 // Registry returns the registry.
 func Registry() *statement.Registry {
 	registryOnce.Do(func() {
-		registry = statement.NewRegistry()
+		if node.IsSkipped(manaPlugin.Plugin()) {
+			registry = statement.NewRegistry()
+			return
+		}
+
+		registry = statement.NewRegistry(statement.ManaThreshold(config.Node().Float64(CfgManaThreshold), issuerMana))
 	})
 	return registry
 }
 
+// issuerMana returns the consensus mana of the given node or 0 if it is unknown.
+func issuerMana(nodeID identity.ID) float64 {
+	consensusMana, _, err := manaPlugin.GetConsensusMana(nodeID)
+	if err != nil {
+		return 0
+	}
+
+	return consensusMana
+}
+
 func configureFPC() {
 	if listen {
 		lPeer := local.GetInstance()
@@ -170,6 +219,7 @@ func configureFPC() {
 	}))
 
 	Voter().Events().Finalized.Attach(events.NewClosure(messagelayer.Tangle().PayloadOpinionProvider.ProcessVote))
+	Voter().Events().Finalized.Attach(events.NewClosure(messagelayer.Tangle().TimestampOpinionProvider.ProcessVote))
 	Voter().Events().Finalized.Attach(events.NewClosure(func(ev *vote.OpinionEvent) {
 		if ev.Ctx.Type == vote.ConflictType {
 			log.Infof("FPC finalized for transaction with id '%s' - final opinion: '%s'", ev.ID, ev.Opinion)
@@ -181,6 +231,8 @@ func configureFPC() {
 			log.Warnf("FPC failed for transaction with id '%s' - last opinion: '%s'", ev.ID, ev.Opinion)
 		}
 	}))
+	// a failed timestamp vote still has to settle the timestamp opinion, so that the message does not stay pending
+	Voter().Events().Failed.Attach(events.NewClosure(messagelayer.Tangle().TimestampOpinionProvider.ProcessVote))
 
 }
 
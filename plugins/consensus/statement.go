@@ -12,7 +12,10 @@ import (
 )
 
 func makeStatement(roundStats *vote.RoundStats) {
-	// TODO: add check for Mana threshold
+	// only nodes with enough mana write statements, as statements of other nodes are ignored anyway
+	if !Registry().HasEnoughMana(local.GetInstance().ID()) {
+		return
+	}
 
 	timestamps := statement.Timestamps{}
 	conflicts := statement.Conflicts{}
@@ -74,19 +77,17 @@ func readStatement(messageID tangle.MessageID) {
 			return
 		}
 
-		// TODO: check if the Mana threshold of the issuer is ok
-
 		issuerID := identity.NewID(msg.IssuerPublicKey())
 		// Skip ourselves
 		if issuerID == local.GetInstance().ID() {
 			return
 		}
 
-		issuerRegistry := Registry().NodeView(issuerID)
-
-		issuerRegistry.AddConflicts(statementPayload.Conflicts)
-
-		issuerRegistry.AddTimestamps(statementPayload.Timestamps)
+		// Skip statements of issuers below the mana threshold
+		if !Registry().AddStatement(issuerID, statementPayload) {
+			log.Debugf("ignored statement %s of %s: insufficient mana", msg.ID(), issuerID)
+			return
+		}
 
 		messagelayer.Tangle().Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
 			sendToRemoteLog(
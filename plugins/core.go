@@ -14,6 +14,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/gracefulshutdown"
 	"github.com/iotaledger/goshimmer/plugins/issuer"
 	"github.com/iotaledger/goshimmer/plugins/logger"
+	"github.com/iotaledger/goshimmer/plugins/mana"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
 	"github.com/iotaledger/goshimmer/plugins/metrics"
 	"github.com/iotaledger/goshimmer/plugins/portcheck"
@@ -39,6 +40,7 @@ var Core = node.Plugins(
 	pow.Plugin(),
 	clock.Plugin(),
 	messagelayer.Plugin(),
+	mana.Plugin(),
 	gossip.Plugin(),
 	issuer.Plugin(),
 	syncbeacon.Plugin(),
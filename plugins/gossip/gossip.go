@@ -73,12 +73,21 @@ func start(shutdownSignal <-chan struct{}) {
 	}
 	defer listener.Close()
 
+	// the manual peering is closed after the server, so that pending connection attempts are aborted
+	defer ManualPeering().Close()
+
 	srv := server.ServeTCP(lPeer, listener, log)
 	defer srv.Close()
 
 	mgr.Start(srv)
 	defer mgr.Close()
 
+	// connect to the manual peers of the config
+	startManualPeering()
+
+	Reputation().Start()
+	defer Reputation().Close()
+
 	// trigger start of the autopeering selection
 	go func() { autopeering.StartSelection() }()
 
@@ -122,6 +131,14 @@ func (r *requestedMessages) append(msgID tangle.MessageID) {
 	r.msgs[msgID] = types.Void
 }
 
+func (r *requestedMessages) contains(msgID tangle.MessageID) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	_, exist := r.msgs[msgID]
+	return exist
+}
+
 func (r *requestedMessages) delete(msgID tangle.MessageID) (deleted bool) {
 	r.Lock()
 	defer r.Unlock()
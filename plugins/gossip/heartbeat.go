@@ -0,0 +1,201 @@
+package gossip
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	pb "github.com/iotaledger/goshimmer/packages/gossip/proto"
+	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/goshimmer/plugins/syncbeaconfollower"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/lru_cache"
+	"github.com/iotaledger/hive.go/timeutil"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// the name of the heartbeat worker
+	heartbeatName = PluginName + "[Heartbeat]"
+
+	// maxHeartbeatMarkers defines the maximum number of solid markers that are advertised in a heartbeat.
+	maxHeartbeatMarkers = 16
+	// maxHeartbeatTips defines the maximum number of tips that are advertised in a heartbeat.
+	maxHeartbeatTips = 16
+
+	// heartbeatRequestsCacheSize defines how many messages that were requested due to a heartbeat are remembered.
+	heartbeatRequestsCacheSize = 1024
+	// heartbeatRequestTimeout defines the time after which a message advertised in a heartbeat is requested again.
+	heartbeatRequestTimeout = 1 * time.Minute
+)
+
+var (
+	heartbeatInterval time.Duration
+
+	solidMarkers = latestMarkers{markers: make(map[markers.SequenceID]*pb.Marker)}
+
+	// heartbeatRequests contains the time of the latest request of the messages that were advertised in heartbeats.
+	heartbeatRequests = lru_cache.NewLRUCache(heartbeatRequestsCacheSize)
+)
+
+// latestMarkers keeps track of the marker with the highest index of every sequence.
+type latestMarkers struct {
+	mu sync.Mutex
+
+	markers map[markers.SequenceID]*pb.Marker
+}
+
+// Update sets the given marker as the latest of its sequence, if its index is higher than the known one.
+func (l *latestMarkers) Update(marker *markers.Marker, messageID tangle.MessageID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if latest, exists := l.markers[marker.SequenceID()]; exists && latest.GetIndex() >= uint64(marker.Index()) {
+		return
+	}
+	l.markers[marker.SequenceID()] = &pb.Marker{
+		SequenceId: uint64(marker.SequenceID()),
+		Index:      uint64(marker.Index()),
+		MessageId:  messageID.Bytes(),
+	}
+}
+
+// Latest returns the markers with the highest indices and forgets about all the others.
+func (l *latestMarkers) Latest(count int) []*pb.Marker {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	result := make([]*pb.Marker, 0, len(l.markers))
+	for _, marker := range l.markers {
+		result = append(result, marker)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetIndex() > result[j].GetIndex()
+	})
+
+	if len(result) > count {
+		for _, marker := range result[count:] {
+			delete(l.markers, markers.SequenceID(marker.GetSequenceId()))
+		}
+		result = result[:count]
+	}
+	return result
+}
+
+func configureHeartbeat() {
+	// keep track of the latest markers of the booked (and therefore solid) messages
+	messagelayer.Tangle().Booker.Events.MessageBooked.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+		messagelayer.Tangle().Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+			if structureDetails := messageMetadata.StructureDetails(); structureDetails != nil && structureDetails.IsPastMarker {
+				solidMarkers.Update(structureDetails.PastMarkers.FirstMarker(), messageID)
+			}
+		})
+	}))
+
+	// request the advertised messages that are unknown to the node
+	Manager().Events().HeartbeatReceived.Attach(events.NewClosure(processHeartbeat))
+}
+
+func startHeartbeat(shutdownSignal <-chan struct{}) {
+	defer log.Infof("Stopping %s ... done", heartbeatName)
+
+	log.Infof("%s started: interval=%v", heartbeatName, heartbeatInterval)
+	timeutil.NewTicker(sendHeartbeat, heartbeatInterval, shutdownSignal).WaitForShutdown()
+	log.Infof("Stopping %s ...", heartbeatName)
+}
+
+// sendHeartbeat advertises the latest solid markers, the tips and the sync status to all neighbors.
+func sendHeartbeat() {
+	Manager().SendHeartbeat(newHeartbeat(tips.All(), solidMarkers.Latest(maxHeartbeatMarkers), syncbeaconfollower.Synced()))
+}
+
+// newHeartbeat creates a heartbeat that contains the digest of all the given tips and the most recent ones of them.
+func newHeartbeat(tipIDs []tangle.MessageID, solidMarkers []*pb.Marker, synced bool) *pb.Heartbeat {
+	heartbeat := &pb.Heartbeat{
+		SolidMarkers: solidMarkers,
+		TipsDigest:   tipsDigest(tipIDs),
+		Synced:       synced,
+	}
+
+	// the tips are ordered by their age, so the most recent ones are at the end
+	if len(tipIDs) > maxHeartbeatTips {
+		tipIDs = tipIDs[len(tipIDs)-maxHeartbeatTips:]
+	}
+	for _, tipID := range tipIDs {
+		heartbeat.Tips = append(heartbeat.Tips, tipID.Bytes())
+	}
+
+	return heartbeat
+}
+
+// tipsDigest returns a hash of the given tips that does not depend on their order, so that neighbors with the same tips
+// advertise the same digest.
+func tipsDigest(tipIDs []tangle.MessageID) []byte {
+	sortedIDs := make([]tangle.MessageID, len(tipIDs))
+	copy(sortedIDs, tipIDs)
+	sort.Slice(sortedIDs, func(i, j int) bool {
+		return bytes.Compare(sortedIDs[i][:], sortedIDs[j][:]) < 0
+	})
+
+	hash, _ := blake2b.New256(nil)
+	for _, id := range sortedIDs {
+		_, _ = hash.Write(id[:])
+	}
+	return hash.Sum(nil)
+}
+
+// processHeartbeat requests the tips and markers of a synced neighbor that are unknown to the node in batches.
+func processHeartbeat(event *gossip.HeartbeatReceivedEvent) {
+	if !event.Heartbeat.GetSynced() || bytes.Equal(event.Heartbeat.GetTipsDigest(), tipsDigest(tips.All())) {
+		return
+	}
+
+	// only consider as many tips and markers as an honest neighbor advertises (the most recent tips are at the end)
+	advertisedTips := event.Heartbeat.GetTips()
+	if len(advertisedTips) > maxHeartbeatTips {
+		advertisedTips = advertisedTips[len(advertisedTips)-maxHeartbeatTips:]
+	}
+	advertisedMarkers := event.Heartbeat.GetSolidMarkers()
+	if len(advertisedMarkers) > maxHeartbeatMarkers {
+		advertisedMarkers = advertisedMarkers[:maxHeartbeatMarkers]
+	}
+
+	advertisedIDs := make([][]byte, 0, len(advertisedTips)+len(advertisedMarkers))
+	advertisedIDs = append(advertisedIDs, advertisedTips...)
+	for _, marker := range advertisedMarkers {
+		advertisedIDs = append(advertisedIDs, marker.GetMessageId())
+	}
+
+	var missingIDs [][]byte
+	for _, advertisedID := range advertisedIDs {
+		msgID, _, err := tangle.MessageIDFromBytes(advertisedID)
+		if err != nil {
+			penalize(event.Peer, gossip.InvalidMessage)
+			return
+		}
+		if requestedMsgs.contains(msgID) || recentlyRequestedInHeartbeat(msgID) || messagelayer.Tangle().Storage.Message(msgID).Consume(func(*tangle.Message) {}) {
+			continue
+		}
+
+		heartbeatRequests.Set(msgID, clock.SyncedTime())
+		missingIDs = append(missingIDs, msgID.Bytes())
+	}
+
+	if len(missingIDs) > 0 {
+		log.Debugw("requesting messages advertised in heartbeat", "id", event.Peer.ID(), "count", len(missingIDs))
+		Manager().RequestMessages(missingIDs, event.Peer.ID())
+	}
+}
+
+// recentlyRequestedInHeartbeat checks if the given message was requested due to a heartbeat within the
+// heartbeatRequestTimeout.
+func recentlyRequestedInHeartbeat(msgID tangle.MessageID) bool {
+	requestTime := heartbeatRequests.Get(msgID)
+
+	return requestTime != nil && clock.Since(requestTime.(time.Time)) < heartbeatRequestTimeout
+}
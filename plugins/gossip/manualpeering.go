@@ -0,0 +1,89 @@
+package gossip
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	"github.com/iotaledger/goshimmer/plugins/config"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/autopeering/peer/service"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/mr-tron/base58"
+)
+
+// ErrParsingManualPeer is returned for an invalid manual peer.
+var ErrParsingManualPeer = errors.New("cannot parse manual peer")
+
+var (
+	manualPeering     *gossip.ManualPeering
+	manualPeeringOnce sync.Once
+)
+
+// ManualPeering returns the instance that keeps the node connected to the manual peers.
+func ManualPeering() *gossip.ManualPeering {
+	manualPeeringOnce.Do(createManualPeering)
+	return manualPeering
+}
+
+func createManualPeering() {
+	manualPeering = gossip.NewManualPeering(Manager(), logger.NewLogger(PluginName).Named("manual"))
+}
+
+// startManualPeering adds the manual peers of the config.
+func startManualPeering() {
+	for _, definition := range config.Node().Strings(CfgGossipManualPeers) {
+		if definition == "" {
+			continue
+		}
+
+		p, err := ParseManualPeer(definition)
+		if err != nil {
+			log.Errorf("Invalid manual peer; ignoring: %v", err)
+			continue
+		}
+		if err := ManualPeering().AddPeer(p); err != nil {
+			log.Errorf("Failed to add manual peer %s: %v", definition, err)
+		}
+	}
+}
+
+// ParseManualPeer parses a peer definition of the form publicKey@host:gossipPort.
+func ParseManualPeer(definition string) (*peer.Peer, error) {
+	parts := strings.Split(definition, "@")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: manual peer parts must be 2, is %d", ErrParsingManualPeer, len(parts))
+	}
+	publicKey, err := ParsePublicKey(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrParsingManualPeer, err)
+	}
+	addr, err := net.ResolveTCPAddr("tcp", parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: host cannot be resolved: %s", ErrParsingManualPeer, err)
+	}
+
+	services := service.New()
+	services.Update(service.GossipKey, addr.Network(), addr.Port)
+
+	return peer.NewPeer(identity.New(publicKey), addr.IP, services), nil
+}
+
+// ParsePublicKey parses the base58 encoded public key of a peer.
+func ParsePublicKey(publicKeyString string) (publicKey ed25519.PublicKey, err error) {
+	bytes, err := base58.Decode(publicKeyString)
+	if err != nil {
+		return publicKey, fmt.Errorf("invalid public key: %w", err)
+	}
+	if len(bytes) != ed25519.PublicKeySize {
+		return publicKey, fmt.Errorf("invalid public key: length must be %d, is %d", ed25519.PublicKeySize, len(bytes))
+	}
+	publicKey, _, err = ed25519.PublicKeyFromBytes(bytes)
+
+	return
+}
@@ -3,6 +3,7 @@ package gossip
 import (
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/gossip"
 	flag "github.com/spf13/pflag"
 )
 
@@ -13,10 +14,22 @@ const (
 	CfgGossipAgeThreshold = "gossip.ageThreshold"
 	// CfgGossipTipsBroadcastInterval the interval in which the oldest known tip is re-broadcast.
 	CfgGossipTipsBroadcastInterval = "gossip.tipsBroadcaster.interval"
+	// CfgGossipHeartbeatInterval defines the interval in which the solid markers, tips and sync status are advertised.
+	CfgGossipHeartbeatInterval = "gossip.heartbeat.interval"
+	// CfgGossipManualPeers defines the config flag of the static peers that the node keeps connected to.
+	CfgGossipManualPeers = "gossip.manualPeers"
+	// CfgGossipReputationThreshold defines the config flag of the score below which a neighbor gets blacklisted.
+	CfgGossipReputationThreshold = "gossip.reputation.threshold"
+	// CfgGossipBlacklistCooldown defines the config flag of the duration for which a misbehaving neighbor is blacklisted.
+	CfgGossipBlacklistCooldown = "gossip.reputation.blacklistCooldown"
 )
 
 func init() {
 	flag.Int(CfgGossipPort, 14666, "tcp port for gossip connection")
 	flag.Duration(CfgGossipAgeThreshold, 5*time.Second, "message age threshold for gossip")
 	flag.Duration(CfgGossipTipsBroadcastInterval, 10*time.Second, "the interval in which the oldest known tip is re-broadcast")
+	flag.Duration(CfgGossipHeartbeatInterval, 10*time.Second, "the interval in which the solid markers, tips and sync status are advertised to the neighbors")
+	flag.StringSlice(CfgGossipManualPeers, []string{}, "list of static gossip neighbors in the form publicKey@host:gossipPort (both nodes need to add each other)")
+	flag.Float64(CfgGossipReputationThreshold, gossip.DefaultReputationThreshold, "the score below which a misbehaving neighbor gets dropped and blacklisted")
+	flag.Duration(CfgGossipBlacklistCooldown, gossip.DefaultBlacklistCooldown, "the duration for which a misbehaving neighbor is blacklisted")
 }
@@ -1,6 +1,7 @@
 package gossip
 
 import (
+	"errors"
 	"sync"
 	"time"
 
@@ -46,11 +47,17 @@ func configure(*node.Plugin) {
 	log = logger.NewLogger(PluginName)
 	ageThreshold = config.Node().Duration(CfgGossipAgeThreshold)
 	tipsBroadcasterInterval = config.Node().Duration(CfgGossipTipsBroadcastInterval)
+	heartbeatInterval = config.Node().Duration(CfgGossipHeartbeatInterval)
 	requestedMsgs = newRequestedMessages()
 
+	// assure that the manual peering is instantiated before any neighbor gets added
+	ManualPeering()
+
 	configureLogging()
 	configureMessageLayer()
 	configureAutopeering()
+	configureReputation()
+	configureHeartbeat()
 }
 
 func run(*node.Plugin) {
@@ -60,6 +67,9 @@ func run(*node.Plugin) {
 	if err := daemon.BackgroundWorker(tipsBroadcasterName, startTipBroadcaster, shutdown.PriorityGossip); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
+	if err := daemon.BackgroundWorker(heartbeatName, startHeartbeat, shutdown.PriorityGossip); err != nil {
+		log.Panicf("Failed to start as daemon: %s", err)
+	}
 }
 
 func configureAutopeering() {
@@ -69,6 +79,10 @@ func configureAutopeering() {
 	// link to the autopeering events
 	peerSel := autopeering.Selection()
 	peerSel.Events().Dropped.Attach(events.NewClosure(func(ev *selection.DroppedEvent) {
+		// the connections to manual peers are not controlled by the autopeering
+		if ManualPeering().IsManualPeer(ev.DroppedID) {
+			return
+		}
 		go func() {
 			if err := mgr.DropNeighbor(ev.DroppedID); err != nil {
 				log.Debugw("error dropping neighbor", "id", ev.DroppedID, "err", err)
@@ -76,8 +90,8 @@ func configureAutopeering() {
 		}()
 	}))
 	peerSel.Events().IncomingPeering.Attach(events.NewClosure(func(ev *selection.PeeringEvent) {
-		if !ev.Status {
-			return // ignore rejected peering
+		if !ev.Status || ManualPeering().IsManualPeer(ev.Peer.ID()) {
+			return // ignore rejected peering and manual peers
 		}
 		go func() {
 			if err := mgr.AddInbound(ev.Peer); err != nil {
@@ -86,8 +100,8 @@ func configureAutopeering() {
 		}()
 	}))
 	peerSel.Events().OutgoingPeering.Attach(events.NewClosure(func(ev *selection.PeeringEvent) {
-		if !ev.Status {
-			return // ignore rejected peering
+		if !ev.Status || ManualPeering().IsManualPeer(ev.Peer.ID()) {
+			return // ignore rejected peering and manual peers
 		}
 		go func() {
 			if err := mgr.AddOutbound(ev.Peer); err != nil {
@@ -111,6 +125,11 @@ func configureLogging() {
 
 	// log the gossip events
 	mgr.Events().ConnectionFailed.Attach(events.NewClosure(func(p *peer.Peer, err error) {
+		// manual peers that are offline fail to connect repeatedly
+		if ManualPeering().IsManualPeer(p.ID()) {
+			log.Debugf("Connection to manual neighbor %s / %s failed: %s", gossip.GetAddress(p), p.ID(), err)
+			return
+		}
 		log.Infof("Connection to neighbor %s / %s failed: %s", gossip.GetAddress(p), p.ID(), err)
 	}))
 	mgr.Events().NeighborAdded.Attach(events.NewClosure(func(n *gossip.Neighbor) {
@@ -139,7 +158,7 @@ func configureMessageLayer() {
 				}
 
 				// do not gossip requested messages
-				if requested := requestedMsgs.delete(messageID); requested {
+				if requested := requestedMsgs.delete(messageID); requested || heartbeatRequests.Delete(messageID) {
 					return
 				}
 
@@ -148,13 +167,44 @@ func configureMessageLayer() {
 		})
 	}))
 
-	// request missing messages
-	messagelayer.Tangle().Requester.Events.SendRequest.Attach(events.NewClosure(func(sendRequest *tangle.SendRequestEvent) {
-		mgr.RequestMessage(sendRequest.ID[:])
+	// request missing messages from one neighbor at a time
+	messagelayer.Tangle().Requester.Events.SendRequest.Attach(events.NewClosure(requestMessage))
+	messagelayer.Tangle().Requester.Events.RequestFailed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+		log.Warnf("Message %s could not be retrieved from any neighbor", messageID)
 	}))
 
 	messagelayer.Tangle().Storage.Events.MissingMessageStored.Attach(events.NewClosure(requestedMsgs.append))
 
+	// keep track of the new and duplicate messages that the neighbors send
+	messagelayer.Tangle().Parser.Events.MessageParsed.Attach(events.NewClosure(func(event *tangle.MessageParsedEvent) {
+		storeRequestHints(event.Message, event.Peer)
+		if nbr := neighbor(event.Peer); nbr != nil {
+			messageID := event.Message.ID()
+			nbr.Stats().CountNewMessage(messageID[:])
+		}
+	}))
+	messagelayer.Tangle().Parser.Events.BytesRejected.Attach(events.NewClosure(func(event *tangle.BytesRejectedEvent, err error) {
+		if !errors.Is(err, tangle.ErrReceivedDuplicateBytes) {
+			return
+		}
+		if nbr := neighbor(event.Peer); nbr != nil {
+			nbr.Stats().CountDuplicateMessage()
+		}
+	}))
+
 	// delete the message from requestedMsgs if it's invalid, otherwise it will always be in the list and never get removed in some cases.
 	messagelayer.Tangle().Events.MessageInvalid.Attach(events.NewClosure(func(messageID tangle.MessageID) { requestedMsgs.delete(messageID) }))
 }
+
+// neighbor returns the neighbor that corresponds to the given peer or nil if the peer is not connected.
+func neighbor(p *peer.Peer) *gossip.Neighbor {
+	if p == nil {
+		return nil
+	}
+
+	nbr, err := Manager().Neighbor(p.ID())
+	if err != nil {
+		return nil
+	}
+	return nbr
+}
@@ -0,0 +1,77 @@
+package gossip
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/autopeering"
+	"github.com/iotaledger/goshimmer/plugins/config"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+var (
+	reputation     *gossip.Reputation
+	reputationOnce sync.Once
+)
+
+// Reputation returns the instance that keeps track of the misbehavior of the neighbors.
+func Reputation() *gossip.Reputation {
+	reputationOnce.Do(createReputation)
+	return reputation
+}
+
+func createReputation() {
+	reputation = gossip.NewReputation(Manager(),
+		gossip.ReputationThreshold(config.Node().Float64(CfgGossipReputationThreshold)),
+		gossip.BlacklistCooldown(config.Node().Duration(CfgGossipBlacklistCooldown)),
+	)
+}
+
+func configureReputation() {
+	// drop misbehaving neighbors and keep the autopeering from selecting them again
+	Reputation().Events.PeerBlacklisted.Attach(events.NewClosure(func(id identity.ID, misbehavior gossip.Misbehavior) {
+		cooldown := config.Node().Duration(CfgGossipBlacklistCooldown)
+		log.Infof("Blacklisting neighbor %s for %v due to %s", id, cooldown, misbehavior)
+
+		autopeering.BlacklistPeer(id, cooldown)
+		go func() {
+			if err := Manager().DropNeighbor(id); err != nil {
+				log.Debugw("error dropping blacklisted neighbor", "id", id, "err", err)
+			}
+		}()
+	}))
+
+	// penalize the neighbors that send invalid messages (old messages are not penalized, as honest neighbors rebroadcast
+	// their oldest tips and answer requests with the same packets)
+	messagelayer.Tangle().Parser.Events.BytesRejected.Attach(events.NewClosure(func(event *tangle.BytesRejectedEvent, err error) {
+		switch {
+		case errors.Is(err, tangle.ErrReceivedDuplicateBytes):
+			// duplicates are expected from honest neighbors and floods are detected from the neighbor stats
+		case errors.Is(err, tangle.ErrInvalidPOWDifficultly):
+			penalize(event.Peer, gossip.InvalidPoW)
+		default:
+			penalize(event.Peer, gossip.InvalidMessage)
+		}
+	}))
+	messagelayer.Tangle().Parser.Events.MessageRejected.Attach(events.NewClosure(func(event *tangle.MessageRejectedEvent, err error) {
+		if errors.Is(err, tangle.ErrInvalidSignature) {
+			penalize(event.Peer, gossip.InvalidSignature)
+			return
+		}
+		penalize(event.Peer, gossip.InvalidMessage)
+	}))
+}
+
+// penalize lowers the reputation of the given peer. Manual peers are trusted and therefore never penalized.
+func penalize(p *peer.Peer, misbehavior gossip.Misbehavior) {
+	if p == nil || ManualPeering().IsManualPeer(p.ID()) {
+		return
+	}
+
+	Reputation().Penalize(p.ID(), misbehavior)
+}
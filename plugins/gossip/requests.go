@@ -0,0 +1,69 @@
+package gossip
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/lru_cache"
+)
+
+// requestHintCacheSize defines how many referenced messages are remembered together with the neighbor that sent the
+// referencing message.
+const requestHintCacheSize = 10000
+
+// requestHints maps the parents of the received messages to the neighbor that sent them, as this neighbor is the most
+// likely to also know the parents.
+var requestHints = lru_cache.NewLRUCache(requestHintCacheSize)
+
+// storeRequestHints remembers the given peer as the preferred source of the parents of the given message.
+func storeRequestHints(msg *tangle.Message, p *peer.Peer) {
+	if p == nil {
+		return
+	}
+
+	msg.ForEachParent(func(parent tangle.Parent) {
+		requestHints.Set(parent.ID, p.ID())
+	})
+}
+
+// requestMessage sends the request for a missing message to a single neighbor. The first attempt goes to the neighbor
+// that sent the referencing message, every further attempt rotates through the remaining neighbors.
+func requestMessage(event *tangle.SendRequestEvent) {
+	neighbors := requestCandidates(event.ID)
+	if len(neighbors) == 0 {
+		return
+	}
+
+	target := neighbors[event.Count%len(neighbors)]
+	Manager().RequestMessage(event.ID[:], target.ID())
+}
+
+// requestCandidates returns the connected neighbors in a deterministic order with the preferred neighbor for the given
+// message at the front.
+func requestCandidates(messageID tangle.MessageID) []*gossip.Neighbor {
+	neighbors := Manager().AllNeighbors()
+	sort.Slice(neighbors, func(i, j int) bool {
+		iID, jID := neighbors[i].ID(), neighbors[j].ID()
+		return bytes.Compare(iID[:], jID[:]) < 0
+	})
+
+	hint := requestHints.Get(messageID)
+	if hint == nil {
+		return neighbors
+	}
+
+	preferred := hint.(identity.ID)
+	for i, nbr := range neighbors {
+		if nbr.ID() == preferred {
+			// move the preferred neighbor to the front while keeping the order of the others
+			copy(neighbors[1:i+1], neighbors[:i])
+			neighbors[0] = nbr
+			break
+		}
+	}
+	return neighbors
+}
@@ -54,6 +54,18 @@ func (s *tiplist) RemoveTip(id tangle.MessageID) {
 	}
 }
 
+// All returns the IDs of all tips ordered by the time they were added.
+func (s *tiplist) All() []tangle.MessageID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]tangle.MessageID, 0, s.list.Len())
+	for elem := s.list.Front(); elem != nil; elem = elem.Next() {
+		result = append(result, elem.Value.(tangle.MessageID))
+	}
+	return result
+}
+
 func (s *tiplist) Next() tangle.MessageID {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -0,0 +1,32 @@
+package mana
+
+import (
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// CfgEmaCoefficientAccess defines the coefficient used for the effective access mana calculation.
+	CfgEmaCoefficientAccess = "mana.emaCoefficientAccess"
+
+	// CfgEmaCoefficientConsensus defines the coefficient used for the effective consensus mana calculation.
+	CfgEmaCoefficientConsensus = "mana.emaCoefficientConsensus"
+
+	// CfgDecay defines the decay rate (per second) of the access base mana.
+	CfgDecay = "mana.decay"
+
+	// CfgPledgeLogSize defines the number of transactions whose pledge events are kept in memory.
+	CfgPledgeLogSize = "mana.pledgeLogSize"
+
+	// CfgStoreInterval defines the interval in which the mana of all nodes is persisted.
+	CfgStoreInterval = "mana.storeInterval"
+)
+
+func init() {
+	flag.Float64(CfgEmaCoefficientAccess, 0.00003209, "coefficient used for effective access mana calculation")
+	flag.Float64(CfgEmaCoefficientConsensus, 0.00003209, "coefficient used for effective consensus mana calculation")
+	flag.Float64(CfgDecay, 0.00003209, "decay rate (per second) of the access base mana")
+	flag.Int(CfgPledgeLogSize, 1000, "number of transactions whose pledge events are kept in memory")
+	flag.Duration(CfgStoreInterval, time.Minute, "interval in which the mana of all nodes is persisted")
+}
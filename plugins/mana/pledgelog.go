@@ -0,0 +1,99 @@
+package mana
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+// region PledgeLogEntry ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// PledgeLogEntry represents a single pledge or revocation of mana that was caused by a Transaction.
+type PledgeLogEntry struct {
+	// Revoked is true if the mana was revoked from the node instead of being pledged to it.
+	Revoked       bool
+	NodeID        identity.ID
+	Amount        float64
+	Time          time.Time
+	ManaType      mana.Type
+	TransactionID ledgerstate.TransactionID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region pledgeLog ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// pledgeLog keeps the PledgeLogEntries of the most recent Transactions in memory. The Transactions are kept in a ring
+// buffer of fixed size, so the log never grows beyond maxTransactions Transactions.
+type pledgeLog struct {
+	entries        map[ledgerstate.TransactionID][]PledgeLogEntry
+	transactionIDs []ledgerstate.TransactionID
+	// next is the position in the ring buffer that is written next, which is also the position of the oldest
+	// Transaction once the buffer is full.
+	next  int
+	count int
+	mutex sync.RWMutex
+}
+
+// newPledgeLog creates a pledgeLog that keeps the entries of up to maxTransactions Transactions.
+func newPledgeLog(maxTransactions int) *pledgeLog {
+	if maxTransactions < 0 {
+		maxTransactions = 0
+	}
+
+	return &pledgeLog{
+		entries:        make(map[ledgerstate.TransactionID][]PledgeLogEntry),
+		transactionIDs: make([]ledgerstate.TransactionID, maxTransactions),
+	}
+}
+
+// add adds a PledgeLogEntry to the log and evicts the entries of the oldest Transaction if the log is full.
+func (p *pledgeLog) add(entry PledgeLogEntry) {
+	if len(p.transactionIDs) == 0 {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, exists := p.entries[entry.TransactionID]; !exists {
+		if p.count == len(p.transactionIDs) {
+			delete(p.entries, p.transactionIDs[p.next])
+		} else {
+			p.count++
+		}
+		p.transactionIDs[p.next] = entry.TransactionID
+		p.next = (p.next + 1) % len(p.transactionIDs)
+	}
+	p.entries[entry.TransactionID] = append(p.entries[entry.TransactionID], entry)
+}
+
+// transactionEntries returns the PledgeLogEntries of the given Transaction.
+func (p *pledgeLog) transactionEntries(transactionID ledgerstate.TransactionID) (entries []PledgeLogEntry) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return append(entries, p.entries[transactionID]...)
+}
+
+// allEntries returns all PledgeLogEntries in the order in which their Transactions were logged.
+func (p *pledgeLog) allEntries() (entries []PledgeLogEntry) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if p.count == 0 {
+		return
+	}
+
+	oldest := (p.next - p.count + len(p.transactionIDs)) % len(p.transactionIDs)
+	for i := 0; i < p.count; i++ {
+		entries = append(entries, p.entries[p.transactionIDs[(oldest+i)%len(p.transactionIDs)]]...)
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
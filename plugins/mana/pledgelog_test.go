@@ -0,0 +1,41 @@
+package mana
+
+import (
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPledgeLog(t *testing.T) {
+	log := newPledgeLog(2)
+
+	txA, txB, txC := ledgerstate.TransactionID{1}, ledgerstate.TransactionID{2}, ledgerstate.TransactionID{3}
+	log.add(PledgeLogEntry{TransactionID: txA, ManaType: mana.AccessMana, Amount: 1})
+	log.add(PledgeLogEntry{TransactionID: txA, ManaType: mana.ConsensusMana, Amount: 1})
+	log.add(PledgeLogEntry{TransactionID: txB, ManaType: mana.ConsensusMana, Amount: 2})
+
+	assert.Len(t, log.transactionEntries(txA), 2)
+	assert.Len(t, log.allEntries(), 3)
+
+	log.add(PledgeLogEntry{TransactionID: txC, ManaType: mana.ConsensusMana, Revoked: true, Amount: 3})
+
+	assert.Empty(t, log.transactionEntries(txA))
+	assert.Equal(t, []PledgeLogEntry{
+		{TransactionID: txB, ManaType: mana.ConsensusMana, Amount: 2},
+		{TransactionID: txC, ManaType: mana.ConsensusMana, Revoked: true, Amount: 3},
+	}, log.allEntries())
+
+	// the ring buffer wraps around without growing
+	txD := ledgerstate.TransactionID{4}
+	log.add(PledgeLogEntry{TransactionID: txD, ManaType: mana.AccessMana, Amount: 4})
+	assert.Equal(t, []PledgeLogEntry{
+		{TransactionID: txC, ManaType: mana.ConsensusMana, Revoked: true, Amount: 3},
+		{TransactionID: txD, ManaType: mana.AccessMana, Amount: 4},
+	}, log.allEntries())
+	assert.Len(t, log.entries, 2)
+	assert.Len(t, log.transactionIDs, 2)
+
+	assert.Empty(t, newPledgeLog(0).allEntries())
+}
@@ -0,0 +1,431 @@
+package mana
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/plugins/config"
+	databaseplugin "github.com/iotaledger/goshimmer/plugins/database"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/timeutil"
+	"github.com/iotaledger/hive.go/types"
+	"golang.org/x/xerrors"
+)
+
+// PluginName is the name of the mana plugin.
+const PluginName = "Mana"
+
+var (
+	// plugin is the plugin instance of the mana plugin.
+	plugin          *node.Plugin
+	pluginOnce      sync.Once
+	log             *logger.Logger
+	baseManaVectors map[mana.Type]*mana.BaseManaVector
+	storage         *objectstorage.ObjectStorage
+	revertedStorage *objectstorage.ObjectStorage
+	pledgeStorage   *objectstorage.ObjectStorage
+	pledges         *pledgeLog
+)
+
+// Plugin gets the plugin instance.
+func Plugin() *node.Plugin {
+	pluginOnce.Do(func() {
+		plugin = node.NewPlugin(PluginName, node.Enabled, configure, run)
+	})
+	return plugin
+}
+
+func configure(*node.Plugin) {
+	log = logger.NewLogger(PluginName)
+
+	mana.SetCoefficients(
+		config.Node().Float64(CfgEmaCoefficientAccess),
+		config.Node().Float64(CfgEmaCoefficientConsensus),
+		config.Node().Float64(CfgDecay),
+	)
+
+	pledges = newPledgeLog(config.Node().Int(CfgPledgeLogSize))
+	baseManaVectors = make(map[mana.Type]*mana.BaseManaVector)
+	for _, manaType := range []mana.Type{mana.AccessMana, mana.ConsensusMana} {
+		baseManaVector, err := mana.NewBaseManaVector(manaType)
+		if err != nil {
+			log.Panicf("failed to create BaseManaVector: %s", err)
+		}
+		baseManaVectors[manaType] = baseManaVector
+	}
+
+	storageFactory := objectstorage.NewFactory(databaseplugin.Store(), database.PrefixMana)
+	storage = storageFactory.New(mana.PrefixBaseManaStorage, mana.PersistableBaseManaFromObjectStorage, mana.BaseManaStorageOptions...)
+	revertedStorage = storageFactory.New(mana.PrefixRevertedTransactionStorage, mana.PersistableRevertedTransactionFromObjectStorage, mana.RevertedTransactionStorageOptions...)
+	pledgeStorage = storageFactory.New(mana.PrefixTransactionPledgeStorage, mana.PersistableTransactionPledgeFromObjectStorage, mana.TransactionPledgeStorageOptions...)
+	loadBaseManaVectors()
+	loadSnapshotBaseManas()
+
+	configureEvents()
+}
+
+func run(*node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(shutdownSignal <-chan struct{}) {
+		// persist the mana regularly, so that it is not lost if the node crashes
+		timeutil.NewTicker(func() {
+			storeBaseManaVectors()
+			storage.Flush()
+			revertedStorage.Flush()
+		}, config.Node().Duration(CfgStoreInterval), shutdownSignal).WaitForShutdown()
+
+		storeBaseManaVectors()
+		storage.Shutdown()
+		revertedStorage.Shutdown()
+		pledgeStorage.Shutdown()
+	}, shutdown.PriorityMana); err != nil {
+		log.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+func configureEvents() {
+	messagelayer.Tangle().Scheduler.SetAccessManaRetrieveFunc(accessMana)
+	messagelayer.Tangle().ApprovalWeightManager.SetConsensusManaRetrieveFunc(consensusManaMap)
+
+	for _, baseManaVector := range baseManaVectors {
+		baseManaVector.Events.Pledged.Attach(events.NewClosure(func(ev *mana.PledgedEvent) {
+			pledges.add(PledgeLogEntry{
+				NodeID:        ev.NodeID,
+				Amount:        ev.Amount,
+				Time:          ev.Time,
+				ManaType:      ev.ManaType,
+				TransactionID: ev.TransactionID,
+			})
+		}))
+		baseManaVector.Events.Revoked.Attach(events.NewClosure(func(ev *mana.RevokedEvent) {
+			pledges.add(PledgeLogEntry{
+				Revoked:       true,
+				NodeID:        ev.NodeID,
+				Amount:        ev.Amount,
+				Time:          ev.Time,
+				ManaType:      ev.ManaType,
+				TransactionID: ev.TransactionID,
+			})
+		}))
+	}
+
+	messagelayer.Tangle().LedgerState.UTXODAG.Events.TransactionBooked.Attach(events.NewClosure(func(ev *ledgerstate.TransactionBookedEvent) {
+		txInfo := mana.NewTxInfo(ev.Transaction, ev.Inputs, inputInfoProvider)
+		for _, baseManaVector := range baseManaVectors {
+			if err := baseManaVector.Book(txInfo); err != nil {
+				log.Warnf("failed to book %s mana of Transaction with %s: %s", baseManaVector.Type(), txInfo.TransactionID, err)
+			}
+		}
+	}))
+
+	// a rejected ConflictBranch rejects the conflicting Transaction together with all Transactions that spend its Outputs
+	messagelayer.Tangle().LedgerState.BranchDAG.Events.BranchRejected.Attach(events.NewClosure(func(ev *ledgerstate.BranchDAGEvent) {
+		ev.Branch.Consume(func(branch ledgerstate.Branch) {
+			if branch.Type() != ledgerstate.ConflictBranchType {
+				return
+			}
+
+			// spenders are reverted before the Transactions they spend, so that the consensus mana that a reverted
+			// spender returns to the pledge nodes of its inputs can be revoked again by reverting these inputs
+			for _, transactionID := range spendersFirst(messagelayer.Tangle().LedgerState.UTXODAG.FutureCone(ledgerstate.TransactionID(branch.ID()))) {
+				revertTransaction(transactionID)
+			}
+		})
+	}))
+}
+
+// revertTransaction reverts the mana that was pledged by the given Transaction.
+func revertTransaction(transactionID ledgerstate.TransactionID) {
+	txInfo, err := txInfoOfTransaction(transactionID)
+	if err != nil {
+		log.Warnf("failed to revert mana of rejected Transaction with %s: %s", transactionID, err)
+		return
+	}
+
+	for _, baseManaVector := range baseManaVectors {
+		if err := baseManaVector.Revert(txInfo); err != nil && !xerrors.Is(err, mana.ErrAlreadyReverted) {
+			log.Warnf("failed to revert %s mana of Transaction with %s: %s", baseManaVector.Type(), txInfo.TransactionID, err)
+		}
+	}
+}
+
+// spendersFirst orders the given Transactions so that every Transaction comes before the Transactions whose Outputs it
+// spends (reverse topological order).
+func spendersFirst(transactionIDs ledgerstate.TransactionIDs) (orderedTransactionIDs []ledgerstate.TransactionID) {
+	return reverseTopologicalOrder(transactionIDs, func(transactionID ledgerstate.TransactionID) (spentTransactionIDs ledgerstate.TransactionIDs) {
+		spentTransactionIDs = make(ledgerstate.TransactionIDs)
+		messagelayer.Tangle().LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+			for _, input := range transaction.Essence().Inputs() {
+				spentTransactionIDs[input.(*ledgerstate.UTXOInput).ReferencedOutputID().TransactionID()] = types.Void
+			}
+		})
+
+		return
+	})
+}
+
+// reverseTopologicalOrder orders the given Transactions so that every Transaction comes before the Transactions that it
+// spends. The spentTransactionIDs function returns the Transactions that created the Outputs spent by a Transaction.
+func reverseTopologicalOrder(transactionIDs ledgerstate.TransactionIDs, spentTransactionIDs func(transactionID ledgerstate.TransactionID) ledgerstate.TransactionIDs) (orderedTransactionIDs []ledgerstate.TransactionID) {
+	orderedTransactionIDs = make([]ledgerstate.TransactionID, 0, len(transactionIDs))
+	visited := make(ledgerstate.TransactionIDs, len(transactionIDs))
+
+	// a post-order walk over the spent Transactions appends every Transaction after the Transactions that it spends
+	var visit func(transactionID ledgerstate.TransactionID)
+	visit = func(transactionID ledgerstate.TransactionID) {
+		if _, seen := visited[transactionID]; seen {
+			return
+		}
+		visited[transactionID] = types.Void
+
+		for spentTransactionID := range spentTransactionIDs(transactionID) {
+			if _, inSet := transactionIDs[spentTransactionID]; inSet {
+				visit(spentTransactionID)
+			}
+		}
+		orderedTransactionIDs = append(orderedTransactionIDs, transactionID)
+	}
+	for transactionID := range transactionIDs {
+		visit(transactionID)
+	}
+
+	for i, j := 0, len(orderedTransactionIDs)-1; i < j; i, j = i+1, j-1 {
+		orderedTransactionIDs[i], orderedTransactionIDs[j] = orderedTransactionIDs[j], orderedTransactionIDs[i]
+	}
+
+	return
+}
+
+// BaseManaVector returns the BaseManaVector of the given mana Type.
+func BaseManaVector(manaType mana.Type) (baseManaVector *mana.BaseManaVector, err error) {
+	baseManaVector, exists := baseManaVectors[manaType]
+	if !exists {
+		err = xerrors.Errorf("failed to retrieve BaseManaVector of type %s: %w", manaType, mana.ErrUnknownManaType)
+	}
+
+	return
+}
+
+// GetManaMap returns the mana of all nodes for the given mana Type.
+func GetManaMap(manaType mana.Type, optionalUpdateTime ...time.Time) (nodeMap mana.NodeMap, updateTime time.Time, err error) {
+	baseManaVector, err := BaseManaVector(manaType)
+	if err != nil {
+		return
+	}
+
+	return baseManaVector.GetManaMap(optionalUpdateTime...)
+}
+
+// GetAccessMana returns the access mana of the given node.
+func GetAccessMana(nodeID identity.ID, optionalUpdateTime ...time.Time) (float64, time.Time, error) {
+	return baseManaVectors[mana.AccessMana].GetMana(nodeID, optionalUpdateTime...)
+}
+
+// GetConsensusMana returns the consensus mana of the given node.
+func GetConsensusMana(nodeID identity.ID, optionalUpdateTime ...time.Time) (float64, time.Time, error) {
+	return baseManaVectors[mana.ConsensusMana].GetMana(nodeID, optionalUpdateTime...)
+}
+
+// PledgeLog returns the logged pledge and revocation events that were caused by the given Transaction.
+func PledgeLog(transactionID ledgerstate.TransactionID) []PledgeLogEntry {
+	return pledges.transactionEntries(transactionID)
+}
+
+// RecentPledgeLog returns the logged pledge and revocation events of the most recent Transactions.
+func RecentPledgeLog() []PledgeLogEntry {
+	return pledges.allEntries()
+}
+
+// BaseManaSnapshot returns the current BaseMana of all nodes so that it can be exported as part of a snapshot.
+func BaseManaSnapshot() (persistableBaseManas []*mana.PersistableBaseMana) {
+	for manaType, baseManaVector := range baseManaVectors {
+		baseManaVector.ForEach(func(nodeID identity.ID, baseMana mana.BaseMana) bool {
+			persistableBaseManas = append(persistableBaseManas, mana.NewPersistableBaseMana(manaType, nodeID, baseMana))
+
+			return true
+		})
+	}
+
+	return
+}
+
+// TransactionPledgeSnapshot returns the timestamps and the pledge nodes of the Transactions that created the given
+// Outputs so that they can be exported as part of a snapshot.
+func TransactionPledgeSnapshot(outputs ledgerstate.Outputs) (transactionPledges []*mana.PersistableTransactionPledge) {
+	transactionIDs := make(ledgerstate.TransactionIDs)
+	for _, output := range outputs {
+		transactionIDs[output.ID().TransactionID()] = types.Void
+	}
+
+	transactionPledges = make([]*mana.PersistableTransactionPledge, 0, len(transactionIDs))
+	for transactionID := range transactionIDs {
+		if timestamp, pledgeID, exists := inputInfoProvider(transactionID); exists {
+			transactionPledges = append(transactionPledges, mana.NewPersistableTransactionPledge(transactionID, timestamp, pledgeID))
+		}
+	}
+
+	return
+}
+
+// accessMana returns the access mana of the given node or 0 if it is unknown.
+func accessMana(nodeID identity.ID) float64 {
+	accessMana, _, err := GetAccessMana(nodeID)
+	if err != nil {
+		return 0
+	}
+
+	return accessMana
+}
+
+// consensusManaMap returns the consensus mana of all nodes or nil if it can not be retrieved.
+func consensusManaMap() map[identity.ID]float64 {
+	manaMap, _, err := GetManaMap(mana.ConsensusMana)
+	if err != nil {
+		return nil
+	}
+
+	return manaMap
+}
+
+// inputInfoProvider retrieves the timestamp and the pledge nodes of the Transaction with the given ID from the ledger
+// state or - if the Transaction created Outputs of the snapshot that the node was bootstrapped from - from the pledges
+// that were imported together with the snapshot.
+func inputInfoProvider(transactionID ledgerstate.TransactionID) (timestamp time.Time, pledgeID map[mana.Type]identity.ID, exists bool) {
+	if exists = messagelayer.Tangle().LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+		timestamp = transaction.Essence().Timestamp()
+		pledgeID = map[mana.Type]identity.ID{
+			mana.AccessMana:    transaction.Essence().AccessPledgeID(),
+			mana.ConsensusMana: transaction.Essence().ConsensusPledgeID(),
+		}
+	}); exists {
+		return
+	}
+
+	exists = pledgeStorage.Load(transactionID.Bytes()).Consume(func(object objectstorage.StorableObject) {
+		transactionPledge := object.(*mana.PersistableTransactionPledge)
+		timestamp = transactionPledge.Timestamp()
+		pledgeID = transactionPledge.PledgeID()
+	})
+
+	return
+}
+
+// txInfoOfTransaction retrieves the Transaction with the given ID and the Outputs it consumes from the ledger state and
+// creates the corresponding TxInfo.
+func txInfoOfTransaction(transactionID ledgerstate.TransactionID) (txInfo *mana.TxInfo, err error) {
+	if !messagelayer.Tangle().LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+		consumedOutputs := make(ledgerstate.Outputs, 0, len(transaction.Essence().Inputs()))
+		for _, input := range transaction.Essence().Inputs() {
+			referencedOutputID := input.(*ledgerstate.UTXOInput).ReferencedOutputID()
+			if !messagelayer.Tangle().LedgerState.Output(referencedOutputID).Consume(func(output ledgerstate.Output) {
+				consumedOutputs = append(consumedOutputs, output)
+			}) {
+				err = xerrors.Errorf("failed to load consumed Output with %s", referencedOutputID)
+				return
+			}
+		}
+
+		txInfo = mana.NewTxInfo(transaction, consumedOutputs, inputInfoProvider)
+	}) {
+		err = xerrors.Errorf("failed to load Transaction with %s", transactionID)
+	}
+
+	return
+}
+
+// loadBaseManaVectors restores the BaseManaVectors from the storage.
+func loadBaseManaVectors() {
+	storage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		cachedObject.Consume(func(object objectstorage.StorableObject) {
+			persistableBaseMana := object.(*mana.PersistableBaseMana)
+
+			baseMana, err := persistableBaseMana.BaseMana()
+			if err != nil {
+				log.Errorf("failed to load BaseMana of %s: %s", persistableBaseMana.NodeID(), err)
+				return
+			}
+			if err = baseManaVectors[persistableBaseMana.ManaType()].SetMana(persistableBaseMana.NodeID(), baseMana); err != nil {
+				log.Errorf("failed to load BaseMana of %s: %s", persistableBaseMana.NodeID(), err)
+			}
+		})
+
+		return true
+	})
+
+	revertedStorage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		cachedObject.Consume(func(object objectstorage.StorableObject) {
+			persistableRevertedTransaction := object.(*mana.PersistableRevertedTransaction)
+
+			if baseManaVector, exists := baseManaVectors[persistableRevertedTransaction.ManaType()]; exists {
+				baseManaVector.SetReverted(persistableRevertedTransaction.TransactionID())
+			}
+		})
+
+		return true
+	})
+}
+
+// loadSnapshotBaseManas initializes the BaseManaVectors with the mana state of the snapshot that the node was
+// bootstrapped from, if the node does not know any mana yet. It also stores the pledges of the Transactions that created
+// the snapshot Outputs, so that the imported mana is revoked when these Outputs are spent.
+func loadSnapshotBaseManas() {
+	loadedSnapshot := messagelayer.LoadedSnapshot()
+	if loadedSnapshot == nil {
+		return
+	}
+
+	for _, transactionPledge := range loadedSnapshot.TransactionPledges {
+		if cachedTransactionPledge, stored := pledgeStorage.StoreIfAbsent(transactionPledge); stored {
+			cachedTransactionPledge.Release()
+		}
+	}
+
+	if len(loadedSnapshot.BaseManas) == 0 {
+		return
+	}
+	for _, baseManaVector := range baseManaVectors {
+		if baseManaVector.Size() != 0 {
+			return
+		}
+	}
+
+	for _, persistableBaseMana := range loadedSnapshot.BaseManas {
+		baseMana, err := persistableBaseMana.BaseMana()
+		if err != nil {
+			log.Errorf("failed to load BaseMana of %s from snapshot: %s", persistableBaseMana.NodeID(), err)
+			continue
+		}
+		if err = baseManaVectors[persistableBaseMana.ManaType()].SetMana(persistableBaseMana.NodeID(), baseMana); err != nil {
+			log.Errorf("failed to load BaseMana of %s from snapshot: %s", persistableBaseMana.NodeID(), err)
+		}
+	}
+	log.Infof("loaded %d BaseMana entries from snapshot", len(loadedSnapshot.BaseManas))
+}
+
+// storeBaseManaVectors persists the BaseManaVectors together with the Transactions that were reverted in them in the
+// storage.
+func storeBaseManaVectors() {
+	for manaType, baseManaVector := range baseManaVectors {
+		baseManaVector.ForEach(func(nodeID identity.ID, baseMana mana.BaseMana) bool {
+			storage.Store(mana.NewPersistableBaseMana(manaType, nodeID, baseMana)).Release()
+
+			return true
+		})
+		baseManaVector.ForEachRevertedTransaction(func(transactionID ledgerstate.TransactionID) bool {
+			if cachedRevertedTransaction, stored := revertedStorage.StoreIfAbsent(mana.NewPersistableRevertedTransaction(manaType, transactionID)); stored {
+				cachedRevertedTransaction.Release()
+			}
+
+			return true
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package mana
+
+import (
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/hive.go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverseTopologicalOrder(t *testing.T) {
+	// txA <- txB <- txD, txA <- txC <- txD
+	txA, txB, txC, txD := ledgerstate.TransactionID{1}, ledgerstate.TransactionID{2}, ledgerstate.TransactionID{3}, ledgerstate.TransactionID{4}
+	spent := map[ledgerstate.TransactionID]ledgerstate.TransactionIDs{
+		txB: {txA: types.Void},
+		txC: {txA: types.Void},
+		txD: {txB: types.Void, txC: types.Void, ledgerstate.GenesisTransactionID: types.Void},
+	}
+
+	for i := 0; i < 10; i++ {
+		ordered := reverseTopologicalOrder(ledgerstate.TransactionIDs{txA: types.Void, txB: types.Void, txC: types.Void, txD: types.Void}, func(transactionID ledgerstate.TransactionID) ledgerstate.TransactionIDs {
+			return spent[transactionID]
+		})
+		assert.Len(t, ordered, 4)
+
+		position := make(map[ledgerstate.TransactionID]int)
+		for index, transactionID := range ordered {
+			position[transactionID] = index
+		}
+		for spender, spentTransactionIDs := range spent {
+			for spentTransactionID := range spentTransactionIDs {
+				if spentTransactionID != ledgerstate.GenesisTransactionID {
+					assert.Less(t, position[spender], position[spentTransactionID])
+				}
+			}
+		}
+	}
+}
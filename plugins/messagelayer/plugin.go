@@ -1,6 +1,7 @@
 package messagelayer
 
 import (
+	"bufio"
 	"errors"
 	"os"
 	"sync"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/local"
 	"github.com/iotaledger/goshimmer/plugins/config"
@@ -32,8 +34,29 @@ const (
 	// CfgMessageLayerFCOBAverageNetworkDelay is the avg. network delay to use for FCoB rules
 	CfgMessageLayerFCOBAverageNetworkDelay = "messageLayer.fcob.averageNetworkDelay"
 
+	// CfgTimestampWindow is the time window for assessing the timestamp quality of messages.
+	CfgTimestampWindow = "messageLayer.timestamp.window"
+
+	// CfgTimestampGratuitousNetworkDelay is the time after which all messages are assumed to be delivered.
+	CfgTimestampGratuitousNetworkDelay = "messageLayer.timestamp.gratuitousNetworkDelay"
+
+	// CfgConfirmationThreshold is the share of the total consensus mana that has to approve a message to confirm it.
+	CfgConfirmationThreshold = "messageLayer.approvalWeight.confirmationThreshold"
+
+	// CfgPruningRetention is the time for which confirmed messages are kept in the database (0 disables pruning).
+	CfgPruningRetention = "messageLayer.pruning.retention"
+
+	// CfgPruningInterval is the time between two consecutive pruning runs.
+	CfgPruningInterval = "messageLayer.pruning.interval"
+
 	// CfgTangleWidth is the width of the Tangle.
 	CfgTangleWidth = "messageLayer.tangleWidth"
+
+	// CfgSchedulerRate is the minimum time between two messages scheduled by the Scheduler.
+	CfgSchedulerRate = "messageLayer.scheduler.rate"
+
+	// CfgSchedulerMaxBufferSize is the maximum number of messages buffered by the Scheduler.
+	CfgSchedulerMaxBufferSize = "messageLayer.scheduler.maxBufferSize"
 )
 
 var (
@@ -45,7 +68,14 @@ var (
 func init() {
 	flag.String(CfgMessageLayerSnapshotFile, "./snapshot.bin", "the path to the snapshot file")
 	flag.Int(CfgMessageLayerFCOBAverageNetworkDelay, 5, "the avg. network delay to use for FCoB rules")
+	flag.Duration(CfgTimestampWindow, tangle.TimestampWindow, "the time window for assessing the timestamp quality of messages")
+	flag.Duration(CfgTimestampGratuitousNetworkDelay, tangle.GratuitousNetworkDelay, "the time after which all messages are assumed to be delivered")
+	flag.Float64(CfgConfirmationThreshold, tangle.DefaultConfirmationThreshold, "the share of the total consensus mana that has to approve a message to confirm it")
+	flag.Duration(CfgPruningRetention, 0, "the time for which confirmed messages are kept in the database (0 disables pruning, at least 30m otherwise)")
+	flag.Duration(CfgPruningInterval, tangle.DefaultPruningInterval, "the time between two consecutive pruning runs")
 	flag.Int(CfgTangleWidth, 0, "the width of the Tangle")
+	flag.Duration(CfgSchedulerRate, 0, "the minimum time between two scheduled messages (0 disables the rate limit)")
+	flag.Int(CfgSchedulerMaxBufferSize, tangle.DefaultMaxBufferSize, "the maximum number of messages buffered by the scheduler")
 }
 
 var (
@@ -55,6 +85,7 @@ var (
 	tangleInstance *tangle.Tangle
 	tangleOnce     sync.Once
 	log            *logger.Logger
+	loadedSnapshot *snapshot.Snapshot
 )
 
 // Plugin gets the plugin instance.
@@ -72,6 +103,15 @@ func Tangle() *tangle.Tangle {
 			tangle.Store(database.Store()),
 			tangle.Identity(local.GetInstance().LocalIdentity()),
 			tangle.TangleWidth(config.Node().Int(CfgTangleWidth)),
+			tangle.SchedulerConfig(tangle.SchedulerParams{
+				Rate:          config.Node().Duration(CfgSchedulerRate),
+				MaxBufferSize: config.Node().Int(CfgSchedulerMaxBufferSize),
+			}),
+			tangle.ConfirmationThreshold(config.Node().Float64(CfgConfirmationThreshold)),
+			tangle.PrunerConfig(tangle.PrunerParams{
+				Retention: config.Node().Duration(CfgPruningRetention),
+				Interval:  config.Node().Duration(CfgPruningInterval),
+			}),
 		)
 	})
 
@@ -80,6 +120,11 @@ func Tangle() *tangle.Tangle {
 
 func configure(*node.Plugin) {
 	log = logger.NewLogger(PluginName)
+
+	if retention := config.Node().Duration(CfgPruningRetention); retention > 0 && retention < tangle.MinPruningRetention {
+		log.Fatalf("%s (%s) must not be shorter than %s", CfgPruningRetention, retention, tangle.MinPruningRetention)
+	}
+
 	Tangle().Setup()
 
 	Tangle().Events.Error.Attach(events.NewClosure(func(err error) {
@@ -89,21 +134,15 @@ func configure(*node.Plugin) {
 	// read snapshot file
 	snapshotFilePath := config.Node().String(CfgMessageLayerSnapshotFile)
 	if len(snapshotFilePath) != 0 {
-		snapshot := ledgerstate.Snapshot{}
-		f, err := os.Open(snapshotFilePath)
-		if err != nil {
-			log.Panic("can not open snapshot file:", err)
-		}
-		if _, err := snapshot.ReadFrom(f); err != nil {
-			log.Panic("could not read snapshot file:", err)
-		}
-		Tangle().LedgerState.LoadSnapshot(snapshot)
-		log.Infof("read snapshot from %s", snapshotFilePath)
+		readSnapshot(snapshotFilePath)
 	}
 
 	avgNetworkDelay := config.Node().Int(CfgMessageLayerFCOBAverageNetworkDelay)
 	tangle.LikedThreshold = (time.Duration(avgNetworkDelay) * time.Second)
 	tangle.LocallyFinalizedThreshold = (time.Duration(avgNetworkDelay*2) * time.Second)
+
+	tangle.TimestampWindow = config.Node().Duration(CfgTimestampWindow)
+	tangle.GratuitousNetworkDelay = config.Node().Duration(CfgTimestampGratuitousNetworkDelay)
 }
 
 func run(*node.Plugin) {
@@ -115,6 +154,44 @@ func run(*node.Plugin) {
 	}
 }
 
+// LoadedSnapshot returns the Snapshot that the node was bootstrapped from or nil if the node was started from a genesis
+// snapshot (or without any snapshot).
+func LoadedSnapshot() *snapshot.Snapshot {
+	return loadedSnapshot
+}
+
+// readSnapshot loads the snapshot file at the given path into the ledger state. It supports both the Snapshots exported
+// by running nodes and the genesis snapshots written by ledgerstate.Snapshot.
+func readSnapshot(snapshotFilePath string) {
+	f, err := os.Open(snapshotFilePath)
+	if err != nil {
+		log.Panic("can not open snapshot file:", err)
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	if snapshot.IsSnapshot(reader) {
+		loadedSnapshot = &snapshot.Snapshot{}
+		if _, err := loadedSnapshot.ReadFrom(reader); err != nil {
+			log.Panic("could not read snapshot file:", err)
+		}
+		Tangle().LedgerState.LoadOutputs(loadedSnapshot.Outputs)
+		for _, solidEntryPoint := range loadedSnapshot.SolidEntryPoints {
+			Tangle().Storage.StoreSolidEntryPoint(solidEntryPoint)
+		}
+		log.Infof("read snapshot with %d outputs and %d solid entry points from %s", len(loadedSnapshot.Outputs), len(loadedSnapshot.SolidEntryPoints), snapshotFilePath)
+
+		return
+	}
+
+	genesisSnapshot := ledgerstate.Snapshot{}
+	if _, err := genesisSnapshot.ReadFrom(reader); err != nil {
+		log.Panic("could not read snapshot file:", err)
+	}
+	Tangle().LedgerState.LoadSnapshot(genesisSnapshot)
+	log.Infof("read snapshot from %s", snapshotFilePath)
+}
+
 // AwaitMessageToBeBooked awaits maxAwait for the given message to get booked.
 func AwaitMessageToBeBooked(f func() (*tangle.Message, error), txID ledgerstate.TransactionID, maxAwait time.Duration) (*tangle.Message, error) {
 	// first subscribe to the transaction booked event
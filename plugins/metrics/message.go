@@ -69,6 +69,12 @@ var (
 	// current number of missing messages in missingMessageStorage
 	missingMessageCountDB atomic.Uint64
 
+	// number of messages that were pruned from the database since the start of the node
+	prunedMessageCount atomic.Uint64
+
+	// number of message requests that were given up since the start of the node
+	failedMessageRequestCount atomic.Uint64
+
 	// current number of message tips.
 	messageTips atomic.Uint64
 
@@ -165,6 +171,16 @@ func MessageMissingCountDB() uint64 {
 	return initialMissingMessageCountDB + missingMessageCountDB.Load()
 }
 
+// MessagePrunedCount returns the number of messages that were pruned from the DB since the start of the node.
+func MessagePrunedCount() uint64 {
+	return prunedMessageCount.Load()
+}
+
+// MessageRequestFailedCount returns the number of message requests that were given up since the start of the node.
+func MessageRequestFailedCount() uint64 {
+	return failedMessageRequestCount.Load()
+}
+
 // ReceivedMessagesPerSecond retrieves the current messages per second number.
 func ReceivedMessagesPerSecond() uint64 {
 	return measuredReceivedMPS.Load()
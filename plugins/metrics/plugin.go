@@ -104,6 +104,11 @@ func registerLocalMetrics() {
 		messageTotalCountDB.Dec()
 	}))
 
+	messagelayer.Tangle().Pruner.Events.MessagePruned.Attach(events.NewClosure(func(tangle.MessageID) {
+		// the removal itself is already counted by MessageRemoved
+		prunedMessageCount.Inc()
+	}))
+
 	// messages can only become solid once, then they stay like that, hence no .Dec() part
 	messagelayer.Tangle().Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(messageID tangle.MessageID) {
 		increasePerComponentCounter(Solidifier)
@@ -129,6 +134,11 @@ func registerLocalMetrics() {
 		missingMessageCountDB.Dec()
 	}))
 
+	// fired when the requests for a missing message were given up
+	messagelayer.Tangle().Requester.Events.RequestFailed.Attach(events.NewClosure(func(tangle.MessageID) {
+		failedMessageRequestCount.Inc()
+	}))
+
 	messagelayer.Tangle().Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(messageID tangle.MessageID) {
 		increasePerComponentCounter(Scheduler)
 	}))
@@ -1,6 +1,7 @@
 package prometheus
 
 import (
+	"github.com/iotaledger/goshimmer/plugins/consensus"
 	"github.com/iotaledger/goshimmer/plugins/metrics"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -14,6 +15,8 @@ var (
 	queryOpRx          prometheus.Gauge
 	queryReplyNotRx    prometheus.Gauge
 	queryOpReplyNotRx  prometheus.Gauge
+	statementsAccepted prometheus.Gauge
+	statementsIgnored  prometheus.Gauge
 )
 
 func registerFPCMetrics() {
@@ -49,6 +52,14 @@ func registerFPCMetrics() {
 		Name: "fpc_query_opinion_replies_not_received",
 		Help: " number of opinions that the node failed to gather from peers",
 	})
+	statementsAccepted = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fpc_statements_accepted",
+		Help: "number of statements accepted from issuers above the mana threshold",
+	})
+	statementsIgnored = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fpc_statements_ignored",
+		Help: "number of statements ignored because their issuers are below the mana threshold",
+	})
 
 	registry.MustRegister(activeConflicts)
 	registry.MustRegister(finalizedConflicts)
@@ -58,6 +69,8 @@ func registerFPCMetrics() {
 	registry.MustRegister(queryOpRx)
 	registry.MustRegister(queryReplyNotRx)
 	registry.MustRegister(queryOpReplyNotRx)
+	registry.MustRegister(statementsAccepted)
+	registry.MustRegister(statementsIgnored)
 
 	addCollect(collectFPCMetrics)
 }
@@ -71,4 +84,8 @@ func collectFPCMetrics() {
 	queryOpRx.Set(float64(metrics.FPCOpinionQueryReceived()))
 	queryReplyNotRx.Set(float64(metrics.FPCQueryReplyErrors()))
 	queryOpReplyNotRx.Set(float64(metrics.FPCOpinionQueryReplyErrors()))
+
+	statementStats := consensus.Registry().Stats()
+	statementsAccepted.Set(float64(statementStats.AcceptedStatements))
+	statementsIgnored.Set(float64(statementStats.IgnoredStatements))
 }
@@ -0,0 +1,97 @@
+package prometheus
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	gossipNeighborBytesSent         *prometheus.GaugeVec
+	gossipNeighborBytesReceived     *prometheus.GaugeVec
+	gossipNeighborPacketsSent       *prometheus.GaugeVec
+	gossipNeighborPacketsReceived   *prometheus.GaugeVec
+	gossipNeighborPacketsDropped    *prometheus.GaugeVec
+	gossipNeighborNewMessages       *prometheus.GaugeVec
+	gossipNeighborDuplicateMessages *prometheus.GaugeVec
+	gossipNeighborRequestsSent      *prometheus.GaugeVec
+	gossipNeighborRequestsReceived  *prometheus.GaugeVec
+	gossipNeighborRequestsAnswered  *prometheus.GaugeVec
+	gossipNeighborRoundTripTime     *prometheus.GaugeVec
+	gossipNeighborReputation        *prometheus.GaugeVec
+	gossipNeighborSynced            *prometheus.GaugeVec
+)
+
+func registerGossipMetrics() {
+	gossipNeighborBytesSent = newNeighborGaugeVec("gossip_neighbor_bytes_sent", "Bytes sent to the gossip neighbor.")
+	gossipNeighborBytesReceived = newNeighborGaugeVec("gossip_neighbor_bytes_received", "Bytes received from the gossip neighbor.")
+	gossipNeighborPacketsSent = newNeighborGaugeVec("gossip_neighbor_packets_sent", "Packets sent to the gossip neighbor.")
+	gossipNeighborPacketsReceived = newNeighborGaugeVec("gossip_neighbor_packets_received", "Packets received from the gossip neighbor.")
+	gossipNeighborPacketsDropped = newNeighborGaugeVec("gossip_neighbor_packets_dropped", "Packets dropped because the send queue of the gossip neighbor was full.")
+	gossipNeighborNewMessages = newNeighborGaugeVec("gossip_neighbor_new_messages", "Messages received from the gossip neighbor that were unknown to the node.")
+	gossipNeighborDuplicateMessages = newNeighborGaugeVec("gossip_neighbor_duplicate_messages", "Messages received from the gossip neighbor that were already known to the node.")
+	gossipNeighborRequestsSent = newNeighborGaugeVec("gossip_neighbor_requests_sent", "Message requests sent to the gossip neighbor.")
+	gossipNeighborRequestsReceived = newNeighborGaugeVec("gossip_neighbor_requests_received", "Message requests received from the gossip neighbor.")
+	gossipNeighborRequestsAnswered = newNeighborGaugeVec("gossip_neighbor_requests_answered", "Message requests of the gossip neighbor that were answered.")
+	gossipNeighborRoundTripTime = newNeighborGaugeVec("gossip_neighbor_round_trip_time_seconds", "Average time between a message request to the gossip neighbor and the reception of the requested message.")
+	gossipNeighborReputation = newNeighborGaugeVec("gossip_neighbor_reputation", "Reputation score of the gossip neighbor (lowered by misbehavior).")
+	gossipNeighborSynced = newNeighborGaugeVec("gossip_neighbor_synced", "Whether the gossip neighbor reported to be in sync in its last heartbeat.")
+
+	registry.MustRegister(gossipNeighborBytesSent)
+	registry.MustRegister(gossipNeighborBytesReceived)
+	registry.MustRegister(gossipNeighborPacketsSent)
+	registry.MustRegister(gossipNeighborPacketsReceived)
+	registry.MustRegister(gossipNeighborPacketsDropped)
+	registry.MustRegister(gossipNeighborNewMessages)
+	registry.MustRegister(gossipNeighborDuplicateMessages)
+	registry.MustRegister(gossipNeighborRequestsSent)
+	registry.MustRegister(gossipNeighborRequestsReceived)
+	registry.MustRegister(gossipNeighborRequestsAnswered)
+	registry.MustRegister(gossipNeighborRoundTripTime)
+	registry.MustRegister(gossipNeighborReputation)
+	registry.MustRegister(gossipNeighborSynced)
+
+	addCollect(collectGossipMetrics)
+}
+
+func newNeighborGaugeVec(name string, help string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: name,
+		Help: help,
+	}, []string{"neighbor_id"})
+}
+
+func collectGossipMetrics() {
+	// reset the metrics, so that disconnected neighbors disappear
+	for _, gaugeVec := range []*prometheus.GaugeVec{
+		gossipNeighborBytesSent, gossipNeighborBytesReceived, gossipNeighborPacketsSent, gossipNeighborPacketsReceived,
+		gossipNeighborPacketsDropped, gossipNeighborNewMessages, gossipNeighborDuplicateMessages,
+		gossipNeighborRequestsSent, gossipNeighborRequestsReceived, gossipNeighborRequestsAnswered, gossipNeighborRoundTripTime,
+		gossipNeighborReputation, gossipNeighborSynced,
+	} {
+		gaugeVec.Reset()
+	}
+
+	for _, neighbor := range gossip.Manager().AllNeighbors() {
+		neighborID := neighbor.ID().String()
+		stats := neighbor.Stats()
+
+		gossipNeighborBytesSent.WithLabelValues(neighborID).Set(float64(neighbor.BytesWritten()))
+		gossipNeighborBytesReceived.WithLabelValues(neighborID).Set(float64(neighbor.BytesRead()))
+		gossipNeighborPacketsSent.WithLabelValues(neighborID).Set(float64(stats.PacketsSent()))
+		gossipNeighborPacketsReceived.WithLabelValues(neighborID).Set(float64(stats.PacketsReceived()))
+		gossipNeighborPacketsDropped.WithLabelValues(neighborID).Set(float64(stats.PacketsDropped()))
+		gossipNeighborNewMessages.WithLabelValues(neighborID).Set(float64(stats.NewMessages()))
+		gossipNeighborDuplicateMessages.WithLabelValues(neighborID).Set(float64(stats.DuplicateMessages()))
+		gossipNeighborRequestsSent.WithLabelValues(neighborID).Set(float64(stats.RequestsSent()))
+		gossipNeighborRequestsReceived.WithLabelValues(neighborID).Set(float64(stats.RequestsReceived()))
+		gossipNeighborRequestsAnswered.WithLabelValues(neighborID).Set(float64(stats.RequestsAnswered()))
+		gossipNeighborRoundTripTime.WithLabelValues(neighborID).Set(stats.RoundTripTime().Seconds())
+		gossipNeighborReputation.WithLabelValues(neighborID).Set(gossip.Reputation().Score(neighbor.ID()))
+
+		var synced float64
+		if heartbeat, _ := neighbor.LastHeartbeat(); heartbeat.GetSynced() {
+			synced = 1
+		}
+		gossipNeighborSynced.WithLabelValues(neighborID).Set(synced)
+	}
+}
@@ -57,6 +57,7 @@ func configure(plugin *node.Plugin) {
 		registerAutopeeringMetrics()
 		registerDBMetrics()
 		registerFPCMetrics()
+		registerGossipMetrics()
 		registerInfoMetrics()
 		registerNetworkMetrics()
 		registerProcessMetrics()
@@ -14,7 +14,9 @@ var (
 	messageSolidCountDB      prometheus.Gauge
 	avgSolidificationTime    prometheus.Gauge
 	messageMissingCountDB    prometheus.Gauge
+	messagePrunedCount       prometheus.Gauge
 	messageRequestCount      prometheus.Gauge
+	messageRequestFailed     prometheus.Gauge
 
 	transactionCounter prometheus.Gauge
 	valueTips          prometheus.Gauge
@@ -67,6 +69,11 @@ func registerTangleMetrics() {
 		Help: "number of missing messages in the node's database",
 	})
 
+	messagePrunedCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_message_pruned_count",
+		Help: "number of messages pruned from the node's database since the start of the node",
+	})
+
 	transactionCounter = prometheus.NewGauge(prometheus.GaugeOpts{
 		Name: "tangle_value_transaction_counter",
 		Help: "number of value transactions (value payloads) seen",
@@ -77,6 +84,11 @@ func registerTangleMetrics() {
 		Help: "current number requested messages by the message tangle",
 	})
 
+	messageRequestFailed = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_message_request_failed_count",
+		Help: "number of message requests that were given up since the start of the node",
+	})
+
 	registry.MustRegister(messageTips)
 	registry.MustRegister(messagePerTypeCount)
 	registry.MustRegister(messagePerComponentCount)
@@ -85,7 +97,9 @@ func registerTangleMetrics() {
 	registry.MustRegister(messageSolidCountDB)
 	registry.MustRegister(avgSolidificationTime)
 	registry.MustRegister(messageMissingCountDB)
+	registry.MustRegister(messagePrunedCount)
 	registry.MustRegister(messageRequestCount)
+	registry.MustRegister(messageRequestFailed)
 	registry.MustRegister(transactionCounter)
 
 	addCollect(collectTangleMetrics)
@@ -106,6 +120,8 @@ func collectTangleMetrics() {
 	messageSolidCountDB.Set(float64(metrics.MessageSolidCountDB()))
 	avgSolidificationTime.Set(metrics.AvgSolidificationTime())
 	messageMissingCountDB.Set(float64(metrics.MessageMissingCountDB()))
+	messagePrunedCount.Set(float64(metrics.MessagePrunedCount()))
 	messageRequestCount.Set(float64(metrics.MessageRequestQueueSize()))
+	messageRequestFailed.Set(float64(metrics.MessageRequestFailedCount()))
 	// transactionCounter.Set(float64(metrics.ValueTransactionCounter()))
 }
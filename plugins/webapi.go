@@ -6,9 +6,15 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/webapi/data"
 	"github.com/iotaledger/goshimmer/plugins/webapi/drng"
 	"github.com/iotaledger/goshimmer/plugins/webapi/faucet"
+	"github.com/iotaledger/goshimmer/plugins/webapi/gossip"
 	"github.com/iotaledger/goshimmer/plugins/webapi/healthz"
 	"github.com/iotaledger/goshimmer/plugins/webapi/info"
+	"github.com/iotaledger/goshimmer/plugins/webapi/mana"
+	"github.com/iotaledger/goshimmer/plugins/webapi/manualpeering"
 	"github.com/iotaledger/goshimmer/plugins/webapi/message"
+	"github.com/iotaledger/goshimmer/plugins/webapi/openapi"
+	"github.com/iotaledger/goshimmer/plugins/webapi/snapshot"
+	"github.com/iotaledger/goshimmer/plugins/webapi/subscriptions"
 	"github.com/iotaledger/goshimmer/plugins/webapi/tools"
 	"github.com/iotaledger/goshimmer/plugins/webapi/value"
 	"github.com/iotaledger/hive.go/node"
@@ -23,7 +29,13 @@ var WebAPI = node.Plugins(
 	healthz.Plugin(),
 	message.Plugin(),
 	autopeering.Plugin(),
+	gossip.Plugin(),
+	manualpeering.Plugin(),
 	info.Plugin(),
 	value.Plugin(),
 	tools.Plugin(),
+	mana.Plugin(),
+	snapshot.Plugin(),
+	subscriptions.Plugin(),
+	openapi.Plugin(),
 )
@@ -0,0 +1,108 @@
+package gossip
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	gossipPlugin "github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+)
+
+// PluginName is the name of the web API gossip endpoint plugin.
+const PluginName = "WebAPI gossip Endpoint"
+
+var (
+	// plugin is the plugin instance of the web API gossip endpoint plugin.
+	plugin *node.Plugin
+	once   sync.Once
+)
+
+// Plugin gets the plugin instance.
+func Plugin() *node.Plugin {
+	once.Do(func() {
+		plugin = node.NewPlugin(PluginName, node.Enabled, configure)
+	})
+	return plugin
+}
+
+func configure(*node.Plugin) {
+	webapi.Server().GET("gossip/neighbors", getNeighborsHandler)
+}
+
+// getNeighborsHandler returns the connected gossip neighbors together with the statistics of their traffic.
+func getNeighborsHandler(c echo.Context) error {
+	neighbors := gossipPlugin.Manager().AllNeighbors()
+	sort.Slice(neighbors, func(i, j int) bool {
+		return neighbors[i].ID().String() < neighbors[j].ID().String()
+	})
+
+	response := NeighborsResponse{Neighbors: make([]Neighbor, 0, len(neighbors))}
+	for _, nbr := range neighbors {
+		response.Neighbors = append(response.Neighbors, newNeighbor(nbr))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// newNeighbor creates the JSON representation of the given gossip.Neighbor.
+func newNeighbor(nbr *gossip.Neighbor) Neighbor {
+	stats := nbr.Stats()
+	heartbeat, heartbeatReceived := nbr.LastHeartbeat()
+
+	return Neighbor{
+		ID:                    nbr.ID().String(),
+		PublicKey:             nbr.PublicKey().String(),
+		Address:               gossip.GetAddress(nbr.Peer),
+		Outbound:              nbr.IsOutbound(),
+		ConnectionEstablished: nbr.ConnectionEstablished(),
+		BytesSent:             nbr.BytesWritten(),
+		BytesReceived:         nbr.BytesRead(),
+		PacketsSent:           stats.PacketsSent(),
+		PacketsReceived:       stats.PacketsReceived(),
+		PacketsDropped:        stats.PacketsDropped(),
+		NewMessages:           stats.NewMessages(),
+		DuplicateMessages:     stats.DuplicateMessages(),
+		RequestsSent:          stats.RequestsSent(),
+		RequestsReceived:      stats.RequestsReceived(),
+		RequestsAnswered:      stats.RequestsAnswered(),
+		RoundTripTime:         stats.RoundTripTime().Milliseconds(),
+		Reputation:            gossipPlugin.Reputation().Score(nbr.ID()),
+		Synced:                heartbeat.GetSynced(),
+		LastHeartbeat:         heartbeatReceived,
+	}
+}
+
+// NeighborsResponse contains the connected gossip neighbors of the node.
+type NeighborsResponse struct {
+	Neighbors []Neighbor `json:"neighbors,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// Neighbor contains the statistics of the traffic that was exchanged with a neighbor since the connection was
+// established.
+type Neighbor struct {
+	ID                    string    `json:"id"`                    // comparable node identifier
+	PublicKey             string    `json:"publicKey"`             // public key used to verify signatures
+	Address               string    `json:"address"`               // network address of the gossip service
+	Outbound              bool      `json:"outbound"`              // whether the connection was dialed by the node
+	ConnectionEstablished time.Time `json:"connectionEstablished"` // time when the connection was established
+	BytesSent             uint64    `json:"bytesSent"`
+	BytesReceived         uint64    `json:"bytesReceived"`
+	PacketsSent           uint64    `json:"packetsSent"`
+	PacketsReceived       uint64    `json:"packetsReceived"`
+	PacketsDropped        uint64    `json:"packetsDropped"`    // packets that were dropped because the send queue was full
+	NewMessages           uint64    `json:"newMessages"`       // received messages that were unknown to the node
+	DuplicateMessages     uint64    `json:"duplicateMessages"` // received messages that were already known to the node
+	RequestsSent          uint64    `json:"requestsSent"`
+	RequestsReceived      uint64    `json:"requestsReceived"`
+	RequestsAnswered      uint64    `json:"requestsAnswered"`
+	RoundTripTime         int64     `json:"roundTripTime"` // average time between a message request and its answer in ms
+	Reputation            float64   `json:"reputation"`    // score that is lowered by misbehavior (0 is the best)
+	Synced                bool      `json:"synced"`        // whether the neighbor reported to be in sync in its last heartbeat
+	LastHeartbeat         time.Time `json:"lastHeartbeat"` // time when the last heartbeat was received (zero if none)
+}
@@ -0,0 +1,38 @@
+package mana
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/mana"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
+	"github.com/labstack/echo"
+)
+
+// getAllManaHandler handles the request to retrieve the access and consensus mana of all nodes, sorted in descending
+// order.
+func getAllManaHandler(c echo.Context) error {
+	accessManaMap, accessTimestamp, err := manaPlugin.GetManaMap(mana.AccessMana)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, GetAllManaResponse{Error: err.Error()})
+	}
+	consensusManaMap, consensusTimestamp, err := manaPlugin.GetManaMap(mana.ConsensusMana)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, GetAllManaResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, GetAllManaResponse{
+		Access:             nodeStrs(accessManaMap.SortedNodes()),
+		AccessTimestamp:    accessTimestamp.Unix(),
+		Consensus:          nodeStrs(consensusManaMap.SortedNodes()),
+		ConsensusTimestamp: consensusTimestamp.Unix(),
+	})
+}
+
+// GetAllManaResponse is the response of the request to retrieve the mana of all nodes.
+type GetAllManaResponse struct {
+	Access             []NodeStr `json:"access"`
+	AccessTimestamp    int64     `json:"accessTimestamp"`
+	Consensus          []NodeStr `json:"consensus"`
+	ConsensusTimestamp int64     `json:"consensusTimestamp"`
+	Error              string    `json:"error,omitempty"`
+}
@@ -0,0 +1,38 @@
+package mana
+
+import (
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/local"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+// NodeStr represents a node and its mana value in the responses of the mana endpoints.
+type NodeStr struct {
+	ShortNodeID string  `json:"shortNodeID"`
+	NodeID      string  `json:"nodeID"`
+	Mana        float64 `json:"mana"`
+}
+
+// nodeStrs converts the given nodes into their NodeStr representation.
+func nodeStrs(nodes []mana.Node) (result []NodeStr) {
+	result = make([]NodeStr, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, NodeStr{
+			ShortNodeID: node.ID.String(),
+			NodeID:      mana.IDToBase58(node.ID),
+			Mana:        node.Mana,
+		})
+	}
+
+	return
+}
+
+// nodeIDFromQueryParam parses the base58 encoded full nodeID of a request and falls back to the ID of the local node if
+// no nodeID was provided.
+func nodeIDFromQueryParam(base58NodeID string) (identity.ID, error) {
+	if base58NodeID == "" {
+		return local.GetInstance().ID(), nil
+	}
+
+	return mana.IDFromBase58(base58NodeID)
+}
@@ -0,0 +1,48 @@
+package mana
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/mana"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
+	"github.com/labstack/echo"
+	"golang.org/x/xerrors"
+)
+
+// getManaHandler handles the request to retrieve the access and consensus mana of a node. If no nodeID is provided, the
+// mana of the local node is returned.
+func getManaHandler(c echo.Context) error {
+	nodeID, err := nodeIDFromQueryParam(c.QueryParam("nodeID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, GetManaResponse{Error: err.Error()})
+	}
+
+	accessMana, accessTimestamp, err := manaPlugin.GetAccessMana(nodeID)
+	if err != nil && !xerrors.Is(err, mana.ErrNodeNotFoundInBaseManaVector) {
+		return c.JSON(http.StatusInternalServerError, GetManaResponse{Error: err.Error()})
+	}
+	consensusMana, consensusTimestamp, err := manaPlugin.GetConsensusMana(nodeID)
+	if err != nil && !xerrors.Is(err, mana.ErrNodeNotFoundInBaseManaVector) {
+		return c.JSON(http.StatusInternalServerError, GetManaResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, GetManaResponse{
+		ShortNodeID:        nodeID.String(),
+		NodeID:             mana.IDToBase58(nodeID),
+		Access:             accessMana,
+		AccessTimestamp:    accessTimestamp.Unix(),
+		Consensus:          consensusMana,
+		ConsensusTimestamp: consensusTimestamp.Unix(),
+	})
+}
+
+// GetManaResponse is the response of the mana request of a single node.
+type GetManaResponse struct {
+	Error              string  `json:"error,omitempty"`
+	ShortNodeID        string  `json:"shortNodeID"`
+	NodeID             string  `json:"nodeID"`
+	Access             float64 `json:"access"`
+	AccessTimestamp    int64   `json:"accessTimestamp"`
+	Consensus          float64 `json:"consensus"`
+	ConsensusTimestamp int64   `json:"consensusTimestamp"`
+}
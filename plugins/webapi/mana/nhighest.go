@@ -0,0 +1,45 @@
+package mana
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/iotaledger/goshimmer/packages/mana"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
+	"github.com/labstack/echo"
+)
+
+// getNHighestAccessHandler handles the request to retrieve the N nodes with the highest access mana.
+func getNHighestAccessHandler(c echo.Context) error {
+	return getNHighestHandler(c, mana.AccessMana)
+}
+
+// getNHighestConsensusHandler handles the request to retrieve the N nodes with the highest consensus mana.
+func getNHighestConsensusHandler(c echo.Context) error {
+	return getNHighestHandler(c, mana.ConsensusMana)
+}
+
+// getNHighestHandler retrieves the N nodes with the highest mana of the given type.
+func getNHighestHandler(c echo.Context, manaType mana.Type) error {
+	number, err := strconv.ParseUint(c.QueryParam("number"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, GetNHighestResponse{Error: err.Error()})
+	}
+
+	manaMap, timestamp, err := manaPlugin.GetManaMap(manaType)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, GetNHighestResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, GetNHighestResponse{
+		Nodes:     nodeStrs(manaMap.HighestNodes(uint(number))),
+		Timestamp: timestamp.Unix(),
+	})
+}
+
+// GetNHighestResponse is the response of the request to retrieve the N nodes with the highest mana.
+type GetNHighestResponse struct {
+	Error     string    `json:"error,omitempty"`
+	Nodes     []NodeStr `json:"nodes,omitempty"`
+	Timestamp int64     `json:"timestamp"`
+}
@@ -0,0 +1,64 @@
+package mana
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/mana"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/labstack/echo"
+	"golang.org/x/xerrors"
+)
+
+// getPercentileHandler handles the request to retrieve the percentage of nodes that have less access and consensus
+// mana than the given node. If no nodeID is provided, the percentile of the local node is returned.
+func getPercentileHandler(c echo.Context) error {
+	nodeID, err := nodeIDFromQueryParam(c.QueryParam("nodeID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, GetPercentileResponse{Error: err.Error()})
+	}
+
+	accessPercentile, accessTimestamp, err := percentile(mana.AccessMana, nodeID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, GetPercentileResponse{Error: err.Error()})
+	}
+	consensusPercentile, consensusTimestamp, err := percentile(mana.ConsensusMana, nodeID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, GetPercentileResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, GetPercentileResponse{
+		ShortNodeID:        nodeID.String(),
+		NodeID:             mana.IDToBase58(nodeID),
+		Access:             accessPercentile,
+		AccessTimestamp:    accessTimestamp.Unix(),
+		Consensus:          consensusPercentile,
+		ConsensusTimestamp: consensusTimestamp.Unix(),
+	})
+}
+
+// percentile calculates the percentile of the given node for the given mana Type. Nodes without mana are in the 0th
+// percentile.
+func percentile(manaType mana.Type, nodeID identity.ID) (percentile float64, timestamp time.Time, err error) {
+	manaMap, timestamp, err := manaPlugin.GetManaMap(manaType)
+	if err != nil {
+		return
+	}
+	if percentile, err = manaMap.Percentile(nodeID); xerrors.Is(err, mana.ErrNodeNotFoundInBaseManaVector) {
+		err = nil
+	}
+
+	return
+}
+
+// GetPercentileResponse is the response of the percentile request.
+type GetPercentileResponse struct {
+	Error              string  `json:"error,omitempty"`
+	ShortNodeID        string  `json:"shortNodeID"`
+	NodeID             string  `json:"nodeID"`
+	Access             float64 `json:"access"`
+	AccessTimestamp    int64   `json:"accessTimestamp"`
+	Consensus          float64 `json:"consensus"`
+	ConsensusTimestamp int64   `json:"consensusTimestamp"`
+}
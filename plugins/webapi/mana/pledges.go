@@ -0,0 +1,57 @@
+package mana
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
+	"github.com/labstack/echo"
+)
+
+// getPledgeLogHandler handles the request to retrieve the mana pledge and revocation events of a transaction. If no
+// txID is provided, the events of the most recent transactions are returned.
+func getPledgeLogHandler(c echo.Context) error {
+	var entries []manaPlugin.PledgeLogEntry
+	if base58TxID := c.QueryParam("txID"); base58TxID != "" {
+		transactionID, err := ledgerstate.TransactionIDFromBase58(base58TxID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, GetPledgeLogResponse{Error: err.Error()})
+		}
+		entries = manaPlugin.PledgeLog(transactionID)
+	} else {
+		entries = manaPlugin.RecentPledgeLog()
+	}
+
+	pledges := make([]PledgeLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		pledges = append(pledges, PledgeLogEntry{
+			Revoked:     entry.Revoked,
+			ShortNodeID: entry.NodeID.String(),
+			NodeID:      mana.IDToBase58(entry.NodeID),
+			Amount:      entry.Amount,
+			Time:        entry.Time.Unix(),
+			ManaType:    entry.ManaType.String(),
+			TxID:        entry.TransactionID.Base58(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, GetPledgeLogResponse{Pledges: pledges})
+}
+
+// GetPledgeLogResponse is the response of the pledge log request.
+type GetPledgeLogResponse struct {
+	Pledges []PledgeLogEntry `json:"pledges"`
+	Error   string           `json:"error,omitempty"`
+}
+
+// PledgeLogEntry represents a single pledge or revocation of mana in the responses of the pledge log endpoint.
+type PledgeLogEntry struct {
+	Revoked     bool    `json:"revoked"`
+	ShortNodeID string  `json:"shortNodeID"`
+	NodeID      string  `json:"nodeID"`
+	Amount      float64 `json:"amount"`
+	Time        int64   `json:"time"`
+	ManaType    string  `json:"manaType"`
+	TxID        string  `json:"txID"`
+}
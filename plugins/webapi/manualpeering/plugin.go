@@ -0,0 +1,121 @@
+package manualpeering
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	gossipPlugin "github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+)
+
+// PluginName is the name of the web API manual peering endpoint plugin.
+const PluginName = "WebAPI manual peering Endpoint"
+
+var (
+	// plugin is the plugin instance of the web API manual peering endpoint plugin.
+	plugin *node.Plugin
+	once   sync.Once
+)
+
+// Plugin gets the plugin instance.
+func Plugin() *node.Plugin {
+	once.Do(func() {
+		plugin = node.NewPlugin(PluginName, node.Enabled, configure)
+	})
+	return plugin
+}
+
+func configure(*node.Plugin) {
+	webapi.Server().GET("manualpeering/peers", getPeersHandler)
+	webapi.Server().POST("manualpeering/peers", addPeersHandler)
+	webapi.Server().DELETE("manualpeering/peers", removePeerHandler)
+}
+
+// getPeersHandler returns the manual peers of the node and whether they are currently connected.
+func getPeersHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, peersResponse())
+}
+
+// addPeersHandler adds the given manual peers (publicKey@host:gossipPort). Peers that were already added are ignored.
+func addPeersHandler(c echo.Context) error {
+	var request PeersRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, PeersResponse{Error: err.Error()})
+	}
+
+	peers := make([]*peer.Peer, 0, len(request.Peers))
+	for _, definition := range request.Peers {
+		p, err := gossipPlugin.ParseManualPeer(definition)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, PeersResponse{Error: err.Error()})
+		}
+		peers = append(peers, p)
+	}
+
+	for _, p := range peers {
+		if err := gossipPlugin.ManualPeering().AddPeer(p); err != nil && !errors.Is(err, gossip.ErrManualPeerExists) {
+			return c.JSON(http.StatusBadRequest, PeersResponse{Error: err.Error()})
+		}
+	}
+
+	return c.JSON(http.StatusOK, peersResponse())
+}
+
+// removePeerHandler removes the manual peer with the given public key and drops the connection to it.
+func removePeerHandler(c echo.Context) error {
+	publicKey, err := gossipPlugin.ParsePublicKey(c.QueryParam("publicKey"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, PeersResponse{Error: err.Error()})
+	}
+
+	if err := gossipPlugin.ManualPeering().RemovePeer(identity.NewID(publicKey)); err != nil {
+		if errors.Is(err, gossip.ErrUnknownManualPeer) {
+			return c.JSON(http.StatusNotFound, PeersResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, PeersResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, peersResponse())
+}
+
+// peersResponse creates the PeersResponse that contains all manual peers.
+func peersResponse() PeersResponse {
+	peers := gossipPlugin.ManualPeering().Peers()
+
+	response := PeersResponse{Peers: make([]Peer, 0, len(peers))}
+	for _, p := range peers {
+		response.Peers = append(response.Peers, Peer{
+			ID:        p.ID().String(),
+			PublicKey: p.PublicKey().String(),
+			Address:   gossip.GetAddress(p),
+			Connected: gossipPlugin.Manager().IsNeighbor(p.ID()),
+		})
+	}
+
+	return response
+}
+
+// PeersRequest contains the manual peers that should be added.
+type PeersRequest struct {
+	Peers []string `json:"peers"` // peers of the form publicKey@host:gossipPort
+}
+
+// PeersResponse contains the manual peers of the node.
+type PeersResponse struct {
+	Peers []Peer `json:"peers,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Peer contains information about a manual peer.
+type Peer struct {
+	ID        string `json:"id"`        // comparable node identifier
+	PublicKey string `json:"publicKey"` // public key used to verify signatures
+	Address   string `json:"address"`   // network address of the gossip service
+	Connected bool   `json:"connected"` // whether the gossip connection is currently established
+}
@@ -0,0 +1,48 @@
+package message
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/labstack/echo"
+)
+
+// childrenHandler returns the (base58 encoded) IDs of the messages that reference the message with the given ID as a
+// strong or weak parent.
+func childrenHandler(c echo.Context) error {
+	return getChildren(c, messagelayer.Tangle())
+}
+
+func getChildren(c echo.Context, messageTangle *tangle.Tangle) error {
+	messageID, err := tangle.NewMessageID(c.QueryParam("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ChildrenResponse{Error: err.Error()})
+	}
+
+	if !messageTangle.Storage.MessageMetadata(messageID).Consume(func(*tangle.MessageMetadata) {}) {
+		return c.JSON(http.StatusNotFound, ChildrenResponse{Error: "Message not found"})
+	}
+
+	response := ChildrenResponse{
+		StrongChildren: make([]string, 0),
+		WeakChildren:   make([]string, 0),
+	}
+	messageTangle.Storage.Approvers(messageID).Consume(func(approver *tangle.Approver) {
+		switch approver.Type() {
+		case tangle.StrongApprover:
+			response.StrongChildren = append(response.StrongChildren, approver.ApproverMessageID().String())
+		case tangle.WeakApprover:
+			response.WeakChildren = append(response.WeakChildren, approver.ApproverMessageID().String())
+		}
+	})
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ChildrenResponse contains the IDs of the messages that approve a message.
+type ChildrenResponse struct {
+	StrongChildren []string `json:"strongChildren,omitempty"`
+	WeakChildren   []string `json:"weakChildren,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
@@ -0,0 +1,130 @@
+package message
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/labstack/echo"
+)
+
+// metadataHandler returns the metadata of the message with the given (base58 encoded) ID.
+func metadataHandler(c echo.Context) error {
+	return getMetadata(c, messagelayer.Tangle())
+}
+
+func getMetadata(c echo.Context, messageTangle *tangle.Tangle) error {
+	messageID, err := tangle.NewMessageID(c.QueryParam("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, MetadataResponse{Error: err.Error()})
+	}
+
+	var response MetadataResponse
+	if !messageTangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+		response = newMetadataResponse(messageMetadata)
+	}) {
+		return c.JSON(http.StatusNotFound, MetadataResponse{Error: "Message not found"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// newMetadataResponse creates the JSON representation of the given MessageMetadata.
+func newMetadataResponse(messageMetadata *tangle.MessageMetadata) MetadataResponse {
+	timestampOpinion := messageMetadata.TimestampOpinion()
+
+	return MetadataResponse{
+		ID:                 messageMetadata.ID().String(),
+		ReceivedTime:       unixTime(messageMetadata.ReceivedTime()),
+		Solid:              messageMetadata.IsSolid(),
+		SolidificationTime: unixTime(messageMetadata.SolidificationTime()),
+		StructureDetails:   newStructureDetails(messageMetadata.StructureDetails()),
+		BranchID:           messageMetadata.BranchID().String(),
+		TimestampOpinion: TimestampOpinion{
+			Value: timestampOpinion.Value.String(),
+			LoK:   uint8(timestampOpinion.LoK),
+		},
+		Scheduled:        messageMetadata.Scheduled(),
+		ScheduledTime:    unixTime(messageMetadata.ScheduledTime()),
+		Booked:           messageMetadata.IsBooked(),
+		Eligible:         messageMetadata.IsEligible(),
+		Invalid:          messageMetadata.IsInvalid(),
+		Confirmed:        messageMetadata.IsConfirmed(),
+		ConfirmationTime: unixTime(messageMetadata.ConfirmationTime()),
+	}
+}
+
+// unixTime returns the unix timestamp of the given time or 0 if it was never set, so that the field is omitted.
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
+// newStructureDetails creates the JSON representation of the given StructureDetails (nil if the message was not booked
+// into the marker DAG, yet).
+func newStructureDetails(structureDetails *markers.StructureDetails) *StructureDetails {
+	if structureDetails == nil {
+		return nil
+	}
+
+	return &StructureDetails{
+		Rank:          structureDetails.Rank,
+		IsPastMarker:  structureDetails.IsPastMarker,
+		PastMarkers:   newMarkers(structureDetails.PastMarkers),
+		FutureMarkers: newMarkers(structureDetails.FutureMarkers),
+	}
+}
+
+// newMarkers creates the JSON representation of the given Markers.
+func newMarkers(structureMarkers *markers.Markers) (jsonMarkers map[uint64]uint64) {
+	jsonMarkers = make(map[uint64]uint64)
+	if structureMarkers == nil {
+		return
+	}
+
+	structureMarkers.ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
+		jsonMarkers[uint64(sequenceID)] = uint64(index)
+		return true
+	})
+
+	return
+}
+
+// MetadataResponse is the HTTP response containing the metadata of a message. All times are unix timestamps and are
+// omitted if the corresponding event did not happen, yet.
+type MetadataResponse struct {
+	ID                 string            `json:"id,omitempty"`
+	ReceivedTime       int64             `json:"receivedTime,omitempty"`
+	Solid              bool              `json:"solid"`
+	SolidificationTime int64             `json:"solidificationTime,omitempty"`
+	StructureDetails   *StructureDetails `json:"structureDetails,omitempty"`
+	BranchID           string            `json:"branchID,omitempty"`
+	TimestampOpinion   TimestampOpinion  `json:"timestampOpinion"`
+	Scheduled          bool              `json:"scheduled"`
+	ScheduledTime      int64             `json:"scheduledTime,omitempty"`
+	Booked             bool              `json:"booked"`
+	Eligible           bool              `json:"eligible"`
+	Invalid            bool              `json:"invalid"`
+	Confirmed          bool              `json:"confirmed"`
+	ConfirmationTime   int64             `json:"confirmationTime,omitempty"`
+	Error              string            `json:"error,omitempty"`
+}
+
+// StructureDetails contains the position of a message in the marker DAG. The markers map sequence IDs to indexes.
+type StructureDetails struct {
+	Rank          uint64            `json:"rank"`
+	IsPastMarker  bool              `json:"isPastMarker"`
+	PastMarkers   map[uint64]uint64 `json:"pastMarkers"`
+	FutureMarkers map[uint64]uint64 `json:"futureMarkers"`
+}
+
+// TimestampOpinion contains the opinion of the node about the timestamp of a message and its level of knowledge.
+type TimestampOpinion struct {
+	Value string `json:"value"`
+	LoK   uint8  `json:"lok"`
+}
@@ -0,0 +1,83 @@
+package message
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMetadata(t *testing.T) {
+	messageTangle := tangle.New()
+	defer messageTangle.Shutdown()
+
+	message := newTestMessage("metadata", tangle.EmptyMessageID)
+	messageTangle.Storage.StoreMessage(message)
+
+	// the times of the events that did not happen, yet, are omitted
+	rec := serve(t, getMetadata, messageTangle, message.ID().String())
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "scheduledTime")
+	assert.NotContains(t, rec.Body.String(), "confirmationTime")
+	assert.NotContains(t, rec.Body.String(), "solidificationTime")
+
+	var response MetadataResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, message.ID().String(), response.ID)
+	assert.NotZero(t, response.ReceivedTime)
+	assert.False(t, response.Solid)
+
+	messageTangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *tangle.MessageMetadata) {
+		messageMetadata.SetSolid(true)
+	})
+	rec = serve(t, getMetadata, messageTangle, message.ID().String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.True(t, response.Solid)
+	assert.NotZero(t, response.SolidificationTime)
+
+	rec = serve(t, getMetadata, messageTangle, tangle.MessageID{1}.String())
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(t, getMetadata, messageTangle, "invalid")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetChildren(t *testing.T) {
+	messageTangle := tangle.New()
+	defer messageTangle.Shutdown()
+
+	parent := newTestMessage("parent", tangle.EmptyMessageID)
+	child := newTestMessage("child", parent.ID())
+	messageTangle.Storage.StoreMessage(parent)
+	messageTangle.Storage.StoreMessage(child)
+
+	rec := serve(t, getChildren, messageTangle, parent.ID().String())
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ChildrenResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []string{child.ID().String()}, response.StrongChildren)
+	assert.Empty(t, response.WeakChildren)
+
+	rec = serve(t, getChildren, messageTangle, tangle.MessageID{1}.String())
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func newTestMessage(payloadString string, parent tangle.MessageID) *tangle.Message {
+	return tangle.NewMessage([]tangle.MessageID{parent}, []tangle.MessageID{}, time.Now(), ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte(payloadString)), 0, ed25519.Signature{})
+}
+
+func serve(t *testing.T, handler func(echo.Context, *tangle.Tangle) error, messageTangle *tangle.Tangle, id string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/?id="+id, nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, handler(echo.New().NewContext(req, rec), messageTangle))
+	return rec
+}
@@ -30,4 +30,7 @@ func configure(plugin *node.Plugin) {
 	log = logger.NewLogger(PluginName)
 	webapi.Server().POST("message/findById", findByIDHandler)
 	webapi.Server().POST("message/sendPayload", sendPayloadHandler)
+	webapi.Server().GET("message/solidEntryPoints", solidEntryPointsHandler)
+	webapi.Server().GET("message/metadata", metadataHandler)
+	webapi.Server().GET("message/children", childrenHandler)
 }
@@ -0,0 +1,22 @@
+package message
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/labstack/echo"
+)
+
+// solidEntryPointsHandler returns the base58 encoded IDs of the solid entry points of the node, i.e. the messages that
+// are treated as solid even though their past cone is not known to the node.
+func solidEntryPointsHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, SolidEntryPointsResponse{
+		SolidEntryPoints: messagelayer.Tangle().Storage.SolidEntryPoints().ToStrings(),
+	})
+}
+
+// SolidEntryPointsResponse contains the IDs of the solid entry points.
+type SolidEntryPointsResponse struct {
+	SolidEntryPoints []string `json:"solidEntryPoints"`
+	Error            string   `json:"error,omitempty"`
+}
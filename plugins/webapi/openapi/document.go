@@ -0,0 +1,271 @@
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// version contains the version of the OpenAPI specification that the Document follows.
+const version = "3.0.3"
+
+// pluginsPackagePath contains the import path of the plugins whose types are used to derive the names of the schemas.
+const pluginsPackagePath = "github.com/iotaledger/goshimmer/plugins/"
+
+// region Document /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Document is the root object of an OpenAPI specification.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// newDocument generates the Document of the given endpoints by deriving the schemas of the request and response bodies
+// from their Go types.
+func newDocument(appVersion string, endpoints []*endpoint) (document *Document) {
+	document = &Document{
+		OpenAPI: version,
+		Info: Info{
+			Title:       "GoShimmer Web API",
+			Description: "The HTTP API exposed by a GoShimmer node. Some endpoints are only available if the corresponding plugin is enabled.",
+			Version:     appVersion,
+		},
+		Paths: make(map[string]*PathItem),
+		Components: Components{
+			Schemas: make(map[string]*Schema),
+		},
+	}
+
+	for _, endpoint := range endpoints {
+		pathItem, exists := document.Paths["/"+endpoint.path]
+		if !exists {
+			pathItem = &PathItem{}
+			document.Paths["/"+endpoint.path] = pathItem
+		}
+
+		operation := document.operation(endpoint)
+		switch endpoint.method {
+		case "GET":
+			pathItem.Get = operation
+		case "POST":
+			pathItem.Post = operation
+		case "DELETE":
+			pathItem.Delete = operation
+		default:
+			panic(fmt.Sprintf("unsupported method %s of endpoint %s", endpoint.method, endpoint.path))
+		}
+	}
+
+	return
+}
+
+// operation creates the Operation of the given endpoint and registers the used schemas in the Components.
+func (d *Document) operation(endpoint *endpoint) (operation *Operation) {
+	operation = &Operation{
+		Summary:     endpoint.summary,
+		OperationID: endpoint.operationID,
+		Tags:        []string{endpoint.tag},
+		Parameters:  endpoint.parameters,
+		Responses:   make(map[string]*Response),
+	}
+	if endpoint.request != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  jsonContent(d.schema(reflect.TypeOf(endpoint.request))),
+		}
+	}
+	for _, schemaType := range endpoint.messages {
+		d.schema(reflect.TypeOf(schemaType))
+	}
+
+	switch {
+	case endpoint.responses != nil:
+		operation.Responses = endpoint.responses
+	case endpoint.response != nil:
+		responseSchema := d.schema(reflect.TypeOf(endpoint.response))
+		operation.Responses["200"] = &Response{Description: "successful operation", Content: jsonContent(responseSchema)}
+		operation.Responses["default"] = &Response{Description: "failed operation (see the error field for details)", Content: jsonContent(responseSchema)}
+	}
+
+	return
+}
+
+// schema returns the Schema of the given type. Named structs are registered in the Components and referenced.
+func (d *Document) schema(schemaType reflect.Type) *Schema {
+	if schemaType == reflect.TypeOf(time.Time{}) {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch schemaType.Kind() {
+	case reflect.Ptr:
+		return d.schema(schemaType.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "uint64"}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "uint32"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		if schemaType.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: d.schema(schemaType.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.schema(schemaType.Elem())}
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Struct:
+		return d.structSchema(schemaType)
+	default:
+		panic(fmt.Sprintf("unsupported type %s", schemaType))
+	}
+}
+
+// structSchema registers the Schema of the given struct type in the Components and returns a reference to it.
+func (d *Document) structSchema(structType reflect.Type) *Schema {
+	name := schemaName(structType)
+	reference := &Schema{Ref: "#/components/schemas/" + name}
+	if _, exists := d.Components.Schemas[name]; exists {
+		return reference
+	}
+
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	d.Components.Schemas[name] = schema
+	d.addProperties(schema, structType)
+
+	return reference
+}
+
+// addProperties adds the JSON encoded fields of the given struct type to the Schema (including the fields of embedded
+// structs that are not named by a tag).
+func (d *Document) addProperties(schema *Schema, structType reflect.Type) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tagName, tagOptions := parseTag(field.Tag.Get("json"))
+		if tagName == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+		if field.Anonymous && tagName == "" && field.Type.Kind() == reflect.Struct {
+			d.addProperties(schema, field.Type)
+			continue
+		}
+
+		if tagName == "" {
+			tagName = field.Name
+		}
+		schema.Properties[tagName] = d.schema(field.Type)
+		if !strings.Contains(tagOptions, "omitempty") {
+			schema.Required = append(schema.Required, tagName)
+		}
+	}
+}
+
+// schemaName returns the name of the schema of a named type, which is derived from its package path relative to the
+// plugins (i.e. value.AddressHistoryResponse or tools.message.PastconeResponse).
+func schemaName(namedType reflect.Type) string {
+	packagePath := strings.TrimPrefix(namedType.PkgPath(), pluginsPackagePath)
+	packagePath = strings.TrimPrefix(packagePath, "webapi/")
+
+	return strings.ReplaceAll(packagePath, "/", ".") + "." + namedType.Name()
+}
+
+// parseTag splits a json struct tag into the name and its options.
+func parseTag(tag string) (name string, options string) {
+	if index := strings.Index(tag, ","); index != -1 {
+		return tag[:index], tag[index+1:]
+	}
+
+	return tag, ""
+}
+
+// jsonContent returns the content map of a request or response body that is encoded as JSON.
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Document elements ////////////////////////////////////////////////////////////////////////////////////////////
+
+// Info contains the metadata of the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem describes the operations that are available on a single path.
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+}
+
+// Operation describes a single API operation on a path.
+type Operation struct {
+	Summary     string               `json:"summary,omitempty"`
+	OperationID string               `json:"operationId"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter describes a single query parameter of an Operation.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Explode     *bool   `json:"explode,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request.
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response describes a single response of an Operation.
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType contains the Schema of a request or response body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable Schemas that are referenced by the Operations.
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema describes the structure of a JSON value.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
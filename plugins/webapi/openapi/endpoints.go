@@ -0,0 +1,513 @@
+package openapi
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/networkdelay"
+	"github.com/iotaledger/goshimmer/plugins/spammer"
+	"github.com/iotaledger/goshimmer/plugins/webapi/autopeering"
+	"github.com/iotaledger/goshimmer/plugins/webapi/data"
+	"github.com/iotaledger/goshimmer/plugins/webapi/drng"
+	"github.com/iotaledger/goshimmer/plugins/webapi/faucet"
+	"github.com/iotaledger/goshimmer/plugins/webapi/gossip"
+	"github.com/iotaledger/goshimmer/plugins/webapi/info"
+	"github.com/iotaledger/goshimmer/plugins/webapi/mana"
+	"github.com/iotaledger/goshimmer/plugins/webapi/manualpeering"
+	"github.com/iotaledger/goshimmer/plugins/webapi/message"
+	"github.com/iotaledger/goshimmer/plugins/webapi/snapshot"
+	"github.com/iotaledger/goshimmer/plugins/webapi/subscriptions"
+	toolsmessage "github.com/iotaledger/goshimmer/plugins/webapi/tools/message"
+	toolsvalue "github.com/iotaledger/goshimmer/plugins/webapi/tools/value"
+	"github.com/iotaledger/goshimmer/plugins/webapi/value"
+)
+
+// endpoint describes a route of the web API together with the types of its request and response bodies.
+type endpoint struct {
+	method      string
+	path        string
+	operationID string
+	tag         string
+	summary     string
+	parameters  []*Parameter
+	request     interface{}
+	response    interface{}
+
+	// responses overrides the generated responses for endpoints that do not respond with JSON.
+	responses map[string]*Response
+
+	// messages contains the types that are exchanged over a websocket connection.
+	messages []interface{}
+}
+
+// endpoints contains all routes that are registered at the web API by the plugins of a GoShimmer node.
+var endpoints = []*endpoint{
+	// region autopeering //////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "autopeering/neighbors",
+		operationID: "getNeighbors",
+		tag:         "autopeering",
+		summary:     "Returns the chosen and accepted neighbors of the node and optionally its known peers.",
+		parameters:  []*Parameter{queryParameter("known", "set to 1 to also return the known peers", false, &Schema{Type: "string", Enum: []string{"0", "1"}})},
+		response:    autopeering.Response{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region data /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "POST",
+		path:        "data",
+		operationID: "broadcastData",
+		tag:         "data",
+		summary:     "Issues a message with a data payload.",
+		request:     data.Request{},
+		response:    data.Response{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region drng /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "POST",
+		path:        "drng/collectiveBeacon",
+		operationID: "broadcastCollectiveBeacon",
+		tag:         "drng",
+		summary:     "Issues a message with a collective beacon payload.",
+		request:     drng.CollectiveBeaconRequest{},
+		response:    drng.CollectiveBeaconResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "drng/info/committee",
+		operationID: "getCommittee",
+		tag:         "drng",
+		summary:     "Returns the current dRNG committees.",
+		response:    drng.CommitteeResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "drng/info/randomness",
+		operationID: "getRandomness",
+		tag:         "drng",
+		summary:     "Returns the current randomness of the dRNG instances.",
+		response:    drng.RandomnessResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region faucet ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "POST",
+		path:        "faucet",
+		operationID: "requestFunds",
+		tag:         "faucet",
+		summary:     "Issues a faucet request for the given address.",
+		request:     faucet.Request{},
+		response:    faucet.Response{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region gossip ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "gossip/neighbors",
+		operationID: "getGossipNeighbors",
+		tag:         "gossip",
+		summary:     "Returns the connected gossip neighbors together with the statistics of their traffic.",
+		response:    gossip.NeighborsResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region healthz //////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "healthz",
+		operationID: "getHealthz",
+		tag:         "healthz",
+		summary:     "Returns if the node is healthy.",
+		responses: map[string]*Response{
+			"200": {Description: "the node is healthy"},
+			"503": {Description: "the node is not healthy"},
+		},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region info /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "info",
+		operationID: "getInfo",
+		tag:         "info",
+		summary:     "Returns the general information about the node.",
+		response:    info.Response{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region mana /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "mana",
+		operationID: "getMana",
+		tag:         "mana",
+		summary:     "Returns the access and consensus mana of a node.",
+		parameters:  []*Parameter{queryParameter("nodeID", "base58 encoded ID of the node (defaults to the local node)", false, &Schema{Type: "string"})},
+		response:    mana.GetManaResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "mana/all",
+		operationID: "getAllMana",
+		tag:         "mana",
+		summary:     "Returns the access and consensus mana of all nodes.",
+		response:    mana.GetAllManaResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "mana/access/nhighest",
+		operationID: "getNHighestAccessMana",
+		tag:         "mana",
+		summary:     "Returns the nodes with the highest access mana.",
+		parameters:  []*Parameter{queryParameter("number", "amount of nodes to return", true, &Schema{Type: "integer", Format: "uint32"})},
+		response:    mana.GetNHighestResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "mana/consensus/nhighest",
+		operationID: "getNHighestConsensusMana",
+		tag:         "mana",
+		summary:     "Returns the nodes with the highest consensus mana.",
+		parameters:  []*Parameter{queryParameter("number", "amount of nodes to return", true, &Schema{Type: "integer", Format: "uint32"})},
+		response:    mana.GetNHighestResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "mana/percentile",
+		operationID: "getManaPercentile",
+		tag:         "mana",
+		summary:     "Returns the percentile of the mana of a node compared to all other nodes.",
+		parameters:  []*Parameter{queryParameter("nodeID", "base58 encoded ID of the node (defaults to the local node)", false, &Schema{Type: "string"})},
+		response:    mana.GetPercentileResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "mana/pledges",
+		operationID: "getManaPledges",
+		tag:         "mana",
+		summary:     "Returns the logged mana pledges.",
+		parameters:  []*Parameter{queryParameter("txID", "base58 encoded ID of a transaction to filter the pledges", false, &Schema{Type: "string"})},
+		response:    mana.GetPledgeLogResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region manualpeering ////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "manualpeering/peers",
+		operationID: "getManualPeers",
+		tag:         "manualpeering",
+		summary:     "Returns the manual peers of the node and whether they are connected.",
+		response:    manualpeering.PeersResponse{},
+	},
+	{
+		method:      "POST",
+		path:        "manualpeering/peers",
+		operationID: "addManualPeers",
+		tag:         "manualpeering",
+		summary:     "Adds manual peers that the node keeps connected to (peers that were already added are ignored).",
+		request:     manualpeering.PeersRequest{},
+		response:    manualpeering.PeersResponse{},
+	},
+	{
+		method:      "DELETE",
+		path:        "manualpeering/peers",
+		operationID: "removeManualPeer",
+		tag:         "manualpeering",
+		summary:     "Removes a manual peer and drops the connection to it.",
+		parameters:  []*Parameter{queryParameter("publicKey", "base58 encoded public key of the peer", true, &Schema{Type: "string"})},
+		response:    manualpeering.PeersResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region message //////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "POST",
+		path:        "message/findById",
+		operationID: "findMessagesByID",
+		tag:         "message",
+		summary:     "Returns the messages with the given IDs (empty if a message is unknown).",
+		request:     message.FindByIDRequest{},
+		response:    message.FindByIDResponse{},
+	},
+	{
+		method:      "POST",
+		path:        "message/sendPayload",
+		operationID: "sendPayload",
+		tag:         "message",
+		summary:     "Issues a message with the given marshaled payload.",
+		request:     message.SendPayloadRequest{},
+		response:    message.SendPayloadResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "message/solidEntryPoints",
+		operationID: "getSolidEntryPoints",
+		tag:         "message",
+		summary:     "Returns the IDs of the solid entry points of the node.",
+		response:    message.SolidEntryPointsResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "message/metadata",
+		operationID: "getMessageMetadata",
+		tag:         "message",
+		summary:     "Returns the metadata of a message.",
+		parameters:  []*Parameter{queryParameter("id", "base58 encoded ID of the message", true, &Schema{Type: "string"})},
+		response:    message.MetadataResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "message/children",
+		operationID: "getMessageChildren",
+		tag:         "message",
+		summary:     "Returns the IDs of the messages that reference a message as a strong or weak parent.",
+		parameters:  []*Parameter{queryParameter("id", "base58 encoded ID of the message", true, &Schema{Type: "string"})},
+		response:    message.ChildrenResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region openapi //////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "openapi.json",
+		operationID: "getOpenAPISpecification",
+		tag:         "openapi",
+		summary:     "Returns this OpenAPI specification of the web API.",
+		responses: map[string]*Response{
+			"200": {Description: "the OpenAPI document", Content: jsonContent(&Schema{Type: "object"})},
+		},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region networkdelay /////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "POST",
+		path:        "networkdelay",
+		operationID: "broadcastNetworkDelayObject",
+		tag:         "networkdelay",
+		summary:     "Issues a message with a network delay object.",
+		response:    networkdelay.Response{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region snapshot /////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "snapshot",
+		operationID: "getSnapshot",
+		tag:         "snapshot",
+		summary:     "Returns a snapshot of the confirmed ledger state that can be used to bootstrap other nodes.",
+		parameters:  []*Parameter{queryParameter("mana", "set to true to include the base mana vectors", false, &Schema{Type: "boolean"})},
+		response:    snapshot.GetSnapshotResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region spammer //////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "spammer",
+		operationID: "controlSpammer",
+		tag:         "spammer",
+		summary:     "Starts or stops the spammer of the node.",
+		parameters: []*Parameter{
+			queryParameter("cmd", "command that is executed by the spammer", true, &Schema{Type: "string", Enum: []string{"start", "stop"}}),
+			queryParameter("mpm", "messages per minute that are issued after starting the spammer", false, &Schema{Type: "integer", Format: "int64"}),
+		},
+		response: spammer.Response{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region subscriptions ////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "subscriptions",
+		operationID: "subscribe",
+		tag:         "subscriptions",
+		summary:     "Upgrades the connection to a websocket that accepts subscriptions.Request messages and streams subscriptions.Event messages.",
+		responses: map[string]*Response{
+			"101": {Description: "switching to the websocket protocol"},
+		},
+		messages: []interface{}{subscriptions.Request{}, subscriptions.Event{}},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region tools ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "tools/message/pastcone",
+		operationID: "checkPastCone",
+		tag:         "tools",
+		summary:     "Checks if the past cone of a message is complete (the request body is sent with the GET request).",
+		request:     toolsmessage.PastconeRequest{},
+		response:    toolsmessage.PastconeResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "tools/message/missing",
+		operationID: "getMissingMessages",
+		tag:         "tools",
+		summary:     "Returns the IDs of the messages that are missing in the tangle of the node.",
+		response:    toolsmessage.MissingResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "tools/message/approval",
+		operationID: "runApprovalAnalysis",
+		tag:         "tools",
+		summary:     "Writes the first approvers of all messages to a csv file.",
+		response:    toolsmessage.ApprovalResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "tools/message/orphanage",
+		operationID: "runOrphanageAnalysis",
+		tag:         "tools",
+		summary:     "Writes the orphanage statistics of the messages of the node to a csv file.",
+		parameters:  []*Parameter{queryParameter("msgID", "base58 encoded ID of the message that is analyzed", true, &Schema{Type: "string"})},
+		response:    toolsmessage.OrphanageResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "tools/value/objects",
+		operationID: "getValueObjects",
+		tag:         "tools",
+		summary:     "Returns the transactions that are attached to the genesis.",
+		response:    toolsvalue.ObjectsResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// region value ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	{
+		method:      "GET",
+		path:        "value/attachments",
+		operationID: "getAttachments",
+		tag:         "value",
+		summary:     "Returns the messages that contain a transaction.",
+		parameters:  []*Parameter{queryParameter("txnID", "base58 encoded ID of the transaction", true, &Schema{Type: "string"})},
+		response:    value.AttachmentsResponse{},
+	},
+	{
+		method:      "POST",
+		path:        "value/unspentOutputs",
+		operationID: "getUnspentOutputs",
+		tag:         "value",
+		summary:     "Returns the unspent outputs of the given addresses.",
+		request:     value.UnspentOutputsRequest{},
+		response:    value.UnspentOutputsResponse{},
+	},
+	{
+		method:      "POST",
+		path:        "value/sendTransaction",
+		operationID: "sendTransaction",
+		tag:         "value",
+		summary:     "Issues a message with the given marshaled transaction.",
+		request:     value.SendTransactionRequest{},
+		response:    value.SendTransactionResponse{},
+	},
+	{
+		method:      "POST",
+		path:        "value/sendTransactionByJson",
+		operationID: "sendTransactionByJSON",
+		tag:         "value",
+		summary:     "Issues a message with the transaction that is described by the given JSON object.",
+		request:     value.SendTransactionByJSONRequest{},
+		response:    value.SendTransactionByJSONResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "value/transactionByID",
+		operationID: "getTransactionByID",
+		tag:         "value",
+		summary:     "Returns a transaction together with its inclusion state.",
+		parameters:  []*Parameter{queryParameter("txnID", "base58 encoded ID of the transaction", true, &Schema{Type: "string"})},
+		response:    value.GetTransactionByIDResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "value/colorSupply",
+		operationID: "getColorSupply",
+		tag:         "value",
+		summary:     "Returns the amount of minted and destroyed tokens of a color.",
+		parameters:  []*Parameter{queryParameter("color", "base58 encoded color", true, &Schema{Type: "string"})},
+		response:    value.ColorSupplyResponse{},
+	},
+	{
+		method:      "GET",
+		path:        "value/addressHistory",
+		operationID: "getAddressHistory",
+		tag:         "value",
+		summary:     "Returns a page of the outputs that were ever created on an address.",
+		parameters: []*Parameter{
+			queryParameter("address", "base58 encoded address", true, &Schema{Type: "string"}),
+			queryParameter("cursor", "next_cursor of the previous page", false, &Schema{Type: "string"}),
+			queryParameter("limit", "maximum amount of outputs that are returned", false, &Schema{Type: "integer", Format: "int64"}),
+			arrayQueryParameter("color", "base58 encoded colors that the returned outputs need to hold at least one of", &Schema{Type: "string"}),
+		},
+		response: value.AddressHistoryResponse{},
+	},
+
+	// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////
+}
+
+// queryParameter is a utility function that creates a Parameter that is passed in the query string.
+func queryParameter(name string, description string, required bool, schema *Schema) *Parameter {
+	return &Parameter{
+		Name:        name,
+		In:          "query",
+		Description: description,
+		Required:    required,
+		Schema:      schema,
+	}
+}
+
+// arrayQueryParameter is a utility function that creates an optional Parameter that can be passed several times in
+// the query string.
+func arrayQueryParameter(name string, description string, itemSchema *Schema) *Parameter {
+	explode := true
+
+	return &Parameter{
+		Name:        name,
+		In:          "query",
+		Description: description,
+		Explode:     &explode,
+		Schema:      &Schema{Type: "array", Items: itemSchema},
+	}
+}
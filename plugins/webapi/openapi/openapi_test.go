@@ -0,0 +1,168 @@
+package openapi
+
+import (
+	"encoding/json"
+	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/iotaledger/goshimmer/plugins/banner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// specFile contains the path of the committed OpenAPI document that clients are generated from.
+const specFile = "openapi.json"
+
+var update = flag.Bool("update", false, "update the committed OpenAPI document")
+
+// TestSpec_Routes checks that the Document describes exactly the routes that the plugins register at the web API.
+func TestSpec_Routes(t *testing.T) {
+	registeredRoutes := make(map[string]bool)
+	err := filepath.Walk(filepath.Join("..", ".."), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			if method, route, isRoute := webAPIRoute(node); isRoute {
+				registeredRoutes[method+" /"+route] = true
+			}
+			return true
+		})
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	documentedRoutes := make(map[string]bool)
+	for path, pathItem := range Spec().Paths {
+		if pathItem.Get != nil {
+			documentedRoutes["GET "+path] = true
+		}
+		if pathItem.Post != nil {
+			documentedRoutes["POST "+path] = true
+		}
+		if pathItem.Delete != nil {
+			documentedRoutes["DELETE "+path] = true
+		}
+	}
+
+	assert.NotEmpty(t, registeredRoutes)
+	assert.Equal(t, registeredRoutes, documentedRoutes)
+}
+
+// TestSpec_References checks that all references of the Document point to existing schemas.
+func TestSpec_References(t *testing.T) {
+	document := Spec()
+
+	var checkSchema func(schema *Schema)
+	checkSchema = func(schema *Schema) {
+		if schema == nil {
+			return
+		}
+		if schema.Ref != "" {
+			_, exists := document.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+			assert.True(t, exists, "schema %s does not exist", schema.Ref)
+		}
+		checkSchema(schema.Items)
+		checkSchema(schema.AdditionalProperties)
+		for _, property := range schema.Properties {
+			checkSchema(property)
+		}
+	}
+
+	operationIDs := make(map[string]bool)
+	for _, pathItem := range document.Paths {
+		for _, operation := range []*Operation{pathItem.Get, pathItem.Post, pathItem.Delete} {
+			if operation == nil {
+				continue
+			}
+
+			assert.False(t, operationIDs[operation.OperationID], "duplicate operationId %s", operation.OperationID)
+			operationIDs[operation.OperationID] = true
+
+			if operation.RequestBody != nil {
+				for _, mediaType := range operation.RequestBody.Content {
+					checkSchema(mediaType.Schema)
+				}
+			}
+			for _, response := range operation.Responses {
+				for _, mediaType := range response.Content {
+					checkSchema(mediaType.Schema)
+				}
+			}
+		}
+	}
+	for _, schema := range document.Components.Schemas {
+		checkSchema(schema)
+	}
+}
+
+// TestSpec_UpToDate checks that the committed OpenAPI document matches the request and response types of the handlers,
+// so that renamed JSON fields do not go unnoticed. Run the test with the -update flag to regenerate the document.
+func TestSpec_UpToDate(t *testing.T) {
+	generatedSpec, err := json.MarshalIndent(Spec(), "", "  ")
+	require.NoError(t, err)
+	generatedSpec = append(generatedSpec, '\n')
+
+	if *update {
+		require.NoError(t, ioutil.WriteFile(specFile, generatedSpec, 0644))
+	}
+
+	committedSpec, err := ioutil.ReadFile(specFile)
+	require.NoError(t, err)
+
+	// the version of the node does not need to match
+	var committedDocument Document
+	require.NoError(t, json.Unmarshal(committedSpec, &committedDocument))
+	committedDocument.Info.Version = banner.AppVersion
+	normalizedCommittedSpec, err := json.MarshalIndent(&committedDocument, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, string(generatedSpec), string(normalizedCommittedSpec)+"\n", "%s is outdated - run the tests with the -update flag", specFile)
+}
+
+// webAPIRoute returns the method and the route if the given node is a call of the form webapi.Server().METHOD("route", ...).
+func webAPIRoute(node ast.Node) (method string, route string, isRoute bool) {
+	call, isCall := node.(*ast.CallExpr)
+	if !isCall || len(call.Args) == 0 {
+		return
+	}
+	methodSelector, isSelector := call.Fun.(*ast.SelectorExpr)
+	if !isSelector {
+		return
+	}
+	serverCall, isCall := methodSelector.X.(*ast.CallExpr)
+	if !isCall {
+		return
+	}
+	serverSelector, isSelector := serverCall.Fun.(*ast.SelectorExpr)
+	if !isSelector || serverSelector.Sel.Name != "Server" {
+		return
+	}
+	if packageName, isIdent := serverSelector.X.(*ast.Ident); !isIdent || packageName.Name != "webapi" {
+		return
+	}
+	routeLiteral, isLiteral := call.Args[0].(*ast.BasicLit)
+	if !isLiteral || routeLiteral.Kind != token.STRING {
+		return
+	}
+	route, err := strconv.Unquote(routeLiteral.Value)
+	if err != nil {
+		return
+	}
+
+	return methodSelector.Sel.Name, strings.TrimPrefix(route, "/"), true
+}
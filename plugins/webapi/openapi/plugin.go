@@ -0,0 +1,45 @@
+package openapi
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/plugins/banner"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+)
+
+// PluginName is the name of the web API OpenAPI endpoint plugin.
+const PluginName = "WebAPI OpenAPI Endpoint"
+
+var (
+	// plugin is the plugin instance of the web API OpenAPI endpoint plugin.
+	plugin *node.Plugin
+	once   sync.Once
+
+	document     *Document
+	documentOnce sync.Once
+)
+
+// Plugin gets the plugin instance.
+func Plugin() *node.Plugin {
+	once.Do(func() {
+		plugin = node.NewPlugin(PluginName, node.Enabled, configure)
+	})
+	return plugin
+}
+
+// Spec returns the OpenAPI Document that describes the web API of the node.
+func Spec() *Document {
+	documentOnce.Do(func() {
+		document = newDocument(banner.AppVersion, endpoints)
+	})
+	return document
+}
+
+func configure(_ *node.Plugin) {
+	webapi.Server().GET("openapi.json", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, Spec())
+	})
+}
@@ -0,0 +1,29 @@
+package snapshot
+
+import (
+	"sync"
+
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/hive.go/node"
+)
+
+// PluginName is the name of the web API snapshot endpoint plugin.
+const PluginName = "WebAPI snapshot Endpoint"
+
+var (
+	// plugin is the plugin instance of the web API snapshot endpoint plugin.
+	plugin *node.Plugin
+	once   sync.Once
+)
+
+// Plugin gets the plugin instance.
+func Plugin() *node.Plugin {
+	once.Do(func() {
+		plugin = node.NewPlugin(PluginName, node.Disabled, configure)
+	})
+	return plugin
+}
+
+func configure(_ *node.Plugin) {
+	webapi.Server().GET("snapshot", getSnapshotHandler)
+}
@@ -0,0 +1,53 @@
+package snapshot
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/mana"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+)
+
+// getSnapshotHandler handles the request to export the confirmed unspent outputs of the ledger state (and optionally
+// the mana state) of the node together with its solid entry points as a snapshot that can be used to bootstrap a new
+// node.
+func getSnapshotHandler(c echo.Context) error {
+	withMana := false
+	if manaParam := c.QueryParam("mana"); manaParam != "" {
+		var err error
+		if withMana, err = strconv.ParseBool(manaParam); err != nil {
+			return c.JSON(http.StatusBadRequest, GetSnapshotResponse{Error: err.Error()})
+		}
+	}
+	if withMana && node.IsSkipped(manaPlugin.Plugin()) {
+		return c.JSON(http.StatusBadRequest, GetSnapshotResponse{Error: "mana plugin is not enabled"})
+	}
+
+	ledgerSnapshot := &snapshot.Snapshot{
+		Outputs:          messagelayer.Tangle().LedgerState.UTXODAG.ConfirmedUnspentOutputs(),
+		SolidEntryPoints: append(messagelayer.Tangle().Storage.SolidEntryPoints(), messagelayer.Tangle().Storage.RetrieveConfirmedTips()...),
+	}
+	if withMana {
+		ledgerSnapshot.BaseManas = manaPlugin.BaseManaSnapshot()
+		ledgerSnapshot.TransactionPledges = manaPlugin.TransactionPledgeSnapshot(ledgerSnapshot.Outputs)
+	}
+
+	return c.JSON(http.StatusOK, GetSnapshotResponse{
+		OutputCount:          len(ledgerSnapshot.Outputs),
+		BaseManaCount:        len(ledgerSnapshot.BaseManas),
+		SolidEntryPointCount: len(ledgerSnapshot.SolidEntryPoints),
+		Bytes:                ledgerSnapshot.Bytes(),
+	})
+}
+
+// GetSnapshotResponse is the response of the request to export a snapshot.
+type GetSnapshotResponse struct {
+	OutputCount          int    `json:"outputCount"`
+	BaseManaCount        int    `json:"baseManaCount"`
+	SolidEntryPointCount int    `json:"solidEntryPointCount"`
+	Bytes                []byte `json:"bytes,omitempty"`
+	Error                string `json:"error,omitempty"`
+}
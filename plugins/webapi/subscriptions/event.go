@@ -0,0 +1,73 @@
+package subscriptions
+
+// EventType defines the kind of an Event that is sent to the subscribers.
+type EventType string
+
+const (
+	// MessageBooked is sent when a subscribed Message was booked.
+	MessageBooked EventType = "messageBooked"
+
+	// MessageConfirmed is sent when a subscribed Message was confirmed.
+	MessageConfirmed EventType = "messageConfirmed"
+
+	// MessageInvalid is sent when a subscribed Message was marked as invalid.
+	MessageInvalid EventType = "messageInvalid"
+
+	// TransactionBooked is sent when a subscribed Transaction (or a Transaction that sends funds to a subscribed
+	// Address) was booked.
+	TransactionBooked EventType = "transactionBooked"
+
+	// TransactionConfirmed is sent when a subscribed Transaction (or a Transaction that sends funds to a subscribed
+	// Address) was confirmed.
+	TransactionConfirmed EventType = "transactionConfirmed"
+
+	// TransactionRejected is sent when a subscribed Transaction (or a Transaction that sends funds to a subscribed
+	// Address) was rejected.
+	TransactionRejected EventType = "transactionRejected"
+
+	// OutputCreated is sent when a booked Transaction created an Output on a subscribed Address.
+	OutputCreated EventType = "outputCreated"
+
+	// OutputSpent is sent when a booked Transaction spent an Output of a subscribed Address.
+	OutputSpent EventType = "outputSpent"
+
+	// Subscribed is sent to confirm a subscribe request.
+	Subscribed EventType = "subscribed"
+
+	// Unsubscribed is sent to confirm an unsubscribe request.
+	Unsubscribed EventType = "unsubscribed"
+
+	// Error is sent when a request of the client could not be processed.
+	Error EventType = "error"
+
+	// Overflow is sent when the client did not receive its events fast enough and some of them were dropped.
+	Overflow EventType = "overflow"
+)
+
+// Event is the JSON message that is sent to the subscribers.
+type Event struct {
+	Type          EventType `json:"type"`
+	MessageID     string    `json:"messageID,omitempty"`
+	TransactionID string    `json:"transactionID,omitempty"`
+	Address       string    `json:"address,omitempty"`
+	OutputID      string    `json:"outputID,omitempty"`
+	Error         string    `json:"error,omitempty"`
+	DroppedEvents uint64    `json:"droppedEvents,omitempty"`
+}
+
+const (
+	// SubscribeAction is the action of a request that subscribes to the given objects.
+	SubscribeAction = "subscribe"
+
+	// UnsubscribeAction is the action of a request that unsubscribes from the given objects.
+	UnsubscribeAction = "unsubscribe"
+)
+
+// Request is the JSON message that is sent by the clients to (un)subscribe to the events of addresses, transactions and
+// messages.
+type Request struct {
+	Action         string   `json:"action"`
+	Addresses      []string `json:"addresses,omitempty"`
+	TransactionIDs []string `json:"transactionIDs,omitempty"`
+	MessageIDs     []string `json:"messageIDs,omitempty"`
+}
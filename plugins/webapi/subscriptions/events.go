@@ -0,0 +1,152 @@
+package subscriptions
+
+import (
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/lru_cache"
+	"github.com/iotaledger/hive.go/types"
+)
+
+// confirmedTransactionsCacheSize defines how many confirmed Transactions are remembered to publish their confirmation
+// only once.
+const confirmedTransactionsCacheSize = 10000
+
+var (
+	// confirmedTransactions contains the recently confirmed Transactions, as a conflicting Transaction is reported as
+	// confirmed by both the OpinionFormer and the BranchDAG.
+	confirmedTransactions = lru_cache.NewLRUCache(confirmedTransactionsCacheSize)
+
+	onMessageBooked        = events.NewClosure(func(messageID tangle.MessageID) { publishMessageEvent(MessageBooked, messageID) })
+	onMessageConfirmed     = events.NewClosure(func(messageID tangle.MessageID) { publishMessageEvent(MessageConfirmed, messageID) })
+	onMessageInvalid       = events.NewClosure(func(messageID tangle.MessageID) { publishMessageEvent(MessageInvalid, messageID) })
+	onTransactionBooked    = events.NewClosure(publishTransactionBooked)
+	onTransactionConfirmed = events.NewClosure(func(messageID tangle.MessageID) {
+		messagelayer.Tangle().Utils.ComputeIfTransaction(messageID, publishTransactionConfirmed)
+	})
+	onBranchConfirmed = events.NewClosure(func(branchDAGEvent *ledgerstate.BranchDAGEvent) {
+		branchDAGEvent.Branch.Consume(func(branch ledgerstate.Branch) {
+			if branch.Type() == ledgerstate.ConflictBranchType {
+				publishTransactionConfirmed(ledgerstate.TransactionID(branch.ID()))
+			}
+		})
+	})
+	onBranchRejected = events.NewClosure(func(branchDAGEvent *ledgerstate.BranchDAGEvent) {
+		branchDAGEvent.Branch.Consume(func(branch ledgerstate.Branch) {
+			if branch.Type() != ledgerstate.ConflictBranchType {
+				return
+			}
+
+			// the Transactions that spend the Outputs of the rejected Transaction are rejected as well
+			for transactionID := range messagelayer.Tangle().LedgerState.UTXODAG.FutureCone(ledgerstate.TransactionID(branch.ID())) {
+				publishTransactionEvent(TransactionRejected, transactionID)
+			}
+		})
+	})
+)
+
+// attachEvents connects the publishing of the Events to the events of the tangle and the ledger.
+func attachEvents() {
+	messagelayer.Tangle().Booker.Events.MessageBooked.Attach(onMessageBooked)
+	messagelayer.Tangle().ApprovalWeightManager.Events.MessageConfirmed.Attach(onMessageConfirmed)
+	messagelayer.Tangle().Events.MessageInvalid.Attach(onMessageInvalid)
+	messagelayer.Tangle().LedgerState.UTXODAG.Events.TransactionBooked.Attach(onTransactionBooked)
+	messagelayer.Tangle().OpinionFormer.Events.TransactionConfirmed.Attach(onTransactionConfirmed)
+	messagelayer.Tangle().LedgerState.BranchDAG.Events.BranchConfirmed.Attach(onBranchConfirmed)
+	messagelayer.Tangle().LedgerState.BranchDAG.Events.BranchRejected.Attach(onBranchRejected)
+}
+
+// detachEvents disconnects the publishing of the Events from the events of the tangle and the ledger.
+func detachEvents() {
+	messagelayer.Tangle().Booker.Events.MessageBooked.Detach(onMessageBooked)
+	messagelayer.Tangle().ApprovalWeightManager.Events.MessageConfirmed.Detach(onMessageConfirmed)
+	messagelayer.Tangle().Events.MessageInvalid.Detach(onMessageInvalid)
+	messagelayer.Tangle().LedgerState.UTXODAG.Events.TransactionBooked.Detach(onTransactionBooked)
+	messagelayer.Tangle().OpinionFormer.Events.TransactionConfirmed.Detach(onTransactionConfirmed)
+	messagelayer.Tangle().LedgerState.BranchDAG.Events.BranchConfirmed.Detach(onBranchConfirmed)
+	messagelayer.Tangle().LedgerState.BranchDAG.Events.BranchRejected.Detach(onBranchRejected)
+}
+
+// publishMessageEvent publishes an Event of the given type to the subscribers of the Message.
+func publishMessageEvent(eventType EventType, messageID tangle.MessageID) {
+	topic := MessageTopic(messageID)
+	if !hub.HasSubscribers(topic) {
+		return
+	}
+
+	hub.Publish(&Event{Type: eventType, MessageID: messageID.String()}, topic)
+}
+
+// publishTransactionBooked publishes the Events of a booked Transaction to the subscribers of the Transaction and of the
+// Addresses whose Outputs were created or spent.
+func publishTransactionBooked(transactionBookedEvent *ledgerstate.TransactionBookedEvent) {
+	transactionID := transactionBookedEvent.Transaction.ID()
+	createdOutputs := transactionBookedEvent.Transaction.Essence().Outputs()
+
+	transactionTopics := append(addressTopics(createdOutputs), TransactionTopic(transactionID))
+	hub.Publish(&Event{Type: TransactionBooked, TransactionID: transactionID.Base58()}, transactionTopics...)
+
+	for _, output := range createdOutputs {
+		for _, address := range ledgerstate.OutputAddresses(output) {
+			hub.Publish(&Event{
+				Type:          OutputCreated,
+				TransactionID: transactionID.Base58(),
+				Address:       address.Base58(),
+				OutputID:      output.ID().Base58(),
+			}, AddressTopic(address))
+		}
+	}
+
+	for _, input := range transactionBookedEvent.Inputs {
+		for _, address := range ledgerstate.OutputAddresses(input) {
+			hub.Publish(&Event{
+				Type:          OutputSpent,
+				TransactionID: transactionID.Base58(),
+				Address:       address.Base58(),
+				OutputID:      input.ID().Base58(),
+			}, AddressTopic(address))
+		}
+	}
+}
+
+// publishTransactionConfirmed publishes the TransactionConfirmed Event of the given Transaction if it was not published
+// before.
+func publishTransactionConfirmed(transactionID ledgerstate.TransactionID) {
+	firstConfirmation := false
+	confirmedTransactions.ComputeIfAbsent(transactionID, func() interface{} {
+		firstConfirmation = true
+		return types.Void
+	})
+	if !firstConfirmation {
+		return
+	}
+
+	publishTransactionEvent(TransactionConfirmed, transactionID)
+}
+
+// publishTransactionEvent publishes an Event of the given type to the subscribers of the Transaction and of the
+// Addresses that received funds from it.
+func publishTransactionEvent(eventType EventType, transactionID ledgerstate.TransactionID) {
+	topics := []Topic{TransactionTopic(transactionID)}
+	messagelayer.Tangle().LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+		topics = append(topics, addressTopics(transaction.Essence().Outputs())...)
+	})
+	if !hub.HasSubscribers(topics...) {
+		return
+	}
+
+	hub.Publish(&Event{Type: eventType, TransactionID: transactionID.Base58()}, topics...)
+}
+
+// addressTopics returns the Topics of the Addresses that can unlock the given Outputs.
+func addressTopics(outputs ledgerstate.Outputs) (topics []Topic) {
+	topics = make([]Topic, 0, len(outputs))
+	for _, output := range outputs {
+		for _, address := range ledgerstate.OutputAddresses(output) {
+			topics = append(topics, AddressTopic(address))
+		}
+	}
+
+	return
+}
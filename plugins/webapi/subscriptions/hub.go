@@ -0,0 +1,222 @@
+package subscriptions
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/hive.go/types"
+)
+
+const (
+	// MaxTopicsPerSubscriber defines the maximum amount of topics that a single subscriber can subscribe to.
+	MaxTopicsPerSubscriber = 1000
+
+	// subscriberQueueSize defines the amount of events that are buffered for a subscriber before events get dropped.
+	subscriberQueueSize = 1000
+)
+
+// region Hub //////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Hub keeps track of the connected Subscribers and distributes the published Events to the Subscribers of the
+// corresponding Topics.
+type Hub struct {
+	subscribers      map[uint64]*Subscriber
+	topics           map[Topic]map[uint64]*Subscriber
+	nextSubscriberID uint64
+	mutex            sync.RWMutex
+}
+
+// NewHub is the constructor of the Hub.
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[uint64]*Subscriber),
+		topics:      make(map[Topic]map[uint64]*Subscriber),
+	}
+}
+
+// Register creates a new Subscriber that is not subscribed to any Topic, yet.
+func (h *Hub) Register() (subscriber *Subscriber) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	subscriber = &Subscriber{
+		id:     h.nextSubscriberID,
+		events: make(chan *Event, subscriberQueueSize),
+		topics: make(map[Topic]types.Empty),
+	}
+	h.subscribers[subscriber.id] = subscriber
+	h.nextSubscriberID++
+
+	return
+}
+
+// Unregister removes the Subscriber from all of its Topics and closes its Event channel.
+func (h *Hub) Unregister(subscriber *Subscriber) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, registered := h.subscribers[subscriber.id]; !registered {
+		return
+	}
+
+	for topic := range subscriber.topics {
+		h.removeFromTopic(subscriber, topic)
+	}
+	delete(h.subscribers, subscriber.id)
+	close(subscriber.events)
+}
+
+// Subscribe subscribes the Subscriber to the given Topics. It returns an error if the Subscriber is not registered or
+// if it would exceed the maximum amount of Topics.
+func (h *Hub) Subscribe(subscriber *Subscriber, topics ...Topic) (err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, registered := h.subscribers[subscriber.id]; !registered {
+		return fmt.Errorf("subscriber is not registered")
+	}
+
+	newTopics := make(map[Topic]types.Empty)
+	for _, topic := range topics {
+		if _, subscribed := subscriber.topics[topic]; !subscribed {
+			newTopics[topic] = types.Void
+		}
+	}
+	if len(subscriber.topics)+len(newTopics) > MaxTopicsPerSubscriber {
+		return fmt.Errorf("subscribers can not subscribe to more than %d topics", MaxTopicsPerSubscriber)
+	}
+
+	for topic := range newTopics {
+		if _, topicExists := h.topics[topic]; !topicExists {
+			h.topics[topic] = make(map[uint64]*Subscriber)
+		}
+		h.topics[topic][subscriber.id] = subscriber
+		subscriber.topics[topic] = types.Void
+	}
+
+	return nil
+}
+
+// Unsubscribe removes the Subscriber from the given Topics.
+func (h *Hub) Unsubscribe(subscriber *Subscriber, topics ...Topic) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, topic := range topics {
+		if _, subscribed := subscriber.topics[topic]; subscribed {
+			h.removeFromTopic(subscriber, topic)
+		}
+	}
+}
+
+// Publish sends the Event to all Subscribers of the given Topics. Every Subscriber receives the Event at most once and
+// slow Subscribers whose queue is full miss the Event (which is counted in their dropped events).
+func (h *Hub) Publish(event *Event, topics ...Topic) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	notifiedSubscribers := make(map[uint64]types.Empty)
+	for _, topic := range topics {
+		for subscriberID, subscriber := range h.topics[topic] {
+			if _, notified := notifiedSubscribers[subscriberID]; notified {
+				continue
+			}
+			notifiedSubscribers[subscriberID] = types.Void
+
+			select {
+			case subscriber.events <- event:
+			default:
+				// drop the event if the subscriber is too slow
+				atomic.AddUint64(&subscriber.droppedEvents, 1)
+			}
+		}
+	}
+}
+
+// HasSubscribers returns true if at least one Subscriber is subscribed to one of the given Topics.
+func (h *Hub) HasSubscribers(topics ...Topic) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	for _, topic := range topics {
+		if len(h.topics[topic]) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Close unregisters all Subscribers.
+func (h *Hub) Close() {
+	h.mutex.RLock()
+	subscribers := make([]*Subscriber, 0, len(h.subscribers))
+	for _, subscriber := range h.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	h.mutex.RUnlock()
+
+	for _, subscriber := range subscribers {
+		h.Unregister(subscriber)
+	}
+}
+
+// removeFromTopic removes the Subscriber from the given Topic (the mutex needs to be locked).
+func (h *Hub) removeFromTopic(subscriber *Subscriber, topic Topic) {
+	delete(subscriber.topics, topic)
+	delete(h.topics[topic], subscriber.id)
+	if len(h.topics[topic]) == 0 {
+		delete(h.topics, topic)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Subscriber ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Subscriber represents a client of the Hub that receives the Events of the Topics it subscribed to.
+type Subscriber struct {
+	id            uint64
+	events        chan *Event
+	topics        map[Topic]types.Empty
+	droppedEvents uint64
+}
+
+// Events returns the channel that receives the Events of the subscribed Topics. It is closed when the Subscriber is
+// unregistered.
+func (s *Subscriber) Events() <-chan *Event {
+	return s.events
+}
+
+// takeDroppedEvents returns the amount of Events that were dropped since the last call because the queue of the
+// Subscriber was full.
+func (s *Subscriber) takeDroppedEvents() uint64 {
+	return atomic.SwapUint64(&s.droppedEvents, 0)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Topic ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Topic identifies an object whose Events can be subscribed to.
+type Topic string
+
+// AddressTopic returns the Topic of the Events related to the given Address.
+func AddressTopic(address ledgerstate.Address) Topic {
+	return Topic("address:" + address.Base58())
+}
+
+// TransactionTopic returns the Topic of the Events related to the given Transaction.
+func TransactionTopic(transactionID ledgerstate.TransactionID) Topic {
+	return Topic("transaction:" + transactionID.Base58())
+}
+
+// MessageTopic returns the Topic of the Events related to the given Message.
+func MessageTopic(messageID tangle.MessageID) Topic {
+	return Topic("message:" + messageID.String())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
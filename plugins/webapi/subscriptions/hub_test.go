@@ -0,0 +1,80 @@
+package subscriptions
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub(t *testing.T) {
+	hub := NewHub()
+	transactionTopic := TransactionTopic(ledgerstate.TransactionID{1})
+	messageTopic := MessageTopic(tangle.EmptyMessageID)
+
+	subscriber1 := hub.Register()
+	subscriber2 := hub.Register()
+	require.NoError(t, hub.Subscribe(subscriber1, transactionTopic, messageTopic))
+	require.NoError(t, hub.Subscribe(subscriber2, messageTopic))
+	assert.True(t, hub.HasSubscribers(transactionTopic))
+
+	// every subscriber receives an event only once even if it is subscribed to several of its topics
+	event := &Event{Type: TransactionBooked}
+	hub.Publish(event, transactionTopic, messageTopic)
+	assert.Equal(t, event, <-subscriber1.Events())
+	assert.Equal(t, event, <-subscriber2.Events())
+	assert.Empty(t, subscriber1.Events())
+	assert.Empty(t, subscriber2.Events())
+
+	hub.Unsubscribe(subscriber1, transactionTopic)
+	assert.False(t, hub.HasSubscribers(transactionTopic))
+	hub.Publish(event, transactionTopic)
+	assert.Empty(t, subscriber1.Events())
+
+	// unregistered subscribers have their channel closed and can not subscribe anymore
+	hub.Unregister(subscriber2)
+	_, open := <-subscriber2.Events()
+	assert.False(t, open)
+	assert.Error(t, hub.Subscribe(subscriber2, messageTopic))
+	hub.Publish(event, messageTopic)
+	assert.Equal(t, event, <-subscriber1.Events())
+
+	hub.Close()
+	_, open = <-subscriber1.Events()
+	assert.False(t, open)
+	assert.False(t, hub.HasSubscribers(messageTopic))
+}
+
+func TestHub_MaxTopicsPerSubscriber(t *testing.T) {
+	hub := NewHub()
+	subscriber := hub.Register()
+
+	topics := make([]Topic, 0, MaxTopicsPerSubscriber)
+	for i := 0; i < MaxTopicsPerSubscriber; i++ {
+		topics = append(topics, Topic(fmt.Sprintf("topic%d", i)))
+	}
+	require.NoError(t, hub.Subscribe(subscriber, topics...))
+
+	// subscribing to known topics again does not count against the limit
+	require.NoError(t, hub.Subscribe(subscriber, topics[0]))
+	assert.Error(t, hub.Subscribe(subscriber, Topic("additionalTopic")))
+	assert.False(t, hub.HasSubscribers(Topic("additionalTopic")))
+}
+
+func TestHub_DroppedEvents(t *testing.T) {
+	hub := NewHub()
+	topic := MessageTopic(tangle.EmptyMessageID)
+	subscriber := hub.Register()
+	require.NoError(t, hub.Subscribe(subscriber, topic))
+
+	// the events that do not fit into the queue of the subscriber are counted
+	for i := 0; i < subscriberQueueSize+2; i++ {
+		hub.Publish(&Event{Type: MessageBooked}, topic)
+	}
+	assert.Len(t, subscriber.Events(), subscriberQueueSize)
+	assert.Equal(t, uint64(2), subscriber.takeDroppedEvents())
+	assert.Zero(t, subscriber.takeDroppedEvents())
+}
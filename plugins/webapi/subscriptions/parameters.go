@@ -0,0 +1,15 @@
+package subscriptions
+
+import flag "github.com/spf13/pflag"
+
+const (
+	// CfgMaxConnections defines the config flag of the maximum amount of concurrent websocket connections.
+	CfgMaxConnections = "webapi.subscriptions.maxConnections"
+	// CfgAllowedOrigins defines the config flag of the origins that are allowed to open a websocket connection.
+	CfgAllowedOrigins = "webapi.subscriptions.allowedOrigins"
+)
+
+func init() {
+	flag.Int(CfgMaxConnections, 100, "the maximum amount of concurrent websocket connections to the subscriptions endpoint")
+	flag.StringSlice(CfgAllowedOrigins, []string{}, "the origins that are allowed to connect to the subscriptions endpoint in addition to the own one ('*' allows all origins)")
+}
@@ -0,0 +1,55 @@
+package subscriptions
+
+import (
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/plugins/config"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/node"
+)
+
+// PluginName is the name of the web API subscriptions endpoint plugin.
+const PluginName = "WebAPI Subscriptions Endpoint"
+
+var (
+	// plugin is the plugin instance of the web API subscriptions endpoint plugin.
+	plugin *node.Plugin
+	once   sync.Once
+	log    *logger.Logger
+
+	// hub distributes the events of the tangle and the ledger to the connected websocket clients.
+	hub *Hub
+)
+
+// Plugin gets the plugin instance.
+func Plugin() *node.Plugin {
+	once.Do(func() {
+		plugin = node.NewPlugin(PluginName, node.Enabled, configure, run)
+	})
+	return plugin
+}
+
+func configure(_ *node.Plugin) {
+	log = logger.NewLogger(PluginName)
+	hub = NewHub()
+	maxConnections = int32(config.Node().Int(CfgMaxConnections))
+	allowedOrigins = config.Node().Strings(CfgAllowedOrigins)
+
+	webapi.Server().GET("subscriptions", subscriptionsHandler)
+}
+
+func run(*node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(shutdownSignal <-chan struct{}) {
+		attachEvents()
+		<-shutdownSignal
+		log.Info("Stopping " + PluginName + " ...")
+		detachEvents()
+		hub.Close()
+		log.Info("Stopping " + PluginName + " ... done")
+	}, shutdown.PriorityWebAPI); err != nil {
+		log.Panicf("Failed to start as daemon: %s", err)
+	}
+}
@@ -0,0 +1,195 @@
+package subscriptions
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/labstack/echo"
+)
+
+const (
+	// webSocketWriteTimeout defines the time after which a write to a websocket client is aborted.
+	webSocketWriteTimeout = 3 * time.Second
+
+	// replyQueueSize defines the amount of replies to requests that are buffered for a websocket client.
+	replyQueueSize = 10
+)
+
+var (
+	// upgrader turns the HTTP requests to the subscription endpoint into websocket connections.
+	upgrader = websocket.Upgrader{
+		HandshakeTimeout:  webSocketWriteTimeout,
+		CheckOrigin:       checkOrigin,
+		EnableCompression: true,
+	}
+
+	// maxConnections defines the maximum amount of concurrent websocket connections.
+	maxConnections int32
+	// allowedOrigins contains the origins that are allowed to connect in addition to the own one.
+	allowedOrigins []string
+	// connections contains the amount of currently open websocket connections.
+	connections int32
+)
+
+// subscriptionsHandler upgrades the connection to a websocket and streams the Events of the Topics that the client
+// subscribes to by sending Requests.
+func subscriptionsHandler(c echo.Context) error {
+	defer atomic.AddInt32(&connections, -1)
+	if atomic.AddInt32(&connections, 1) > maxConnections {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "maximum amount of subscription connections reached")
+	}
+
+	ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	subscriber := hub.Register()
+	defer hub.Unregister(subscriber)
+
+	replies := make(chan *Event, replyQueueSize)
+	disconnected := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go readRequests(ws, subscriber, replies, disconnected, done)
+
+	for {
+		var event *Event
+		select {
+		case event = <-replies:
+		case <-disconnected:
+			return nil
+		case receivedEvent, open := <-subscriber.Events():
+			if !open {
+				return nil
+			}
+			event = receivedEvent
+		}
+
+		if err := writeEvent(ws, event); err != nil {
+			return nil
+		}
+
+		// let the client know that it was too slow to receive all of its events
+		if dropped := subscriber.takeDroppedEvents(); dropped != 0 {
+			if err := writeEvent(ws, &Event{Type: Overflow, DroppedEvents: dropped}); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// writeEvent sends the Event to the websocket client.
+func writeEvent(ws *websocket.Conn, event *Event) error {
+	if err := ws.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
+		return err
+	}
+
+	return ws.WriteJSON(event)
+}
+
+// checkOrigin accepts the requests of clients that do not send an Origin header (i.e. non-browser clients), of the own
+// origin and of the configured allowed origins.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	for _, allowedOrigin := range allowedOrigins {
+		if allowedOrigin == "*" || strings.EqualFold(allowedOrigin, origin) {
+			return true
+		}
+	}
+
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(originURL.Host, r.Host)
+}
+
+// readRequests processes the Requests of the websocket client until the connection is closed or the handler is done.
+func readRequests(ws *websocket.Conn, subscriber *Subscriber, replies chan<- *Event, disconnected chan<- struct{}, done <-chan struct{}) {
+	defer close(disconnected)
+
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var reply *Event
+		var request Request
+		if err := json.Unmarshal(message, &request); err != nil {
+			reply = &Event{Type: Error, Error: fmt.Sprintf("failed to parse request: %s", err)}
+		} else {
+			reply = processRequest(subscriber, &request)
+		}
+
+		select {
+		case replies <- reply:
+		case <-done:
+			return
+		}
+	}
+}
+
+// processRequest (un)subscribes the Subscriber according to the Request and returns the reply for the client.
+func processRequest(subscriber *Subscriber, request *Request) *Event {
+	topics, err := requestTopics(request)
+	if err != nil {
+		return &Event{Type: Error, Error: err.Error()}
+	}
+
+	switch request.Action {
+	case SubscribeAction:
+		if err := hub.Subscribe(subscriber, topics...); err != nil {
+			return &Event{Type: Error, Error: err.Error()}
+		}
+		return &Event{Type: Subscribed}
+	case UnsubscribeAction:
+		hub.Unsubscribe(subscriber, topics...)
+		return &Event{Type: Unsubscribed}
+	default:
+		return &Event{Type: Error, Error: fmt.Sprintf("unsupported action '%s'", request.Action)}
+	}
+}
+
+// requestTopics parses the identifiers of the Request and returns the corresponding Topics.
+func requestTopics(request *Request) (topics []Topic, err error) {
+	topics = make([]Topic, 0, len(request.Addresses)+len(request.TransactionIDs)+len(request.MessageIDs))
+	for _, base58Address := range request.Addresses {
+		address, err := ledgerstate.AddressFromBase58EncodedString(base58Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse address '%s': %w", base58Address, err)
+		}
+		topics = append(topics, AddressTopic(address))
+	}
+	for _, base58TransactionID := range request.TransactionIDs {
+		transactionID, err := ledgerstate.TransactionIDFromBase58(base58TransactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transaction ID '%s': %w", base58TransactionID, err)
+		}
+		topics = append(topics, TransactionTopic(transactionID))
+	}
+	for _, base58MessageID := range request.MessageIDs {
+		messageID, err := tangle.NewMessageID(base58MessageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse message ID '%s': %w", base58MessageID, err)
+		}
+		topics = append(topics, MessageTopic(messageID))
+	}
+
+	return topics, nil
+}
@@ -0,0 +1,72 @@
+package subscriptions
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	allowedOrigins = []string{"https://wallet.example.com"}
+	defer func() { allowedOrigins = nil }()
+
+	newRequest := func(origin string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "http://node.example.com/subscriptions", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		return req
+	}
+
+	assert.True(t, checkOrigin(newRequest("")))
+	assert.True(t, checkOrigin(newRequest("http://node.example.com")))
+	assert.True(t, checkOrigin(newRequest("https://wallet.example.com")))
+	assert.False(t, checkOrigin(newRequest("https://evil.example.com")))
+
+	allowedOrigins = []string{"*"}
+	assert.True(t, checkOrigin(newRequest("https://evil.example.com")))
+}
+
+func TestSubscriptionsHandler_MaxConnections(t *testing.T) {
+	hub = NewHub()
+	defer hub.Close()
+	maxConnections = 1
+	defer func() { maxConnections = 0 }()
+
+	e := echo.New()
+	e.GET("/subscriptions", subscriptionsHandler)
+	server := httptest.NewServer(e)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions"
+
+	ws, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+
+	// the connection is established once the subscription of the client is confirmed
+	require.NoError(t, ws.WriteJSON(&Request{Action: SubscribeAction}))
+	var reply Event
+	require.NoError(t, ws.ReadJSON(&reply))
+	assert.Equal(t, Subscribed, reply.Type)
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	// closed connections free up their slot
+	require.NoError(t, ws.Close())
+	assert.Eventually(t, func() bool {
+		ws, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			return false
+		}
+		return ws.Close() == nil
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,188 @@
+package value
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/labstack/echo"
+)
+
+const (
+	// defaultAddressHistoryLimit defines the amount of outputs that are returned per page if no limit is given.
+	defaultAddressHistoryLimit = 100
+
+	// maxAddressHistoryLimit defines the maximum amount of outputs that can be requested per page.
+	maxAddressHistoryLimit = 1000
+)
+
+// addressHistoryHandler returns all outputs that were ever created on an address (spent and unspent) ordered by the
+// timestamp of their creating transaction (outputs of a snapshot come first). The result is paginated by using the ID of the last returned output as the
+// cursor of the next request and can be filtered by the colors of the balances.
+func addressHistoryHandler(c echo.Context) error {
+	address, err := ledgerstate.AddressFromBase58EncodedString(c.QueryParam("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, AddressHistoryResponse{Error: err.Error()})
+	}
+
+	limit := defaultAddressHistoryLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 || limit > maxAddressHistoryLimit {
+			return c.JSON(http.StatusBadRequest, AddressHistoryResponse{Error: "limit needs to be a number between 1 and " + strconv.Itoa(maxAddressHistoryLimit)})
+		}
+	}
+
+	colors := make(map[ledgerstate.Color]bool)
+	for _, base58Color := range c.QueryParams()["color"] {
+		color, colorErr := ledgerstate.ColorFromBase58EncodedString(base58Color)
+		if colorErr != nil {
+			return c.JSON(http.StatusBadRequest, AddressHistoryResponse{Error: colorErr.Error()})
+		}
+		colors[color] = true
+	}
+
+	var cursor *ledgerstate.OutputID
+	if cursorParam := c.QueryParam("cursor"); cursorParam != "" {
+		cursorID, cursorErr := ledgerstate.OutputIDFromBase58(cursorParam)
+		if cursorErr != nil {
+			return c.JSON(http.StatusBadRequest, AddressHistoryResponse{Error: cursorErr.Error()})
+		}
+		cursor = &cursorID
+	}
+
+	// collect one more entry than requested to know if there is a next page
+	entries := make([]*addressHistoryEntry, 0, limit+1)
+	messagelayer.Tangle().LedgerState.AddressHistory(address, cursor, func(outputID ledgerstate.OutputID) bool {
+		messagelayer.Tangle().LedgerState.Output(outputID).Consume(func(output ledgerstate.Output) {
+			if hasColor(output, colors) {
+				entries = append(entries, &addressHistoryEntry{output: output, id: outputID, timestamp: transactionTimestamp(outputID.TransactionID())})
+			}
+		})
+
+		return len(entries) <= limit
+	})
+
+	response := AddressHistoryResponse{
+		Address: address.Base58(),
+		Outputs: make([]AddressHistoryOutput, 0, limit),
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		response.NextCursor = entries[limit-1].id.Base58()
+	}
+	for _, entry := range entries {
+		response.Outputs = append(response.Outputs, newAddressHistoryOutput(entry))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// addressHistoryEntry is an internal utility type that holds an output of the address together with its timestamp.
+type addressHistoryEntry struct {
+	output    ledgerstate.Output
+	id        ledgerstate.OutputID
+	timestamp time.Time
+}
+
+// newAddressHistoryOutput creates the JSON representation of an output in the history of an address.
+func newAddressHistoryOutput(entry *addressHistoryEntry) (historyOutput AddressHistoryOutput) {
+	historyOutput = AddressHistoryOutput{
+		ID:             entry.id.Base58(),
+		Balances:       make([]Balance, 0),
+		Timestamp:      entry.timestamp.Unix(),
+		InclusionState: transactionInclusionState(entry.id.TransactionID()),
+		Consumers:      make([]AddressHistoryConsumer, 0),
+	}
+	entry.output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+		historyOutput.Balances = append(historyOutput.Balances, Balance{
+			Value: int64(balance),
+			Color: color.String(),
+		})
+		return true
+	})
+	messagelayer.Tangle().LedgerState.Consumers(entry.id).Consume(func(consumer *ledgerstate.Consumer) {
+		historyOutput.Consumers = append(historyOutput.Consumers, AddressHistoryConsumer{
+			TransactionID:  consumer.TransactionID().Base58(),
+			Timestamp:      transactionTimestamp(consumer.TransactionID()).Unix(),
+			InclusionState: transactionInclusionState(consumer.TransactionID()),
+		})
+	})
+	sort.Slice(historyOutput.Consumers, func(i, j int) bool {
+		return historyOutput.Consumers[i].Timestamp < historyOutput.Consumers[j].Timestamp
+	})
+
+	return
+}
+
+// hasColor returns true if the output contains a balance of one of the given colors (or if no colors are given).
+func hasColor(output ledgerstate.Output, colors map[ledgerstate.Color]bool) (found bool) {
+	if len(colors) == 0 {
+		return true
+	}
+
+	output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+		found = colors[color]
+		return !found
+	})
+
+	return
+}
+
+// transactionTimestamp returns the timestamp of the given transaction or its solidification time if the transaction
+// is part of a snapshot.
+func transactionTimestamp(transactionID ledgerstate.TransactionID) (timestamp time.Time) {
+	if messagelayer.Tangle().LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+		timestamp = transaction.Essence().Timestamp()
+	}) {
+		return
+	}
+
+	messagelayer.Tangle().LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		timestamp = transactionMetadata.SolidificationTime()
+	})
+
+	return
+}
+
+// transactionInclusionState returns the InclusionState of the given transaction.
+func transactionInclusionState(transactionID ledgerstate.TransactionID) (inclusionState InclusionState) {
+	messagelayer.Tangle().LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		inclusionState.Solid = transactionMetadata.Solid()
+		inclusionState.Finalized = transactionMetadata.Finalized()
+	})
+	if txInclusionState, err := messagelayer.Tangle().LedgerState.TransactionInclusionState(transactionID); err == nil {
+		inclusionState.Confirmed = txInclusionState == ledgerstate.Confirmed
+		inclusionState.Rejected = txInclusionState == ledgerstate.Rejected
+	}
+	inclusionState.Conflicting = messagelayer.Tangle().LedgerState.TransactionConflicting(transactionID)
+
+	return
+}
+
+// AddressHistoryResponse is the HTTP response from retrieving the history of an address.
+type AddressHistoryResponse struct {
+	Address    string                 `json:"address,omitempty"`
+	Outputs    []AddressHistoryOutput `json:"outputs,omitempty"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// AddressHistoryOutput holds an output that was created on the address together with the transactions that consumed
+// it. The timestamps are unix timestamps of the corresponding transactions.
+type AddressHistoryOutput struct {
+	ID             string                   `json:"id"`
+	Balances       []Balance                `json:"balances"`
+	Timestamp      int64                    `json:"timestamp"`
+	InclusionState InclusionState           `json:"inclusion_state"`
+	Consumers      []AddressHistoryConsumer `json:"consumers"`
+}
+
+// AddressHistoryConsumer holds a transaction that spent an output of the address.
+type AddressHistoryConsumer struct {
+	TransactionID  string         `json:"transaction_id"`
+	Timestamp      int64          `json:"timestamp"`
+	InclusionState InclusionState `json:"inclusion_state"`
+}
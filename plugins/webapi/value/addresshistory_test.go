@@ -0,0 +1,20 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasColor(t *testing.T) {
+	output := ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{
+		ledgerstate.ColorIOTA: 100,
+		{1}:                   10,
+	}), &ledgerstate.ED25519Address{})
+
+	assert.True(t, hasColor(output, map[ledgerstate.Color]bool{}))
+	assert.True(t, hasColor(output, map[ledgerstate.Color]bool{{1}: true}))
+	assert.True(t, hasColor(output, map[ledgerstate.Color]bool{{2}: true, ledgerstate.ColorIOTA: true}))
+	assert.False(t, hasColor(output, map[ledgerstate.Color]bool{{2}: true}))
+}
@@ -0,0 +1,43 @@
+package value
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/labstack/echo"
+)
+
+// colorSupplyHandler returns the amount of minted and destroyed tokens of a color.
+func colorSupplyHandler(c echo.Context) error {
+	color, err := ledgerstate.ColorFromBase58EncodedString(c.QueryParam("color"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ColorSupplyResponse{Error: err.Error()})
+	}
+	if color == ledgerstate.ColorIOTA || color == ledgerstate.ColorMint {
+		return c.JSON(http.StatusBadRequest, ColorSupplyResponse{Error: "the supply of " + color.String() + " is not tracked"})
+	}
+
+	var response ColorSupplyResponse
+	if !messagelayer.Tangle().LedgerState.ColorSupply(color).Consume(func(colorSupply *ledgerstate.ColorSupply) {
+		response = ColorSupplyResponse{
+			Color:     color.Base58(),
+			Minted:    colorSupply.Minted(),
+			Destroyed: colorSupply.Destroyed(),
+			Supply:    colorSupply.Supply(),
+		}
+	}) {
+		return c.JSON(http.StatusNotFound, ColorSupplyResponse{Error: "Color not found"})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ColorSupplyResponse is the HTTP response from retrieving the supply of a color.
+type ColorSupplyResponse struct {
+	Color     string `json:"color,omitempty"`
+	Minted    uint64 `json:"minted"`
+	Destroyed uint64 `json:"destroyed"`
+	Supply    uint64 `json:"supply"`
+	Error     string `json:"error,omitempty"`
+}
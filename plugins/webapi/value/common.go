@@ -27,10 +27,21 @@ func ParseTransaction(t *ledgerstate.Transaction) (txn Transaction) {
 			})
 			return true
 		})
-		outputs = append(outputs, Output{
+		jsonOutput := Output{
+			Type:     int8(output.Type()),
 			Address:  output.Address().Base58(),
 			Balances: balances,
-		})
+		}
+		if extendedLockedOutput, ok := output.(*ledgerstate.ExtendedLockedOutput); ok {
+			if !extendedLockedOutput.TimeLock().IsZero() {
+				jsonOutput.TimeLock = extendedLockedOutput.TimeLock().Unix()
+			}
+			if fallbackAddress := extendedLockedOutput.FallbackAddress(); fallbackAddress != nil {
+				jsonOutput.FallbackAddress = fallbackAddress.Base58()
+				jsonOutput.FallbackDeadline = extendedLockedOutput.FallbackDeadline().Unix()
+			}
+		}
+		outputs = append(outputs, jsonOutput)
 	}
 	return Transaction{
 		Inputs:      inputs,
@@ -61,11 +72,15 @@ type UnspentOutput struct {
 	OutputIDs []OutputID `json:"output_ids"`
 }
 
-// Output consists an address and balances
+// Output consists an address and balances. Outputs of the ExtendedLockedOutputType can additionally define a time lock
+// and a fallback address with its deadline (as unix timestamps).
 type Output struct {
-	Type     int8      `json:"type"`
-	Address  string    `json:"address"`
-	Balances []Balance `json:"balances"`
+	Type             int8      `json:"type"`
+	Address          string    `json:"address"`
+	Balances         []Balance `json:"balances"`
+	TimeLock         int64     `json:"timelock,omitempty"`
+	FallbackAddress  string    `json:"fallback_address,omitempty"`
+	FallbackDeadline int64     `json:"fallback_deadline,omitempty"`
 }
 
 // Balance holds the value and the color of token
@@ -30,4 +30,6 @@ func configure(_ *node.Plugin) {
 	webapi.Server().POST("value/sendTransaction", sendTransactionHandler)
 	webapi.Server().POST("value/sendTransactionByJson", sendTransactionByJSONHandler)
 	webapi.Server().GET("value/transactionByID", getTransactionByIDHandler)
+	webapi.Server().GET("value/colorSupply", colorSupplyHandler)
+	webapi.Server().GET("value/addressHistory", addressHistoryHandler)
 }
@@ -49,6 +49,11 @@ func sendTransactionHandler(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, SendTransactionResponse{Error: "spending of referenced consumedOutputs is not authorized"})
 	}
 
+	// check alias continuity
+	if !ledgerstate.AliasOutputsValid(consumedOutputs, tx.Essence().Outputs()) {
+		return c.JSON(http.StatusBadRequest, SendTransactionResponse{Error: "aliases of transaction are not continued correctly"})
+	}
+
 	// check if transaction is too old
 	if tx.Essence().Timestamp().Before(clock.SyncedTime().Add(-tangle.MaxReattachmentTimeMin)) {
 		return c.JSON(http.StatusBadRequest, SendTransactionResponse{Error: fmt.Sprintf("transaction timestamp is older than MaxReattachmentTime (%s) and cannot be issued", tangle.MaxReattachmentTimeMin)})
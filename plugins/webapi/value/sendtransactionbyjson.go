@@ -74,6 +74,11 @@ func sendTransactionByJSONHandler(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, SendTransactionResponse{Error: "spending of referenced consumedOutputs is not authorized"})
 	}
 
+	// check alias continuity
+	if !ledgerstate.AliasOutputsValid(consumedOutputs, tx.Essence().Outputs()) {
+		return c.JSON(http.StatusBadRequest, SendTransactionResponse{Error: "aliases of transaction are not continued correctly"})
+	}
+
 	// check if transaction is too old
 	if tx.Essence().Timestamp().Before(clock.SyncedTime().Add(-tangle.MaxReattachmentTimeMin)) {
 		return c.JSON(http.StatusBadRequest, SendTransactionByJSONResponse{Error: fmt.Sprintf("transaction timestamp is older than MaxReattachmentTime (%s) and cannot be issued", tangle.MaxReattachmentTimeMin)})
@@ -128,22 +133,29 @@ func NewTransactionFromJSON(request SendTransactionByJSONRequest) (*ledgerstate.
 			outputs = append(outputs, o)
 
 		case ledgerstate.SigLockedColoredOutputType:
-			balances := make(map[ledgerstate.Color]uint64)
-			for _, b := range output.Balances {
-				var color ledgerstate.Color
-				if b.Color == "IOTA" {
-					color = ledgerstate.ColorIOTA
-				} else if b.Color == "MINT" {
-					color = ledgerstate.ColorMint
-				} else {
-					color, err = ledgerstate.ColorFromBase58EncodedString(b.Color)
-					if err != nil {
-						return nil, ErrMalformedColor
-					}
+			balances, err := coloredBalancesFromJSON(output.Balances)
+			if err != nil {
+				return nil, err
+			}
+			o := ledgerstate.NewSigLockedColoredOutput(balances, address)
+			outputs = append(outputs, o)
+
+		case ledgerstate.ExtendedLockedOutputType:
+			balances, err := coloredBalancesFromJSON(output.Balances)
+			if err != nil {
+				return nil, err
+			}
+			o := ledgerstate.NewExtendedLockedOutput(balances, address)
+			if output.TimeLock != 0 {
+				o = o.WithTimeLock(time.Unix(output.TimeLock, 0))
+			}
+			if output.FallbackAddress != "" {
+				fallbackAddress, err := ledgerstate.AddressFromBase58EncodedString(output.FallbackAddress)
+				if err != nil || output.FallbackDeadline == 0 {
+					return nil, ErrMalformedOutputs
 				}
-				balances[color] += uint64(b.Value)
+				o = o.WithFallbackOptions(fallbackAddress, time.Unix(output.FallbackDeadline, 0))
 			}
-			o := ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(balances), address)
 			outputs = append(outputs, o)
 
 		default:
@@ -246,3 +258,25 @@ type SendTransactionByJSONResponse struct {
 	TransactionID string `json:"transaction_id,omitempty"`
 	Error         string `json:"error,omitempty"`
 }
+
+// coloredBalancesFromJSON converts the given JSON balances into ColoredBalances.
+func coloredBalancesFromJSON(jsonBalances []Balance) (*ledgerstate.ColoredBalances, error) {
+	balances := make(map[ledgerstate.Color]uint64)
+	for _, b := range jsonBalances {
+		var color ledgerstate.Color
+		switch b.Color {
+		case "IOTA":
+			color = ledgerstate.ColorIOTA
+		case "MINT":
+			color = ledgerstate.ColorMint
+		default:
+			var err error
+			if color, err = ledgerstate.ColorFromBase58EncodedString(b.Color); err != nil {
+				return nil, ErrMalformedColor
+			}
+		}
+		balances[color] += uint64(b.Value)
+	}
+
+	return ledgerstate.NewColoredBalances(balances), nil
+}
@@ -33,13 +33,18 @@ func TestNewTransactionFromJSON(t *testing.T) {
 		tokenColor:            uint64(100),
 		ledgerstate.ColorMint: uint64(100),
 	}), destAddr.Address())
+	output3 := ledgerstate.NewExtendedLockedOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{
+		ledgerstate.ColorIOTA: uint64(50),
+	}), destAddr.Address()).
+		WithTimeLock(time.Unix(1000, 0)).
+		WithFallbackOptions(receiverSeeds.Address(1).Address(), time.Unix(2000, 0))
 
 	// nodeID to pledge mana
 	pledge, _ := identity.RandomID()
 	pledgeID := make([]byte, hex.EncodedLen(len(pledge.Bytes())))
 	_ = hex.Encode(pledgeID, pledge.Bytes())
 
-	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), pledge, pledge, ledgerstate.NewInputs(ledgerstate.NewUTXOInput(out)), ledgerstate.NewOutputs(output1, output2))
+	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), pledge, pledge, ledgerstate.NewInputs(ledgerstate.NewUTXOInput(out)), ledgerstate.NewOutputs(output1, output2, output3))
 	// create data payload
 	dataPayload := payload.NewGenericDataPayload([]byte("some data"))
 	txEssence.SetPayload(dataPayload)
@@ -79,6 +84,19 @@ func TestNewTransactionFromJSON(t *testing.T) {
 				},
 			},
 		},
+		{
+			Type:    int8(output3.Type()),
+			Address: output3.Address().Base58(),
+			Balances: []Balance{
+				{
+					Value: 50,
+					Color: "IOTA",
+				},
+			},
+			TimeLock:         1000,
+			FallbackAddress:  output3.FallbackAddress().Base58(),
+			FallbackDeadline: 2000,
+		},
 	}
 
 	// signature JSON object
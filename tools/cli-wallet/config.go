@@ -12,6 +12,9 @@ import (
 type configuration struct {
 	WebAPI    string           `json:"WebAPI,omitempty"`
 	BasicAuth client.BasicAuth `json:"basic_auth,omitempty"`
+	// WatchOnly contains the base58 encoded addresses or public keys of a cold-storage wallet. If it is set, the wallet
+	// is used without a seed and can only prepare and submit transactions.
+	WatchOnly []string `json:"watchOnly,omitempty"`
 }
 
 // internal variable that holds the config
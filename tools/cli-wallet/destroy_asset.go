@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+)
+
+func execDestroyAssetCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	command.Usage = func() {
+		printUsage(command)
+	}
+
+	helpPtr := command.Bool("help", false, "show this help screen")
+	amountPtr := command.Uint64("amount", 0, "the amount of tokens to be destroyed")
+	colorPtr := command.String("color", "", "the color of the tokens to destroy")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *amountPtr == 0 {
+		printUsage(command)
+	}
+
+	if *colorPtr == "" {
+		printUsage(command, "you need to provide the color of the asset")
+	}
+
+	assetColor, err := parseColor(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	tx, err := cliWallet.DestroyAsset(assetColor, *amountPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Destroying " + strconv.Itoa(int(*amountPtr)) + " tokens with the color '" + assetColor.String() + "' (" + tx.ID().Base58() + ") ...   [DONE]")
+}
+
+func execAssetSupplyCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	command.Usage = func() {
+		printUsage(command)
+	}
+
+	helpPtr := command.Bool("help", false, "show this help screen")
+	colorPtr := command.String("color", "", "the color of the asset")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *colorPtr == "" {
+		printUsage(command, "you need to provide the color of the asset")
+	}
+
+	assetColor, err := parseColor(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	supply, err := cliWallet.AssetSupply(assetColor)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Asset: ", cliWallet.AssetRegistry().Name(assetColor))
+	fmt.Println("Minted: ", supply.Minted)
+	fmt.Println("Destroyed: ", supply.Destroyed)
+	fmt.Println("Supply: ", supply.Supply)
+}
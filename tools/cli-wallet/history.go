@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func execHistoryCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	pendingPtr := command.Bool("pending", false, "only show the transactions that are neither confirmed nor rejected, yet")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	updatedEntries, err := cliWallet.RefreshTransactionHistory()
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	// report the transactions whose status changed since the last run
+	fmt.Println()
+	for _, entry := range updatedEntries {
+		switch entry.Status {
+		case wallet.TransactionPending:
+			fmt.Printf("Reattaching orphaned transaction %s (attempt %d of %d) ... [DONE]\n", entry.TransactionID.Base58(), entry.Reattachments, wallet.MaxReattachments)
+		case wallet.TransactionOrphaned:
+			fmt.Printf("Transaction %s is unknown to the network and could not be reattached: please send the funds again\n", entry.TransactionID.Base58())
+		case wallet.TransactionRejected:
+			fmt.Printf("Transaction %s was rejected\n", entry.TransactionID.Base58())
+		case wallet.TransactionConfirmed:
+			fmt.Printf("Transaction %s was confirmed\n", entry.TransactionID.Base58())
+		}
+	}
+
+	entries := cliWallet.TransactionHistory().Entries()
+	if *pendingPtr {
+		entries = cliWallet.TransactionHistory().PendingEntries()
+	}
+
+	// initialize tab writer
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 2, '\t', 0)
+	defer w.Flush()
+
+	// print header
+	fmt.Println()
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "TIME", "DIRECTION", "STATUS", "TRANSACTION ID", "AMOUNT")
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "-------------------", "---------", "--------", "--------------------------------------------", "-------------------------")
+
+	// print empty if no transactions were found
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "<EMPTY>", "<EMPTY>", "<EMPTY>", "<EMPTY>", "<EMPTY>")
+
+		return
+	}
+
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", entry.Timestamp.Format("2006-01-02 15:04:05"), entry.Direction, "["+entry.Status.String()+"]", entry.TransactionID.Base58(), formatBalances(cliWallet, entry.Balances))
+	}
+}
+
+// formatBalances returns a human readable version of the given balances using the symbols of the asset registry.
+func formatBalances(cliWallet *wallet.Wallet, balances map[ledgerstate.Color]uint64) string {
+	formattedBalances := make([]string, 0, len(balances))
+	for color, balance := range balances {
+		formattedBalances = append(formattedBalances, fmt.Sprintf("%d %s", balance, cliWallet.AssetRegistry().Symbol(color)))
+	}
+
+	return strings.Join(formattedBalances, ", ")
+}
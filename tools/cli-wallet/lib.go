@@ -11,6 +11,7 @@ import (
 	"github.com/iotaledger/goshimmer/client"
 	"github.com/iotaledger/goshimmer/client/wallet"
 	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/hive.go/bitmask"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/marshalutil"
@@ -21,24 +22,90 @@ func printBanner() {
 	fmt.Println("IOTA Pollen CLI-Wallet 0.1")
 }
 
-func loadWallet() *wallet.Wallet {
-	seed, lastAddressIndex, spentAddresses, assetRegistry, err := importWalletStateFile("wallet.dat")
-	if err != nil {
-		panic(err)
-	}
+// seedCommands contains the commands that need the seed of the wallet and can therefore not be executed by a watch-only
+// wallet.
+var seedCommands = map[string]bool{
+	"init":             true,
+	"send-funds":       true,
+	"create-asset":     true,
+	"destroy-asset":    true,
+	"sign-transaction": true,
+}
 
+func loadWallet() *wallet.Wallet {
 	// configure basic-auth
 	options := []client.Option{}
 	if config.BasicAuth.IsEnabled() {
 		options = append(options, client.WithBasicAuth(config.BasicAuth.Credentials()))
 	}
 
+	// commands that handle partially signed transactions do not need a connection to a node
+	connection := wallet.WebAPI(config.WebAPI, options...)
+	if len(os.Args) >= 2 && offlineCommands[os.Args[1]] {
+		connection = wallet.Offline()
+	}
+
+	transactionHistory, err := importTransactionHistoryFile("wallet.history")
+	if err != nil {
+		panic(err)
+	}
+
+	// a watch-only wallet manages the configured addresses without reading the seed from the wallet state file
+	if len(config.WatchOnly) != 0 {
+		if len(os.Args) >= 2 && seedCommands[os.Args[1]] {
+			printUsage(nil, "the command \""+os.Args[1]+"\" needs the seed and can not be executed by a watch-only wallet")
+		}
+
+		watchedAddresses, parseErr := parseWatchedAddresses(config.WatchOnly)
+		if parseErr != nil {
+			panic(parseErr)
+		}
+
+		return wallet.New(
+			connection,
+			wallet.WatchOnly(watchedAddresses...),
+			wallet.ImportTransactionHistory(transactionHistory),
+		)
+	}
+
+	seed, lastAddressIndex, spentAddresses, assetRegistry, err := importWalletStateFile("wallet.dat")
+	if err != nil {
+		panic(err)
+	}
+
 	return wallet.New(
-		wallet.WebAPI(config.WebAPI, options...),
+		connection,
 		wallet.Import(seed, lastAddressIndex, spentAddresses, assetRegistry),
+		wallet.ImportTransactionHistory(transactionHistory),
 	)
 }
 
+// parseWatchedAddresses parses the base58 encoded addresses or ED25519 public keys of a watch-only wallet.
+func parseWatchedAddresses(encodedAddresses []string) (addresses []ledgerstate.Address, err error) {
+	addresses = make([]ledgerstate.Address, len(encodedAddresses))
+	for i, encodedAddress := range encodedAddresses {
+		decodedBytes, decodeErr := base58.Decode(encodedAddress)
+		if decodeErr != nil {
+			return nil, fmt.Errorf("failed to decode watch-only address %s: %w", encodedAddress, decodeErr)
+		}
+
+		if len(decodedBytes) == ed25519.PublicKeySize {
+			publicKey, _, publicKeyErr := ed25519.PublicKeyFromBytes(decodedBytes)
+			if publicKeyErr != nil {
+				return nil, fmt.Errorf("failed to parse watch-only public key %s: %w", encodedAddress, publicKeyErr)
+			}
+			addresses[i] = ledgerstate.NewED25519Address(publicKey)
+			continue
+		}
+
+		if addresses[i], _, err = ledgerstate.AddressFromBytes(decodedBytes); err != nil {
+			return nil, fmt.Errorf("failed to parse watch-only address %s: %w", encodedAddress, err)
+		}
+	}
+
+	return
+}
+
 func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *wallet.AssetRegistry, err error) {
 	walletStateBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -120,6 +187,28 @@ func writeWalletStateFile(wallet *wallet.Wallet, filename string) {
 	}
 }
 
+func importTransactionHistoryFile(filename string) (transactionHistory *wallet.TransactionHistory, err error) {
+	transactionHistoryBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return
+		}
+
+		return wallet.NewTransactionHistory(), nil
+	}
+
+	transactionHistory, _, err = wallet.TransactionHistoryFromBytes(transactionHistoryBytes)
+
+	return
+}
+
+func writeTransactionHistoryFile(wallet *wallet.Wallet, filename string) {
+	err := ioutil.WriteFile(filename, wallet.TransactionHistory().Bytes(), 0644)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func printUsage(command *flag.FlagSet, optionalErrorMessage ...string) {
 	if len(optionalErrorMessage) >= 1 {
 		_, _ = fmt.Fprintf(os.Stderr, "\n")
@@ -138,6 +227,10 @@ func printUsage(command *flag.FlagSet, optionalErrorMessage ...string) {
 		fmt.Println("        initiate a value transfer")
 		fmt.Println("  create-asset")
 		fmt.Println("        create an asset in the form of colored coins")
+		fmt.Println("  destroy-asset")
+		fmt.Println("        convert the colored coins of an asset back to IOTA")
+		fmt.Println("  asset-supply")
+		fmt.Println("        show the amount of created and destroyed tokens of an asset")
 		fmt.Println("  address")
 		fmt.Println("        start the address manager of this wallet")
 		fmt.Println("  request-funds")
@@ -146,6 +239,16 @@ func printUsage(command *flag.FlagSet, optionalErrorMessage ...string) {
 		fmt.Println("        generate a new wallet using a random seed")
 		fmt.Println("  server-status")
 		fmt.Println("        display the server status")
+		fmt.Println("  prepare-transaction")
+		fmt.Println("        build an unsigned transaction and export it to a file")
+		fmt.Println("  sign-transaction")
+		fmt.Println("        sign the inputs of an exported transaction (works offline)")
+		fmt.Println("  merge-transactions")
+		fmt.Println("        merge the signatures of different copies of an exported transaction (works offline)")
+		fmt.Println("  submit-transaction")
+		fmt.Println("        merge the copies of an exported transaction and send it to the network")
+		fmt.Println("  history")
+		fmt.Println("        show the sent and received transactions and reattach or report pending ones")
 		fmt.Println("  help")
 		fmt.Println("        display this help screen")
 
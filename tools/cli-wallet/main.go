@@ -26,7 +26,10 @@ func main() {
 
 	// load wallet
 	wallet := loadWallet()
-	defer writeWalletStateFile(wallet, "wallet.dat")
+	if !wallet.WatchOnly() {
+		defer writeWalletStateFile(wallet, "wallet.dat")
+	}
+	defer writeTransactionHistoryFile(wallet, "wallet.history")
 
 	// check if parameters potentially include sub commands
 	if len(os.Args) < 2 {
@@ -37,9 +40,16 @@ func main() {
 	balanceCommand := flag.NewFlagSet("balance", flag.ExitOnError)
 	sendFundsCommand := flag.NewFlagSet("send-funds", flag.ExitOnError)
 	createAssetCommand := flag.NewFlagSet("create-asset", flag.ExitOnError)
+	destroyAssetCommand := flag.NewFlagSet("destroy-asset", flag.ExitOnError)
+	assetSupplyCommand := flag.NewFlagSet("asset-supply", flag.ExitOnError)
 	addressCommand := flag.NewFlagSet("address", flag.ExitOnError)
 	requestFaucetFundsCommand := flag.NewFlagSet("request-funds", flag.ExitOnError)
 	serverStatusCommand := flag.NewFlagSet("server-status", flag.ExitOnError)
+	prepareTransactionCommand := flag.NewFlagSet("prepare-transaction", flag.ExitOnError)
+	signTransactionCommand := flag.NewFlagSet("sign-transaction", flag.ExitOnError)
+	mergeTransactionsCommand := flag.NewFlagSet("merge-transactions", flag.ExitOnError)
+	submitTransactionCommand := flag.NewFlagSet("submit-transaction", flag.ExitOnError)
+	historyCommand := flag.NewFlagSet("history", flag.ExitOnError)
 
 	// switch logic according to provided sub command
 	switch os.Args[1] {
@@ -51,6 +61,10 @@ func main() {
 		execSendFundsCommand(sendFundsCommand, wallet)
 	case "create-asset":
 		execCreateAssetCommand(createAssetCommand, wallet)
+	case "destroy-asset":
+		execDestroyAssetCommand(destroyAssetCommand, wallet)
+	case "asset-supply":
+		execAssetSupplyCommand(assetSupplyCommand, wallet)
 	case "request-funds":
 		execRequestFundsCommand(requestFaucetFundsCommand, wallet)
 	case "init":
@@ -58,6 +72,16 @@ func main() {
 		fmt.Println("CREATING WALLET STATE FILE (wallet.dat) ...               [DONE]")
 	case "server-status":
 		execServerStatusCommand(serverStatusCommand, wallet)
+	case "prepare-transaction":
+		execPrepareTransactionCommand(prepareTransactionCommand, wallet)
+	case "sign-transaction":
+		execSignTransactionCommand(signTransactionCommand, wallet)
+	case "merge-transactions":
+		execMergeTransactionsCommand(mergeTransactionsCommand)
+	case "submit-transaction":
+		execSubmitTransactionCommand(submitTransactionCommand, wallet)
+	case "history":
+		execHistoryCommand(historyCommand, wallet)
 	case "help":
 		printUsage(nil)
 	default:
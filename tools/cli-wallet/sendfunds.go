@@ -43,22 +43,9 @@ func execSendFundsCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 		return
 	}
 
-	var color ledgerstate.Color
-	switch *colorPtr {
-	case "IOTA":
-		color = ledgerstate.ColorIOTA
-	case "NEW":
-		color = ledgerstate.ColorMint
-	default:
-		colorBytes, parseErr := base58.Decode(*colorPtr)
-		if parseErr != nil {
-			printUsage(command, parseErr.Error())
-		}
-
-		color, _, parseErr = ledgerstate.ColorFromBytes(colorBytes)
-		if parseErr != nil {
-			printUsage(command, parseErr.Error())
-		}
+	color, err := parseColor(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
 	}
 
 	_, err = cliWallet.SendFunds(
@@ -73,3 +60,22 @@ func execSendFundsCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	fmt.Println()
 	fmt.Println("Sending funds ... [DONE]")
 }
+
+// parseColor parses the color of a transfer which can either be IOTA, NEW (for minting new colored coins) or the base58
+// encoded color of an existing asset.
+func parseColor(colorString string) (color ledgerstate.Color, err error) {
+	switch colorString {
+	case "IOTA":
+		return ledgerstate.ColorIOTA, nil
+	case "NEW":
+		return ledgerstate.ColorMint, nil
+	}
+
+	colorBytes, err := base58.Decode(colorString)
+	if err != nil {
+		return
+	}
+	color, _, err = ledgerstate.ColorFromBytes(colorBytes)
+
+	return
+}
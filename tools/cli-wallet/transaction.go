@@ -0,0 +1,281 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// offlineCommands contains the commands that can be executed without a connection to a node.
+var offlineCommands = map[string]bool{
+	"sign-transaction":   true,
+	"merge-transactions": true,
+}
+
+func execPrepareTransactionCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	addressPtr := command.String("dest-addr", "", "destination address for the transfer")
+	amountPtr := command.Int64("amount", 0, "the amount of tokens that are supposed to be sent")
+	colorPtr := command.String("color", "IOTA", "color of the tokens to transfer (optional)")
+	outPtr := command.String("out", "transaction.json", "file that the unsigned transaction is written to")
+	base58Ptr := command.Bool("base58", false, "write the transaction base58 encoded instead of as JSON")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *addressPtr == "" {
+		printUsage(command, "dest-addr has to be set")
+	}
+	if *amountPtr <= 0 {
+		printUsage(command, "amount has to be set and be bigger than 0")
+	}
+
+	destinationAddress, err := ledgerstate.AddressFromBase58EncodedString(*addressPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	color, err := parseColor(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	partiallySignedTransaction, err := cliWallet.PrepareTransaction(
+		wallet.Destination(address.Address{
+			AddressBytes: destinationAddress.Array(),
+		}, uint64(*amountPtr), color),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	writePartiallySignedTransactionFile(partiallySignedTransaction, *outPtr, *base58Ptr)
+
+	fmt.Println()
+	fmt.Println("Preparing transaction (" + *outPtr + ") ... [DONE]")
+}
+
+func execSignTransactionCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	inPtr := command.String("in", "transaction.json", "file containing the transaction that is supposed to be signed")
+	outPtr := command.String("out", "", "file that the signed transaction is written to (defaults to the input file)")
+	base58Ptr := command.Bool("base58", false, "write the transaction base58 encoded instead of as JSON")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+	if *outPtr == "" {
+		*outPtr = *inPtr
+	}
+
+	partiallySignedTransaction, err := readPartiallySignedTransactionFile(*inPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	// the transaction was prepared by another machine, so its content has to be checked before it is signed
+	printPartiallySignedTransaction(partiallySignedTransaction, cliWallet)
+	if !confirm("Do you want to sign this transaction?") {
+		fmt.Println()
+		fmt.Println("Signing transaction ... [ABORTED]")
+		return
+	}
+
+	signedInputs := cliWallet.SignTransaction(partiallySignedTransaction)
+	writePartiallySignedTransactionFile(partiallySignedTransaction, *outPtr, *base58Ptr)
+
+	fmt.Println()
+	fmt.Printf("Signing %d input(s), %d unsigned input(s) left (%s) ... [DONE]\n", signedInputs, len(partiallySignedTransaction.MissingUnlockBlocks()), *outPtr)
+}
+
+func execMergeTransactionsCommand(command *flag.FlagSet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	inPtr := command.String("in", "", "comma separated list of files containing signed copies of the same transaction")
+	outPtr := command.String("out", "transaction.json", "file that the merged transaction is written to")
+	base58Ptr := command.Bool("base58", false, "write the transaction base58 encoded instead of as JSON")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+	if *inPtr == "" {
+		printUsage(command, "in has to be set")
+	}
+
+	partiallySignedTransaction, err := mergePartiallySignedTransactionFiles(strings.Split(*inPtr, ","))
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	writePartiallySignedTransactionFile(partiallySignedTransaction, *outPtr, *base58Ptr)
+
+	fmt.Println()
+	fmt.Printf("Merging transactions, %d unsigned input(s) left (%s) ... [DONE]\n", len(partiallySignedTransaction.MissingUnlockBlocks()), *outPtr)
+}
+
+func execSubmitTransactionCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	inPtr := command.String("in", "transaction.json", "comma separated list of files containing signed copies of the transaction")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	partiallySignedTransaction, err := mergePartiallySignedTransactionFiles(strings.Split(*inPtr, ","))
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	tx, err := cliWallet.SubmitTransaction(partiallySignedTransaction)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Submitting transaction " + tx.ID().Base58() + " ... [DONE]")
+}
+
+// printPartiallySignedTransaction prints the consumed and the created outputs of the transaction together with their
+// balances and marks the addresses that belong to the wallet.
+func printPartiallySignedTransaction(partiallySignedTransaction *wallet.PartiallySignedTransaction, cliWallet *wallet.Wallet) {
+	walletAddresses := make(map[[ledgerstate.AddressLength]byte]bool)
+	for _, addr := range cliWallet.AddressManager().Addresses() {
+		walletAddresses[addr.AddressBytes] = true
+	}
+	owner := func(addr ledgerstate.Address) string {
+		if walletAddresses[addr.Array()] {
+			return "[OWN]"
+		}
+		return ""
+	}
+
+	// initialize tab writer
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 2, '\t', 0)
+
+	fmt.Println()
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "", "INDEX", "ADDRESS", "BALANCE", "COLOR")
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "-------", "-----", "--------------------------------------------", "---------------", "--------------------------------------------")
+	for i, consumedOutput := range partiallySignedTransaction.ConsumedOutputs() {
+		consumedOutput.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s %s\t%d %s\t%s\n", "INPUT", i, consumedOutput.Address().Base58(), owner(consumedOutput.Address()), balance, cliWallet.AssetRegistry().Symbol(color), color.String())
+			return true
+		})
+	}
+	for i, output := range partiallySignedTransaction.Essence().Outputs() {
+		output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s %s\t%d %s\t%s\n", "OUTPUT", i, output.Address().Base58(), owner(output.Address()), balance, cliWallet.AssetRegistry().Symbol(color), color.String())
+			return true
+		})
+	}
+	_ = w.Flush()
+}
+
+// confirm asks the user the given question and returns true if it is answered with yes.
+func confirm(question string) bool {
+	fmt.Println()
+	fmt.Print(question + " [y/N]: ")
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// mergePartiallySignedTransactionFiles reads the given files and merges the contained copies of the transaction.
+func mergePartiallySignedTransactionFiles(filenames []string) (partiallySignedTransaction *wallet.PartiallySignedTransaction, err error) {
+	for _, filename := range filenames {
+		copyOfTransaction, readErr := readPartiallySignedTransactionFile(strings.TrimSpace(filename))
+		if readErr != nil {
+			return nil, readErr
+		}
+
+		if partiallySignedTransaction == nil {
+			partiallySignedTransaction = copyOfTransaction
+			continue
+		}
+
+		if err = partiallySignedTransaction.Merge(copyOfTransaction); err != nil {
+			return nil, fmt.Errorf("failed to merge %s: %w", filename, err)
+		}
+	}
+
+	return
+}
+
+// readPartiallySignedTransactionFile reads a transaction that was either exported as JSON or base58 encoded.
+func readPartiallySignedTransactionFile(filename string) (partiallySignedTransaction *wallet.PartiallySignedTransaction, err error) {
+	fileContent, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+
+	fileContent = bytes.TrimSpace(fileContent)
+	if bytes.HasPrefix(fileContent, []byte("{")) {
+		partiallySignedTransaction = &wallet.PartiallySignedTransaction{}
+		if err = json.Unmarshal(fileContent, partiallySignedTransaction); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+		}
+
+		return
+	}
+
+	if partiallySignedTransaction, err = wallet.PartiallySignedTransactionFromBase58EncodedString(string(fileContent)); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+
+	return
+}
+
+// writePartiallySignedTransactionFile writes the transaction either as JSON or base58 encoded to the given file.
+func writePartiallySignedTransactionFile(partiallySignedTransaction *wallet.PartiallySignedTransaction, filename string, base58Encoded bool) {
+	fileContent := []byte(partiallySignedTransaction.Base58())
+	if !base58Encoded {
+		jsonContent, err := json.MarshalIndent(partiallySignedTransaction, "", "  ")
+		if err != nil {
+			panic(err)
+		}
+		fileContent = jsonContent
+	}
+
+	if err := ioutil.WriteFile(filename, fileContent, 0644); err != nil {
+		panic(err)
+	}
+}
@@ -136,3 +136,7 @@ func (connector *mockConnector) SendTransaction(tx *ledgerstate.Transaction) (er
 	// mark outputs as spent
 	return
 }
+
+func (connector *mockConnector) TransactionInclusionState(transactionID ledgerstate.TransactionID) (inclusionState wallet.InclusionState, err error) {
+	return
+}
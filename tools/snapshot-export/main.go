@@ -0,0 +1,53 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/iotaledger/goshimmer/client"
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	cfgNodeAPIURL           = "node"
+	cfgSnapshotFileName     = "snapshot-file"
+	cfgWithMana             = "mana"
+	defaultSnapshotFileName = "./snapshot.bin"
+)
+
+func init() {
+	flag.String(cfgNodeAPIURL, "http://127.0.0.1:8080", "the web API URL of the node to export the snapshot from")
+	flag.String(cfgSnapshotFileName, defaultSnapshotFileName, "the name of the exported snapshot file")
+	flag.Bool(cfgWithMana, false, "include the mana state of the node in the snapshot")
+}
+
+func main() {
+	flag.Parse()
+	if err := viper.BindPFlags(flag.CommandLine); err != nil {
+		panic(err)
+	}
+	nodeAPIURL := viper.GetString(cfgNodeAPIURL)
+	snapshotFileName := viper.GetString(cfgSnapshotFileName)
+
+	log.Printf("exporting snapshot from %s...", nodeAPIURL)
+	goshimmerAPI := client.NewGoShimmerAPI(nodeAPIURL, client.WithHTTPClient(http.Client{Timeout: 5 * time.Minute}))
+	snapshot, err := goshimmerAPI.GetSnapshot(viper.GetBool(cfgWithMana))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.OpenFile(snapshotFileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		log.Fatal("unable to create snapshot file", err)
+	}
+	defer f.Close()
+
+	if _, err := snapshot.WriteTo(f); err != nil {
+		log.Fatal("unable to write snapshot", err)
+	}
+
+	log.Printf("wrote snapshot with %d outputs and %d mana entries to %s", len(snapshot.Outputs), len(snapshot.BaseManas), snapshotFileName)
+}